@@ -0,0 +1,248 @@
+package valgo
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A Date is a calendar date without a time of day or a time zone, such as a
+// birthday. Unlike [time.Time], a Date is the same day everywhere.
+//
+// The zero value is not a valid date. Use [Date.IsValid] or the Valid rule of
+// the [ValidatorCivilDate] to reject dates that don't exist, such as February
+// 29 of a non-leap year.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of the time in its own location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// ParseDate parses an ISO 8601 calendar date in the extended format
+// "2006-01-02" or the basic format "20060102". Dates that don't exist in the
+// calendar, such as "2023-02-29", return an error.
+func ParseDate(value string) (Date, error) {
+	layout := "2006-01-02"
+	if !strings.Contains(value, "-") {
+		layout = "20060102"
+	}
+
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// In returns the time at midnight of the date in the location. A nil location
+// is UTC.
+func (d Date) In(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// IsZero reports whether the date is the zero value.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// IsValid reports whether the date exists in the calendar.
+func (d Date) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+// IsLeapDay reports whether the date is February 29.
+func (d Date) IsLeapDay() bool {
+	return d.Month == time.February && d.Day == 29
+}
+
+// Weekday returns the day of the week of the date.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// Compare returns -1 if the date is before other, +1 if it's after, and 0 if
+// both dates are the same.
+func (d Date) Compare(other Date) int {
+	switch {
+	case d.Year != other.Year:
+		return cmp.Compare(d.Year, other.Year)
+	case d.Month != other.Month:
+		return cmp.Compare(d.Month, other.Month)
+	default:
+		return cmp.Compare(d.Day, other.Day)
+	}
+}
+
+// Before reports whether the date is before other.
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// After reports whether the date is after other.
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+// String returns the date in the ISO 8601 format "2006-01-02". Dates that
+// don't exist are rendered as they are, without normalizing them.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// MarshalText encodes the date in the ISO 8601 format "2006-01-02".
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes an ISO 8601 date, as accepted by [ParseDate].
+func (d *Date) UnmarshalText(data []byte) error {
+	date, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
+
+// A TimeOfDay is a wall clock time without a date or a time zone, such as the
+// opening time of a store.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// TimeOfDayOf returns the wall clock time of the time in its own location.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	hour, minute, second := t.Clock()
+	return TimeOfDay{Hour: hour, Minute: minute, Second: second, Nanosecond: t.Nanosecond()}
+}
+
+// ParseTimeOfDay parses an ISO 8601 time of day in the extended format
+// "15:04:05" or the basic format "150405". The seconds are optional and may
+// have a fraction, and the value may start with the "T" designator.
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	_value := strings.TrimPrefix(value, "T")
+
+	layout := "15:04:05"
+	if !strings.Contains(_value, ":") {
+		layout = "150405"
+	}
+	if len(_value) == 5 || len(_value) == 4 {
+		layout = layout[:len(_value)]
+	}
+
+	t, err := time.Parse(layout, _value)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return TimeOfDayOf(t), nil
+}
+
+// On returns the time of the time of day on the date in the location. A nil
+// location is UTC.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Date(d.Year, d.Month, d.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+// IsZero reports whether the time of day is the zero value, that is,
+// midnight.
+func (t TimeOfDay) IsZero() bool {
+	return t == TimeOfDay{}
+}
+
+// IsValid reports whether every field of the time of day is within its range.
+func (t TimeOfDay) IsValid() bool {
+	return t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 &&
+		t.Nanosecond >= 0 && t.Nanosecond < int(time.Second)
+}
+
+// SinceMidnight returns the time elapsed from midnight to the time of day.
+func (t TimeOfDay) SinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
+// Compare returns -1 if the time of day is before other, +1 if it's after,
+// and 0 if both are the same.
+func (t TimeOfDay) Compare(other TimeOfDay) int {
+	return cmp.Compare(t.SinceMidnight(), other.SinceMidnight())
+}
+
+// Before reports whether the time of day is before other.
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t.Compare(other) < 0
+}
+
+// After reports whether the time of day is after other.
+func (t TimeOfDay) After(other TimeOfDay) bool {
+	return t.Compare(other) > 0
+}
+
+// String returns the time of day in the ISO 8601 format "15:04:05", with a
+// fraction of a second when it isn't zero. Times of day out of range are
+// rendered as they are, without normalizing them.
+func (t TimeOfDay) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond != 0 {
+		s += "." + strings.TrimRight(fmt.Sprintf("%09d", t.Nanosecond), "0")
+	}
+	return s
+}
+
+// MarshalText encodes the time of day in the ISO 8601 format "15:04:05".
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText decodes an ISO 8601 time of day, as accepted by
+// [ParseTimeOfDay].
+func (t *TimeOfDay) UnmarshalText(data []byte) error {
+	timeOfDay, err := ParseTimeOfDay(string(data))
+	if err != nil {
+		return err
+	}
+	*t = timeOfDay
+	return nil
+}
+
+// Dates that don't exist are rendered with [Date.String], since localizing
+// them would show the normalized date instead.
+func localizedCivilDate(d Date) any {
+	if !d.IsValid() {
+		return d.String()
+	}
+	return localizedDate(d.In(time.UTC))
+}
+
+// Times of day with seconds are rendered with [LayoutKeyTimeOfDaySeconds] so
+// the seconds are not hidden in the error messages. Times of day out of range
+// are rendered with [TimeOfDay.String].
+func localizedCivilTime(t TimeOfDay) any {
+	if !t.IsValid() {
+		return t.String()
+	}
+	if t.Second == 0 && t.Nanosecond == 0 {
+		return localizedTimeOfDay(t.SinceMidnight())
+	}
+	return &localizedTime{
+		time:          time.Date(0, 1, 1, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC),
+		layoutKey:     LayoutKeyTimeOfDaySeconds,
+		defaultLayout: "15:04:05"}
+}
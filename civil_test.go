@@ -0,0 +1,121 @@
+package valgo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDate(t *testing.T) {
+	date, err := ParseDate("2024-02-29")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{Year: 2024, Month: time.February, Day: 29}, date)
+
+	date, err = ParseDate("20240229")
+	assert.NoError(t, err)
+	assert.Equal(t, Date{Year: 2024, Month: time.February, Day: 29}, date)
+
+	for _, value := range []string{"2023-02-29", "2024-13-01", "2024-2-29", "2024-02-29T10:00:00Z", ""} {
+		_, err = ParseDate(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	for value, expected := range map[string]TimeOfDay{
+		"09:30":          {Hour: 9, Minute: 30},
+		"09:30:15":       {Hour: 9, Minute: 30, Second: 15},
+		"09:30:15.25":    {Hour: 9, Minute: 30, Second: 15, Nanosecond: 250000000},
+		"09:30:15,25":    {Hour: 9, Minute: 30, Second: 15, Nanosecond: 250000000},
+		"0930":           {Hour: 9, Minute: 30},
+		"093015":         {Hour: 9, Minute: 30, Second: 15},
+		"T23:59:59":      {Hour: 23, Minute: 59, Second: 59},
+		"00:00":          {},
+		"12:00:00.00001": {Hour: 12, Nanosecond: 10000},
+	} {
+		timeOfDay, err := ParseTimeOfDay(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, timeOfDay, value)
+	}
+
+	for _, value := range []string{"24:00", "09:60", "9:30", "09:30Z", "", "T"} {
+		_, err := ParseTimeOfDay(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestDate(t *testing.T) {
+	leapDay := Date{Year: 2024, Month: time.February, Day: 29}
+
+	assert.True(t, leapDay.IsValid())
+	assert.True(t, leapDay.IsLeapDay())
+	assert.False(t, Date{Year: 2023, Month: time.February, Day: 29}.IsValid())
+	assert.False(t, Date{}.IsValid())
+	assert.True(t, Date{}.IsZero())
+	assert.Equal(t, time.Thursday, leapDay.Weekday())
+	assert.Equal(t, "2024-02-29", leapDay.String())
+
+	assert.Equal(t, 0, leapDay.Compare(leapDay))
+	assert.True(t, leapDay.Before(Date{Year: 2024, Month: time.March, Day: 1}))
+	assert.True(t, leapDay.After(Date{Year: 2024, Month: time.February, Day: 28}))
+	assert.True(t, leapDay.After(Date{Year: 2023, Month: time.December, Day: 31}))
+
+	assert.Equal(t, leapDay, DateOf(time.Date(2024, 2, 29, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), leapDay.In(time.UTC))
+	assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), leapDay.In(nil))
+
+	// Dates that don't exist are not normalized
+	assert.Equal(t, "2023-02-30", Date{Year: 2023, Month: time.February, Day: 30}.String())
+	assert.Equal(t, "0000-00-00", Date{}.String())
+}
+
+func TestTimeOfDay(t *testing.T) {
+	opensAt := TimeOfDay{Hour: 9, Minute: 30}
+
+	assert.True(t, opensAt.IsValid())
+	assert.False(t, TimeOfDay{Hour: 24}.IsValid())
+	assert.False(t, TimeOfDay{Minute: -1}.IsValid())
+	assert.True(t, TimeOfDay{}.IsZero())
+	assert.Equal(t, 9*time.Hour+30*time.Minute, opensAt.SinceMidnight())
+	assert.Equal(t, "09:30:00", opensAt.String())
+	assert.Equal(t, "09:30:00.5", TimeOfDay{Hour: 9, Minute: 30, Nanosecond: 500000000}.String())
+
+	assert.True(t, opensAt.Before(TimeOfDay{Hour: 17}))
+	assert.True(t, opensAt.After(TimeOfDay{Hour: 9}))
+	assert.Equal(t, 0, opensAt.Compare(TimeOfDay{Hour: 9, Minute: 30}))
+
+	assert.Equal(t, opensAt, TimeOfDayOf(time.Date(2024, 2, 29, 9, 30, 0, 0, time.UTC)))
+	assert.Equal(t,
+		time.Date(2024, 2, 29, 9, 30, 0, 0, time.UTC),
+		opensAt.On(Date{Year: 2024, Month: time.February, Day: 29}, time.UTC))
+	assert.Equal(t,
+		time.Date(2024, 2, 29, 9, 30, 0, 0, time.UTC),
+		opensAt.On(Date{Year: 2024, Month: time.February, Day: 29}, nil))
+
+	// Times of day out of range are not normalized
+	assert.Equal(t, "25:00:00", TimeOfDay{Hour: 25}.String())
+}
+
+func TestDateAndTimeOfDayText(t *testing.T) {
+	type store struct {
+		OpenedOn Date      `json:"opened_on"`
+		OpensAt  TimeOfDay `json:"opens_at"`
+	}
+
+	data, err := json.Marshal(store{
+		OpenedOn: Date{Year: 2024, Month: time.February, Day: 29},
+		OpensAt:  TimeOfDay{Hour: 9},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"opened_on":"2024-02-29","opens_at":"09:00:00"}`, string(data))
+
+	var s store
+	assert.NoError(t, json.Unmarshal([]byte(`{"opened_on":"2020-02-29","opens_at":"10:15"}`), &s))
+	assert.Equal(t, Date{Year: 2020, Month: time.February, Day: 29}, s.OpenedOn)
+	assert.Equal(t, TimeOfDay{Hour: 10, Minute: 15}, s.OpensAt)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"opened_on":"2021-02-29"}`), &s))
+	assert.Error(t, json.Unmarshal([]byte(`{"opens_at":"25:00"}`), &s))
+}
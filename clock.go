@@ -0,0 +1,29 @@
+package valgo
+
+import "time"
+
+// Clock provides the current time to the rules that validate a value relative
+// to now, such as InFuture() and WithinLast() of the [ValidatorTime]. The
+// clock of a [Validation] session can be set with [Options] or
+// [FactoryOptions], which is useful to freeze the time in tests. When no clock
+// is set, the system time is used.
+type Clock interface {
+	Now() time.Time
+}
+
+// The ClockFunc type is an adapter to allow the use of an ordinary function
+// as a [Clock].
+type ClockFunc func() time.Time
+
+// Return the time returned by the function.
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// Return a [Clock] that always returns the same time. For example:
+//
+//	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+//	val := v.New(v.Options{Clock: v.FixedClock(now)})
+func FixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time { return t })
+}
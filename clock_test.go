@@ -0,0 +1,67 @@
+package valgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClockFuncAndFixedClock(t *testing.T) {
+
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, now, FixedClock(now).Now())
+	assert.Equal(t, now, ClockFunc(func() time.Time { return now }).Now())
+}
+
+func TestClockWithFactory(t *testing.T) {
+
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	factory := Factory(FactoryOptions{Clock: FixedClock(now)})
+
+	assert.True(t, factory.Is(Time(now.Add(time.Minute)).InFuture()).Valid())
+	assert.False(t, factory.Is(Time(now.Add(-time.Minute)).InFuture()).Valid())
+	assert.True(t, factory.Check(Time(now.Add(-time.Minute)).InPast()).Valid())
+
+	// The clock of the options overrides the clock of the factory
+	later := now.Add(time.Hour)
+	assert.False(t, factory.New(Options{Clock: FixedClock(later)}).Is(Time(now.Add(time.Minute)).InFuture()).Valid())
+	assert.True(t, factory.New(Options{LocaleCode: LocaleCodeEs}).Is(Time(now.Add(time.Minute)).InFuture()).Valid())
+}
+
+func TestClockInNestedValidators(t *testing.T) {
+
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	slots := []time.Time{now.Add(time.Hour), now.Add(-time.Hour)}
+
+	v := New(Options{Clock: FixedClock(now)}).Is(Slice(slots, "slots").Each(func(tm time.Time, _ int) Validator {
+		return Time(tm).InFuture()
+	}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Slots must be in the future",
+		v.Errors()["slots[1]"].Messages()[0])
+}
+
+func TestValidatorContextNow(t *testing.T) {
+
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	// A custom rule relative to the current time
+	isWeekend := func(value time.Time) Validator {
+		ctx := NewContext(value, "date")
+		ctx.Add(func() bool {
+			weekday := ctx.Now().Weekday()
+			return weekday == time.Saturday || weekday == time.Sunday
+		}, ErrorKeyPassing)
+		return &ValidatorTime{context: ctx}
+	}
+
+	// 2024-01-15 is a Monday
+	assert.False(t, New(Options{Clock: FixedClock(now)}).Is(isWeekend(now)).Valid())
+	assert.True(t, New(Options{Clock: FixedClock(now.AddDate(0, 0, 5))}).Is(isWeekend(now)).Valid())
+
+	// Outside of an evaluation the system time is returned
+	assert.WithinDuration(t, time.Now(), NewContext(now).Now(), time.Minute)
+}
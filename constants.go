@@ -94,6 +94,449 @@ const (
 	ErrorKeyFinite    = "finite"
 	ErrorKeyNotFinite = "not_finite"
 
+	ErrorKeyContains    = "contains"
+	ErrorKeyNotContains = "not_contains"
+
+	ErrorKeyUnique    = "unique"
+	ErrorKeyNotUnique = "not_unique"
+
+	ErrorKeyHasKeys    = "has_keys"
+	ErrorKeyNotHasKeys = "not_has_keys"
+
+	ErrorKeyOnlyKeys    = "only_keys"
+	ErrorKeyNotOnlyKeys = "not_only_keys"
+
+	ErrorKeyMultipleOf    = "multiple_of"
+	ErrorKeyNotMultipleOf = "not_multiple_of"
+
+	ErrorKeyTruncated    = "truncated"
+	ErrorKeyNotTruncated = "not_truncated"
+
+	ErrorKeyDecimal    = "decimal"
+	ErrorKeyNotDecimal = "not_decimal"
+
+	ErrorKeyMaxPrecision    = "max_precision"
+	ErrorKeyNotMaxPrecision = "not_max_precision"
+
+	ErrorKeyMaxScale    = "max_scale"
+	ErrorKeyNotMaxScale = "not_max_scale"
+
+	ErrorKeyScale    = "scale"
+	ErrorKeyNotScale = "not_scale"
+
+	ErrorKeyNonNegative    = "non_negative"
+	ErrorKeyNotNonNegative = "not_non_negative"
+
+	ErrorKeyCurrencyMinorUnits    = "currency_minor_units"
+	ErrorKeyNotCurrencyMinorUnits = "not_currency_minor_units"
+	ErrorKeyUnknownCurrency       = "unknown_currency"
+
+	ErrorKeyIP    = "ip"
+	ErrorKeyNotIP = "not_ip"
+
+	ErrorKeyPrefix    = "prefix"
+	ErrorKeyNotPrefix = "not_prefix"
+
+	ErrorKeyAddrPort    = "addr_port"
+	ErrorKeyNotAddrPort = "not_addr_port"
+
+	ErrorKeyIPv4    = "ipv4"
+	ErrorKeyNotIPv4 = "not_ipv4"
+
+	ErrorKeyIPv6    = "ipv6"
+	ErrorKeyNotIPv6 = "not_ipv6"
+
+	ErrorKeyPrivate    = "private"
+	ErrorKeyNotPrivate = "not_private"
+
+	ErrorKeyLoopback    = "loopback"
+	ErrorKeyNotLoopback = "not_loopback"
+
+	ErrorKeyGlobal    = "global"
+	ErrorKeyNotGlobal = "not_global"
+
+	ErrorKeyMulticast    = "multicast"
+	ErrorKeyNotMulticast = "not_multicast"
+
+	ErrorKeyMasked    = "masked"
+	ErrorKeyNotMasked = "not_masked"
+
+	ErrorKeyInPrefix    = "in_prefix"
+	ErrorKeyNotInPrefix = "not_in_prefix"
+
+	ErrorKeyOutsidePrefixes    = "outside_prefixes"
+	ErrorKeyNotOutsidePrefixes = "not_outside_prefixes"
+
+	ErrorKeyPortBetween    = "port_between"
+	ErrorKeyNotPortBetween = "not_port_between"
+
+	ErrorKeyURL    = "url"
+	ErrorKeyNotURL = "not_url"
+
+	ErrorKeyAbsoluteURL    = "absolute_url"
+	ErrorKeyNotAbsoluteURL = "not_absolute_url"
+
+	ErrorKeySchemeIn    = "scheme_in"
+	ErrorKeyNotSchemeIn = "not_scheme_in"
+
+	ErrorKeyHostIn    = "host_in"
+	ErrorKeyNotHostIn = "not_host_in"
+
+	ErrorKeyWithoutUserInfo    = "without_user_info"
+	ErrorKeyNotWithoutUserInfo = "not_without_user_info"
+
+	ErrorKeyWithoutFragment    = "without_fragment"
+	ErrorKeyNotWithoutFragment = "not_without_fragment"
+
+	ErrorKeyPathPrefix    = "path_prefix"
+	ErrorKeyNotPathPrefix = "not_path_prefix"
+
+	ErrorKeyExternalHost    = "external_host"
+	ErrorKeyNotExternalHost = "not_external_host"
+
+	ErrorKeyEmail    = "email"
+	ErrorKeyNotEmail = "not_email"
+
+	ErrorKeyEmailDomainIn    = "email_domain_in"
+	ErrorKeyNotEmailDomainIn = "not_email_domain_in"
+
+	ErrorKeyEmailDomainExcluded    = "email_domain_excluded"
+	ErrorKeyNotEmailDomainExcluded = "not_email_domain_excluded"
+
+	ErrorKeyUUID    = "uuid"
+	ErrorKeyNotUUID = "not_uuid"
+
+	ErrorKeyUUIDVersion    = "uuid_version"
+	ErrorKeyNotUUIDVersion = "not_uuid_version"
+
+	ErrorKeyULID    = "ulid"
+	ErrorKeyNotULID = "not_ulid"
+
+	ErrorKeyKSUID    = "ksuid"
+	ErrorKeyNotKSUID = "not_ksuid"
+
+	ErrorKeyHex    = "hex"
+	ErrorKeyNotHex = "not_hex"
+
+	ErrorKeyHexLength    = "hex_length"
+	ErrorKeyNotHexLength = "not_hex_length"
+
+	ErrorKeyBase64    = "base64"
+	ErrorKeyNotBase64 = "not_base64"
+
+	ErrorKeyBase32    = "base32"
+	ErrorKeyNotBase32 = "not_base32"
+
+	ErrorKeyLuhn    = "luhn"
+	ErrorKeyNotLuhn = "not_luhn"
+
+	ErrorKeyCreditCard    = "credit_card"
+	ErrorKeyNotCreditCard = "not_credit_card"
+
+	ErrorKeyCreditCardBrand    = "credit_card_brand"
+	ErrorKeyNotCreditCardBrand = "not_credit_card_brand"
+
+	ErrorKeyIBAN    = "iban"
+	ErrorKeyNotIBAN = "not_iban"
+
+	ErrorKeyIBANCountry    = "iban_country"
+	ErrorKeyNotIBANCountry = "not_iban_country"
+
+	ErrorKeyBIC    = "bic"
+	ErrorKeyNotBIC = "not_bic"
+
+	ErrorKeyISBN10    = "isbn10"
+	ErrorKeyNotISBN10 = "not_isbn10"
+
+	ErrorKeyISBN13    = "isbn13"
+	ErrorKeyNotISBN13 = "not_isbn13"
+
+	ErrorKeyEAN13    = "ean13"
+	ErrorKeyNotEAN13 = "not_ean13"
+
+	ErrorKeyISSN    = "issn"
+	ErrorKeyNotISSN = "not_issn"
+
+	ErrorKeyChecksum    = "checksum"
+	ErrorKeyNotChecksum = "not_checksum"
+
+	ErrorKeyPhone    = "phone"
+	ErrorKeyNotPhone = "not_phone"
+
+	ErrorKeyPhoneForRegion    = "phone_for_region"
+	ErrorKeyNotPhoneForRegion = "not_phone_for_region"
+
+	ErrorKeySemVer    = "semver"
+	ErrorKeyNotSemVer = "not_semver"
+
+	ErrorKeySatisfiesConstraint    = "satisfies_constraint"
+	ErrorKeyNotSatisfiesConstraint = "not_satisfies_constraint"
+	ErrorKeyMalformedConstraint    = "malformed_constraint"
+
+	ErrorKeySemVerGreaterThan    = "semver_greater_than"
+	ErrorKeyNotSemVerGreaterThan = "not_semver_greater_than"
+
+	ErrorKeySemVerBetween    = "semver_between"
+	ErrorKeyNotSemVerBetween = "not_semver_between"
+
+	ErrorKeyJSON    = "json"
+	ErrorKeyNotJSON = "not_json"
+
+	ErrorKeyJSONObject    = "json_object"
+	ErrorKeyNotJSONObject = "not_json_object"
+
+	ErrorKeyJSONArray    = "json_array"
+	ErrorKeyNotJSONArray = "not_json_array"
+
+	ErrorKeyRegexp    = "regexp"
+	ErrorKeyNotRegexp = "not_regexp"
+
+	ErrorKeyGoTemplate    = "go_template"
+	ErrorKeyNotGoTemplate = "not_go_template"
+
+	ErrorKeyPassword    = "password"
+	ErrorKeyNotPassword = "not_password"
+
+	ErrorKeyPasswordMinLength   = "password_min_length"
+	ErrorKeyPasswordLowercase   = "password_lowercase"
+	ErrorKeyPasswordUppercase   = "password_uppercase"
+	ErrorKeyPasswordDigit       = "password_digit"
+	ErrorKeyPasswordSymbol      = "password_symbol"
+	ErrorKeyPasswordMaxRepeated = "password_max_repeated"
+	ErrorKeyPasswordEntropy     = "password_entropy"
+	ErrorKeyPasswordCommon      = "password_common"
+
+	ErrorKeyPrintable    = "printable"
+	ErrorKeyNotPrintable = "not_printable"
+
+	ErrorKeyWithoutControlChars    = "without_control_chars"
+	ErrorKeyNotWithoutControlChars = "not_without_control_chars"
+
+	ErrorKeyNormalizedNFC    = "normalized_nfc"
+	ErrorKeyNotNormalizedNFC = "not_normalized_nfc"
+
+	ErrorKeySingleScript    = "single_script"
+	ErrorKeyNotSingleScript = "not_single_script"
+
+	ErrorKeyScriptIn    = "script_in"
+	ErrorKeyNotScriptIn = "not_script_in"
+
+	ErrorKeyWithoutConfusables    = "without_confusables"
+	ErrorKeyNotWithoutConfusables = "not_without_confusables"
+
+	ErrorKeySingleLine    = "single_line"
+	ErrorKeyNotSingleLine = "not_single_line"
+
+	ErrorKeyLowercase    = "lowercase"
+	ErrorKeyNotLowercase = "not_lowercase"
+
+	ErrorKeyUppercase    = "uppercase"
+	ErrorKeyNotUppercase = "not_uppercase"
+
+	ErrorKeySlug    = "slug"
+	ErrorKeyNotSlug = "not_slug"
+
+	ErrorKeySnakeCase    = "snake_case"
+	ErrorKeyNotSnakeCase = "not_snake_case"
+
+	ErrorKeyKebabCase    = "kebab_case"
+	ErrorKeyNotKebabCase = "not_kebab_case"
+
+	ErrorKeyCamelCase    = "camel_case"
+	ErrorKeyNotCamelCase = "not_camel_case"
+
+	ErrorKeyPascalCase    = "pascal_case"
+	ErrorKeyNotPascalCase = "not_pascal_case"
+
+	ErrorKeyGoIdentifier    = "go_identifier"
+	ErrorKeyNotGoIdentifier = "not_go_identifier"
+
+	ErrorKeyAlpha    = "alpha"
+	ErrorKeyNotAlpha = "not_alpha"
+
+	ErrorKeyAlphaUnicode    = "alpha_unicode"
+	ErrorKeyNotAlphaUnicode = "not_alpha_unicode"
+
+	ErrorKeyAlphanumeric    = "alphanumeric"
+	ErrorKeyNotAlphanumeric = "not_alphanumeric"
+
+	ErrorKeyAlphanumericUnicode    = "alphanumeric_unicode"
+	ErrorKeyNotAlphanumericUnicode = "not_alphanumeric_unicode"
+
+	ErrorKeyNumeric    = "numeric"
+	ErrorKeyNotNumeric = "not_numeric"
+
+	ErrorKeyNumericUnicode    = "numeric_unicode"
+	ErrorKeyNotNumericUnicode = "not_numeric_unicode"
+
+	ErrorKeyASCII    = "ascii"
+	ErrorKeyNotASCII = "not_ascii"
+
+	ErrorKeyHasPrefix    = "has_prefix"
+	ErrorKeyNotHasPrefix = "not_has_prefix"
+
+	ErrorKeyHasSuffix    = "has_suffix"
+	ErrorKeyNotHasSuffix = "not_has_suffix"
+
+	ErrorKeyContainsAny    = "contains_any"
+	ErrorKeyNotContainsAny = "not_contains_any"
+
+	ErrorKeyExcludesAll    = "excludes_all"
+	ErrorKeyNotExcludesAll = "not_excludes_all"
+
+	ErrorKeyContainsFold    = "contains_fold"
+	ErrorKeyNotContainsFold = "not_contains_fold"
+
+	ErrorKeyEqualToFold    = "equal_to_fold"
+	ErrorKeyNotEqualToFold = "not_equal_to_fold"
+
+	ErrorKeyWordCountBetween    = "word_count_between"
+	ErrorKeyNotWordCountBetween = "not_word_count_between"
+
+	ErrorKeyHostname    = "hostname"
+	ErrorKeyNotHostname = "not_hostname"
+
+	ErrorKeyFQDN    = "fqdn"
+	ErrorKeyNotFQDN = "not_fqdn"
+
+	ErrorKeyDomainWithTLD    = "domain_with_tld"
+	ErrorKeyNotDomainWithTLD = "not_domain_with_tld"
+
+	ErrorKeyHostPort    = "host_port"
+	ErrorKeyNotHostPort = "not_host_port"
+
+	ErrorKeyPort    = "port"
+	ErrorKeyNotPort = "not_port"
+
+	ErrorKeyPunycodeHostname    = "punycode_hostname"
+	ErrorKeyNotPunycodeHostname = "not_punycode_hostname"
+
+	ErrorKeyHostnameLabel    = "hostname_label"
+	ErrorKeyNotHostnameLabel = "not_hostname_label"
+
+	ErrorKeyHostnameEmptyLabel    = "hostname_empty_label"
+	ErrorKeyNotHostnameEmptyLabel = "not_hostname_empty_label"
+
+	ErrorKeyHostnameLabelLength    = "hostname_label_length"
+	ErrorKeyNotHostnameLabelLength = "not_hostname_label_length"
+
+	ErrorKeyHostnameLength    = "hostname_length"
+	ErrorKeyNotHostnameLength = "not_hostname_length"
+
+	ErrorKeyOneOfRanges    = "one_of_ranges"
+	ErrorKeyNotOneOfRanges = "not_one_of_ranges"
+
+	ErrorKeyEven    = "even"
+	ErrorKeyNotEven = "not_even"
+
+	ErrorKeyOdd    = "odd"
+	ErrorKeyNotOdd = "not_odd"
+
+	ErrorKeyPowerOfTwo    = "power_of_two"
+	ErrorKeyNotPowerOfTwo = "not_power_of_two"
+
+	ErrorKeyEqualWithin    = "equal_within"
+	ErrorKeyNotEqualWithin = "not_equal_within"
+
+	ErrorKeyFitsIn    = "fits_in"
+	ErrorKeyNotFitsIn = "not_fits_in"
+
+	ErrorKeyInFuture    = "in_future"
+	ErrorKeyNotInFuture = "not_in_future"
+
+	ErrorKeyInPast    = "in_past"
+	ErrorKeyNotInPast = "not_in_past"
+
+	ErrorKeyWithinLast    = "within_last"
+	ErrorKeyNotWithinLast = "not_within_last"
+
+	ErrorKeyWithinNext    = "within_next"
+	ErrorKeyNotWithinNext = "not_within_next"
+
+	ErrorKeyOlderThan    = "older_than"
+	ErrorKeyNotOlderThan = "not_older_than"
+
+	ErrorKeyAgeAtLeast    = "age_at_least"
+	ErrorKeyNotAgeAtLeast = "not_age_at_least"
+
+	ErrorKeyWeekday    = "weekday"
+	ErrorKeyNotWeekday = "not_weekday"
+
+	ErrorKeyTimeOfDayBetween    = "time_of_day_between"
+	ErrorKeyNotTimeOfDayBetween = "not_time_of_day_between"
+
+	ErrorKeySameDay    = "same_day"
+	ErrorKeyNotSameDay = "not_same_day"
+
+	ErrorKeySameMonth    = "same_month"
+	ErrorKeyNotSameMonth = "not_same_month"
+
+	ErrorKeyInLocation    = "in_location"
+	ErrorKeyNotInLocation = "not_in_location"
+
+	ErrorKeyExcludedDate    = "excluded_date"
+	ErrorKeyNotExcludedDate = "not_excluded_date"
+
+	ErrorKeyBusinessDay    = "business_day"
+	ErrorKeyNotBusinessDay = "not_business_day"
+
+	WeekdayKeySunday    = "weekday_sunday"
+	WeekdayKeyMonday    = "weekday_monday"
+	WeekdayKeyTuesday   = "weekday_tuesday"
+	WeekdayKeyWednesday = "weekday_wednesday"
+	WeekdayKeyThursday  = "weekday_thursday"
+	WeekdayKeyFriday    = "weekday_friday"
+	WeekdayKeySaturday  = "weekday_saturday"
+
+	LayoutKeyDate             = "layout_date"
+	LayoutKeyTimeOfDay        = "layout_time_of_day"
+	LayoutKeyTimeOfDaySeconds = "layout_time_of_day_seconds"
+
+	ErrorKeyValidDate    = "valid_date"
+	ErrorKeyNotValidDate = "not_valid_date"
+
+	ErrorKeyValidTimeOfDay    = "valid_time_of_day"
+	ErrorKeyNotValidTimeOfDay = "not_valid_time_of_day"
+
+	ErrorKeyLeapDay    = "leap_day"
+	ErrorKeyNotLeapDay = "not_leap_day"
+
+	ErrorKeyTime    = "time"
+	ErrorKeyNotTime = "not_time"
+
+	ErrorKeyRFC3339    = "rfc3339"
+	ErrorKeyNotRFC3339 = "not_rfc3339"
+
+	ErrorKeyISO8601Date    = "iso8601_date"
+	ErrorKeyNotISO8601Date = "not_iso8601_date"
+
+	ErrorKeyISO8601Duration    = "iso8601_duration"
+	ErrorKeyNotISO8601Duration = "not_iso8601_duration"
+
+	ErrorKeyUnixTimestamp    = "unix_timestamp"
+	ErrorKeyNotUnixTimestamp = "not_unix_timestamp"
+
+	ErrorKeyStartBeforeEnd    = "start_before_end"
+	ErrorKeyNotStartBeforeEnd = "not_start_before_end"
+
+	ErrorKeyMaxSpan    = "max_span"
+	ErrorKeyNotMaxSpan = "not_max_span"
+
+	ErrorKeyMinSpan    = "min_span"
+	ErrorKeyNotMinSpan = "not_min_span"
+
+	ErrorKeyAlignedTo    = "aligned_to"
+	ErrorKeyNotAlignedTo = "not_aligned_to"
+
+	ErrorKeyNonOverlapping    = "non_overlapping"
+	ErrorKeyNotNonOverlapping = "not_non_overlapping"
+
+	ErrorKeyContiguous    = "contiguous"
+	ErrorKeyNotContiguous = "not_contiguous"
+
+	ErrorKeySorted    = "sorted"
+	ErrorKeyNotSorted = "not_sorted"
+
 	OrKeyPair   = " or "
 	OrKeyMiddle = "; "
 	OrKeyEnd    = "; or "
@@ -35,7 +35,9 @@ export const docsSidebar = [
       { label: 'Numbers', link: '/validators/numbers/' },
       { label: 'Boolean', link: '/validators/boolean/' },
       { label: 'Time', link: '/validators/time/' },
+      { label: 'Network', link: '/validators/network/' },
       { label: 'Comparable', link: '/validators/comparable/' },
+      { label: 'Ordered', link: '/validators/ordered/' },
       { label: 'Typed & Any', link: '/validators/typed-any/' },
       { label: 'OR Operators (Or / OrElse)', link: '/validators/or-operators/' },
       { label: 'Rule Index', link: '/validators/rule-index/' },
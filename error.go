@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/valyala/fasttemplate"
 )
@@ -24,6 +25,22 @@ type errorTemplate struct {
 	params   map[string]interface{}
 }
 
+// A list of messages joined in a single template param, such as the unmet
+// requirements of a password. The messages are built when the param is
+// rendered, so they use the locale of the validation session.
+type localizedList struct {
+	items []*errorTemplate
+}
+
+// A time formatted in a single template param with a layout defined by the
+// locale of the validation session, such as [LayoutKeyDate]. The default
+// layout is used when the locale doesn't define the layout key.
+type localizedTime struct {
+	time          time.Time
+	layoutKey     string
+	defaultLayout string
+}
+
 type errorTemplateOneOf struct {
 	errorTemplate  *errorTemplate
 	errorTemplates []*errorTemplate // Used for "or" operations
@@ -106,19 +123,41 @@ func (ve *valueError) buildMessageFromTemplate(et *errorTemplate) string {
 		title = *ve.title
 	}
 
-	et.params["name"] = *ve.name
-	et.params["title"] = title
-
 	t := fasttemplate.New(ts, "{{", "}}")
 
-	// Ensure interface{} values are string in order to be handle by fasttemplate
+	// Ensure interface{} values are string in order to be handle by fasttemplate.
+	// The params are rendered into a copy, so localized params are built again
+	// for the locale of each rendering.
+	params := make(map[string]interface{}, len(et.params)+2)
 	for k, v := range et.params {
-		if k != "name" && k != "title" {
-			et.params[k] = fmt.Sprintf("%v", v)
+		if list, ok := v.(*localizedList); ok {
+			params[k] = ve.buildLocalizedList(list)
+		} else if lt, ok := v.(*localizedTime); ok {
+			params[k] = ve.buildLocalizedTime(lt)
+		} else {
+			params[k] = fmt.Sprintf("%v", v)
 		}
 	}
+	params["name"] = *ve.name
+	params["title"] = title
 
-	return t.ExecuteString(et.params)
+	return t.ExecuteString(params)
+}
+
+func (ve *valueError) buildLocalizedList(list *localizedList) string {
+	messages := make([]string, len(list.items))
+	for i, item := range list.items {
+		messages[i] = ve.buildMessageFromTemplate(item)
+	}
+	return strings.Join(messages, ", ")
+}
+
+func (ve *valueError) buildLocalizedTime(lt *localizedTime) string {
+	layout := lt.defaultLayout
+	if _layout, ok := (*ve.validator._locale)[lt.layoutKey]; ok {
+		layout = _layout
+	}
+	return lt.time.Format(layout)
 }
 
 // Return the error message associated with a Valgo error.
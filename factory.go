@@ -10,6 +10,9 @@ type FactoryOptions struct {
 	Locales map[string]*Locale
 	// A function field that allows to set a custom JSON marshaler for [Error]
 	MarshalJsonFunc func(e *Error) ([]byte, error)
+	// A field that allows to set the [Clock] used by the rules relative to the
+	// current time, unless a [Validation] session sets its own
+	Clock Clock
 }
 
 // ValidationFactory is a struct provided by Valgo that enables the creation of
@@ -31,6 +34,7 @@ type ValidationFactory struct {
 	localeCodeDefault string
 	locales           map[string]*Locale
 	marshalJsonFunc   func(e *Error) ([]byte, error)
+	clock             Clock
 }
 
 // This New function allows you to create, through a factory, a new Validation
@@ -64,6 +68,12 @@ func (_factory *ValidationFactory) New(options ...Options) *Validation {
 		finalOptions.MarshalJsonFunc = _factory.marshalJsonFunc
 	}
 
+	if _options != nil && _options.Clock != nil {
+		finalOptions.Clock = _options.Clock
+	} else {
+		finalOptions.Clock = _factory.clock
+	}
+
 	return newValidation(finalOptions)
 }
 
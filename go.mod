@@ -5,6 +5,8 @@ go 1.23.0
 require (
 	github.com/stretchr/testify v1.11.1
 	github.com/valyala/fasttemplate v1.2.2
+	golang.org/x/net v0.43.0
+	golang.org/x/text v0.28.0
 )
 
 require (
@@ -95,6 +95,449 @@ func getLocaleDe() *Locale {
 		ErrorKeyFinite:    "{{title}} muss endlich sein",
 		ErrorKeyNotFinite: "{{title}} darf nicht endlich sein",
 
+		ErrorKeyContains:    "{{title}} muss \"{{value}}\" enthalten",
+		ErrorKeyNotContains: "{{title}} darf \"{{value}}\" nicht enthalten",
+
+		ErrorKeyUnique:    "{{title}} darf keine doppelten Werte enthalten",
+		ErrorKeyNotUnique: "{{title}} muss doppelte Werte enthalten",
+
+		ErrorKeyHasKeys:    "{{title}} muss die Schlüssel \"{{keys}}\" enthalten",
+		ErrorKeyNotHasKeys: "{{title}} darf die Schlüssel \"{{keys}}\" nicht enthalten",
+
+		ErrorKeyOnlyKeys:    "{{title}} darf nur die Schlüssel \"{{keys}}\" enthalten",
+		ErrorKeyNotOnlyKeys: "{{title}} muss andere Schlüssel als \"{{keys}}\" enthalten",
+
+		ErrorKeyMultipleOf:    "{{title}} muss ein Vielfaches von \"{{value}}\" sein",
+		ErrorKeyNotMultipleOf: "{{title}} darf kein Vielfaches von \"{{value}}\" sein",
+
+		ErrorKeyTruncated:    "{{title}} darf nicht genauer als \"{{value}}\" sein",
+		ErrorKeyNotTruncated: "{{title}} muss genauer als \"{{value}}\" sein",
+
+		ErrorKeyDecimal:    "{{title}} muss eine Dezimalzahl sein",
+		ErrorKeyNotDecimal: "{{title}} darf keine Dezimalzahl sein",
+
+		ErrorKeyMaxPrecision:    "{{title}} darf nicht mehr als \"{{precision}}\" Stellen haben",
+		ErrorKeyNotMaxPrecision: "{{title}} muss mehr als \"{{precision}}\" Stellen haben",
+
+		ErrorKeyMaxScale:    "{{title}} darf nicht mehr als \"{{scale}}\" Nachkommastellen haben",
+		ErrorKeyNotMaxScale: "{{title}} muss mehr als \"{{scale}}\" Nachkommastellen haben",
+
+		ErrorKeyScale:    "{{title}} muss genau \"{{scale}}\" Nachkommastellen haben",
+		ErrorKeyNotScale: "{{title}} darf nicht genau \"{{scale}}\" Nachkommastellen haben",
+
+		ErrorKeyNonNegative:    "{{title}} darf nicht negativ sein",
+		ErrorKeyNotNonNegative: "{{title}} muss negativ sein",
+
+		ErrorKeyCurrencyMinorUnits:    "{{title}} ist kein gültiger Betrag für die Währung \"{{currency}}\"",
+		ErrorKeyNotCurrencyMinorUnits: "{{title}} darf kein gültiger Betrag für die Währung \"{{currency}}\" sein",
+		ErrorKeyUnknownCurrency:       "{{title}} kann nicht mit der unbekannten Währung \"{{currency}}\" geprüft werden",
+
+		ErrorKeyIP:    "{{title}} muss eine gültige IP-Adresse sein",
+		ErrorKeyNotIP: "{{title}} darf keine gültige IP-Adresse sein",
+
+		ErrorKeyPrefix:    "{{title}} muss ein gültiges Netzwerkpräfix sein",
+		ErrorKeyNotPrefix: "{{title}} darf kein gültiges Netzwerkpräfix sein",
+
+		ErrorKeyAddrPort:    "{{title}} muss eine gültige IP-Adresse mit Port sein",
+		ErrorKeyNotAddrPort: "{{title}} darf keine gültige IP-Adresse mit Port sein",
+
+		ErrorKeyIPv4:    "{{title}} muss IPv4 sein",
+		ErrorKeyNotIPv4: "{{title}} darf nicht IPv4 sein",
+
+		ErrorKeyIPv6:    "{{title}} muss IPv6 sein",
+		ErrorKeyNotIPv6: "{{title}} darf nicht IPv6 sein",
+
+		ErrorKeyPrivate:    "{{title}} muss privat sein",
+		ErrorKeyNotPrivate: "{{title}} darf nicht privat sein",
+
+		ErrorKeyLoopback:    "{{title}} muss Loopback sein",
+		ErrorKeyNotLoopback: "{{title}} darf nicht Loopback sein",
+
+		ErrorKeyGlobal:    "{{title}} muss eine öffentliche Adresse sein",
+		ErrorKeyNotGlobal: "{{title}} darf keine öffentliche Adresse sein",
+
+		ErrorKeyMulticast:    "{{title}} muss Multicast sein",
+		ErrorKeyNotMulticast: "{{title}} darf nicht Multicast sein",
+
+		ErrorKeyMasked:    "{{title}} darf nach der Präfixlänge keine gesetzten Bits haben",
+		ErrorKeyNotMasked: "{{title}} muss nach der Präfixlänge gesetzte Bits haben",
+
+		ErrorKeyInPrefix:    "{{title}} muss innerhalb von \"{{prefix}}\" liegen",
+		ErrorKeyNotInPrefix: "{{title}} darf nicht innerhalb von \"{{prefix}}\" liegen",
+
+		ErrorKeyOutsidePrefixes:    "{{title}} darf nicht innerhalb von \"{{prefixes}}\" liegen",
+		ErrorKeyNotOutsidePrefixes: "{{title}} muss innerhalb von \"{{prefixes}}\" liegen",
+
+		ErrorKeyPortBetween:    "{{title}} muss einen Port zwischen \"{{min}}\" und \"{{max}}\" haben",
+		ErrorKeyNotPortBetween: "{{title}} darf keinen Port zwischen \"{{min}}\" und \"{{max}}\" haben",
+
+		ErrorKeyURL:    "{{title}} muss eine gültige URL sein",
+		ErrorKeyNotURL: "{{title}} darf keine gültige URL sein",
+
+		ErrorKeyAbsoluteURL:    "{{title}} muss eine absolute URL sein",
+		ErrorKeyNotAbsoluteURL: "{{title}} darf keine absolute URL sein",
+
+		ErrorKeySchemeIn:    "{{title}} muss eines der Schemata \"{{schemes}}\" verwenden",
+		ErrorKeyNotSchemeIn: "{{title}} darf keines der Schemata \"{{schemes}}\" verwenden",
+
+		ErrorKeyHostIn:    "{{title}} muss einen der Hosts \"{{hosts}}\" haben",
+		ErrorKeyNotHostIn: "{{title}} darf keinen der Hosts \"{{hosts}}\" haben",
+
+		ErrorKeyWithoutUserInfo:    "{{title}} darf keine Benutzerinformationen enthalten",
+		ErrorKeyNotWithoutUserInfo: "{{title}} muss Benutzerinformationen enthalten",
+
+		ErrorKeyWithoutFragment:    "{{title}} darf kein Fragment enthalten",
+		ErrorKeyNotWithoutFragment: "{{title}} muss ein Fragment enthalten",
+
+		ErrorKeyPathPrefix:    "{{title}} muss einen Pfad haben, der mit \"{{prefix}}\" beginnt",
+		ErrorKeyNotPathPrefix: "{{title}} darf keinen Pfad haben, der mit \"{{prefix}}\" beginnt",
+
+		ErrorKeyExternalHost:    "{{title}} darf nicht auf einen internen Host verweisen",
+		ErrorKeyNotExternalHost: "{{title}} muss auf einen internen Host verweisen",
+
+		ErrorKeyEmail:    "{{title}} muss eine gültige E-Mail-Adresse sein",
+		ErrorKeyNotEmail: "{{title}} darf keine gültige E-Mail-Adresse sein",
+
+		ErrorKeyEmailDomainIn:    "{{title}} muss eine der Domains \"{{domains}}\" verwenden",
+		ErrorKeyNotEmailDomainIn: "{{title}} darf keine der Domains \"{{domains}}\" verwenden",
+
+		ErrorKeyEmailDomainExcluded:    "{{title}} darf keine der Domains \"{{domains}}\" verwenden",
+		ErrorKeyNotEmailDomainExcluded: "{{title}} muss eine der Domains \"{{domains}}\" verwenden",
+
+		ErrorKeyUUID:    "{{title}} muss eine gültige UUID sein",
+		ErrorKeyNotUUID: "{{title}} darf keine gültige UUID sein",
+
+		ErrorKeyUUIDVersion:    "{{title}} muss eine UUID der Version \"{{versions}}\" sein",
+		ErrorKeyNotUUIDVersion: "{{title}} darf keine UUID der Version \"{{versions}}\" sein",
+
+		ErrorKeyULID:    "{{title}} muss eine gültige ULID sein",
+		ErrorKeyNotULID: "{{title}} darf keine gültige ULID sein",
+
+		ErrorKeyKSUID:    "{{title}} muss eine gültige KSUID sein",
+		ErrorKeyNotKSUID: "{{title}} darf keine gültige KSUID sein",
+
+		ErrorKeyHex:    "{{title}} muss eine hexadezimale Zeichenkette sein",
+		ErrorKeyNotHex: "{{title}} darf keine hexadezimale Zeichenkette sein",
+
+		ErrorKeyHexLength:    "{{title}} muss eine hexadezimale Zeichenkette mit \"{{length}}\" Zeichen sein",
+		ErrorKeyNotHexLength: "{{title}} darf keine hexadezimale Zeichenkette mit \"{{length}}\" Zeichen sein",
+
+		ErrorKeyBase64:    "{{title}} muss eine gültige Base64-Zeichenkette sein",
+		ErrorKeyNotBase64: "{{title}} darf keine gültige Base64-Zeichenkette sein",
+
+		ErrorKeyBase32:    "{{title}} muss eine gültige Base32-Zeichenkette sein",
+		ErrorKeyNotBase32: "{{title}} darf keine gültige Base32-Zeichenkette sein",
+
+		ErrorKeyLuhn:    "{{title}} muss eine gültige Luhn-Nummer sein",
+		ErrorKeyNotLuhn: "{{title}} darf keine gültige Luhn-Nummer sein",
+
+		ErrorKeyCreditCard:    "{{title}} muss eine gültige Kreditkartennummer sein",
+		ErrorKeyNotCreditCard: "{{title}} darf keine gültige Kreditkartennummer sein",
+
+		ErrorKeyCreditCardBrand:    "{{title}} muss eine Karte der Marken \"{{brands}}\" sein",
+		ErrorKeyNotCreditCardBrand: "{{title}} darf keine Karte der Marken \"{{brands}}\" sein",
+
+		ErrorKeyIBAN:    "{{title}} muss eine gültige IBAN sein",
+		ErrorKeyNotIBAN: "{{title}} darf keine gültige IBAN sein",
+
+		ErrorKeyIBANCountry:    "{{title}} muss eine IBAN der Länder \"{{countries}}\" sein",
+		ErrorKeyNotIBANCountry: "{{title}} darf keine IBAN der Länder \"{{countries}}\" sein",
+
+		ErrorKeyBIC:    "{{title}} muss ein gültiger BIC sein",
+		ErrorKeyNotBIC: "{{title}} darf kein gültiger BIC sein",
+
+		ErrorKeyISBN10:    "{{title}} muss eine gültige ISBN-10 sein",
+		ErrorKeyNotISBN10: "{{title}} darf keine gültige ISBN-10 sein",
+
+		ErrorKeyISBN13:    "{{title}} muss eine gültige ISBN-13 sein",
+		ErrorKeyNotISBN13: "{{title}} darf keine gültige ISBN-13 sein",
+
+		ErrorKeyEAN13:    "{{title}} muss eine gültige EAN-13 sein",
+		ErrorKeyNotEAN13: "{{title}} darf keine gültige EAN-13 sein",
+
+		ErrorKeyISSN:    "{{title}} muss eine gültige ISSN sein",
+		ErrorKeyNotISSN: "{{title}} darf keine gültige ISSN sein",
+
+		ErrorKeyChecksum:    "{{title}} hat eine ungültige Prüfziffer",
+		ErrorKeyNotChecksum: "{{title}} muss eine ungültige Prüfziffer haben",
+
+		ErrorKeyPhone:    "{{title}} muss eine gültige Telefonnummer im internationalen Format sein",
+		ErrorKeyNotPhone: "{{title}} darf keine gültige Telefonnummer im internationalen Format sein",
+
+		ErrorKeyPhoneForRegion:    "{{title}} muss eine gültige Telefonnummer für {{region}} sein",
+		ErrorKeyNotPhoneForRegion: "{{title}} darf keine gültige Telefonnummer für {{region}} sein",
+
+		ErrorKeySemVer:    "{{title}} muss eine gültige semantische Version sein",
+		ErrorKeyNotSemVer: "{{title}} darf keine gültige semantische Version sein",
+
+		ErrorKeySatisfiesConstraint:    "{{title}} muss die Versionsbedingung \"{{constraint}}\" erfüllen",
+		ErrorKeyNotSatisfiesConstraint: "{{title}} darf die Versionsbedingung \"{{constraint}}\" nicht erfüllen",
+		ErrorKeyMalformedConstraint:    "{{title}} kann nicht mit der fehlerhaften Versionsbedingung \"{{constraint}}\" geprüft werden",
+
+		ErrorKeySemVerGreaterThan:    "{{title}} muss eine Version größer als \"{{version}}\" sein",
+		ErrorKeyNotSemVerGreaterThan: "{{title}} darf keine Version größer als \"{{version}}\" sein",
+
+		ErrorKeySemVerBetween:    "{{title}} muss eine Version zwischen \"{{min}}\" und \"{{max}}\" sein",
+		ErrorKeyNotSemVerBetween: "{{title}} darf keine Version zwischen \"{{min}}\" und \"{{max}}\" sein",
+
+		ErrorKeyJSON:    "{{title}} muss gültiges JSON sein, Fehler in Zeile {{line}}, Spalte {{column}}",
+		ErrorKeyNotJSON: "{{title}} darf kein gültiges JSON sein",
+
+		ErrorKeyJSONObject:    "{{title}} muss ein JSON-Objekt sein",
+		ErrorKeyNotJSONObject: "{{title}} darf kein JSON-Objekt sein",
+
+		ErrorKeyJSONArray:    "{{title}} muss ein JSON-Array sein",
+		ErrorKeyNotJSONArray: "{{title}} darf kein JSON-Array sein",
+
+		ErrorKeyRegexp:    "{{title}} muss ein gültiger regulärer Ausdruck sein, Fehler in Zeile {{line}}, Spalte {{column}}",
+		ErrorKeyNotRegexp: "{{title}} darf kein gültiger regulärer Ausdruck sein",
+
+		ErrorKeyGoTemplate:    "{{title}} muss ein gültiges Go-Template sein, Fehler in Zeile {{line}}",
+		ErrorKeyNotGoTemplate: "{{title}} darf kein gültiges Go-Template sein",
+
+		ErrorKeyPassword:    "{{title}} muss {{requirements}}",
+		ErrorKeyNotPassword: "{{title}} darf die Passwortanforderungen nicht erfüllen",
+
+		ErrorKeyPasswordMinLength:   "mindestens {{min}} Zeichen haben",
+		ErrorKeyPasswordLowercase:   "einen Kleinbuchstaben enthalten",
+		ErrorKeyPasswordUppercase:   "einen Großbuchstaben enthalten",
+		ErrorKeyPasswordDigit:       "eine Ziffer enthalten",
+		ErrorKeyPasswordSymbol:      "ein Sonderzeichen enthalten",
+		ErrorKeyPasswordMaxRepeated: "kein Zeichen mehr als {{max}} Mal hintereinander wiederholen",
+		ErrorKeyPasswordEntropy:     "weniger vorhersehbar sein",
+		ErrorKeyPasswordCommon:      "kein gängiges Passwort sein",
+
+		ErrorKeyPrintable:    "{{title}} darf nur druckbare Zeichen enthalten",
+		ErrorKeyNotPrintable: "{{title}} darf nicht nur druckbare Zeichen enthalten",
+
+		ErrorKeyWithoutControlChars:    "{{title}} darf keine Steuerzeichen enthalten",
+		ErrorKeyNotWithoutControlChars: "{{title}} muss Steuerzeichen enthalten",
+
+		ErrorKeyNormalizedNFC:    "{{title}} muss in der Unicode-Normalisierungsform NFC sein",
+		ErrorKeyNotNormalizedNFC: "{{title}} darf nicht in der Unicode-Normalisierungsform NFC sein",
+
+		ErrorKeySingleScript:    "{{title}} darf keine Zeichen verschiedener Schriften mischen",
+		ErrorKeyNotSingleScript: "{{title}} muss Zeichen verschiedener Schriften mischen",
+
+		ErrorKeyScriptIn:    "{{title}} darf nur Zeichen der Schriften {{scripts}} enthalten",
+		ErrorKeyNotScriptIn: "{{title}} darf nicht nur Zeichen der Schriften {{scripts}} enthalten",
+
+		ErrorKeyWithoutConfusables:    "{{title}} darf keine Zeichen enthalten, die wie lateinische Buchstaben aussehen",
+		ErrorKeyNotWithoutConfusables: "{{title}} muss Zeichen enthalten, die wie lateinische Buchstaben aussehen",
+
+		ErrorKeySingleLine:    "{{title}} muss eine einzelne Zeile sein",
+		ErrorKeyNotSingleLine: "{{title}} darf keine einzelne Zeile sein",
+
+		ErrorKeyLowercase:    "{{title}} darf keine Großbuchstaben enthalten",
+		ErrorKeyNotLowercase: "{{title}} muss Großbuchstaben enthalten",
+
+		ErrorKeyUppercase:    "{{title}} darf keine Kleinbuchstaben enthalten",
+		ErrorKeyNotUppercase: "{{title}} muss Kleinbuchstaben enthalten",
+
+		ErrorKeySlug:    "{{title}} muss ein Slug aus Kleinbuchstaben, Ziffern und Bindestrichen sein",
+		ErrorKeyNotSlug: "{{title}} darf kein Slug sein",
+
+		ErrorKeySnakeCase:    "{{title}} muss in snake_case sein",
+		ErrorKeyNotSnakeCase: "{{title}} darf nicht in snake_case sein",
+
+		ErrorKeyKebabCase:    "{{title}} muss in kebab-case sein",
+		ErrorKeyNotKebabCase: "{{title}} darf nicht in kebab-case sein",
+
+		ErrorKeyCamelCase:    "{{title}} muss in camelCase sein",
+		ErrorKeyNotCamelCase: "{{title}} darf nicht in camelCase sein",
+
+		ErrorKeyPascalCase:    "{{title}} muss in PascalCase sein",
+		ErrorKeyNotPascalCase: "{{title}} darf nicht in PascalCase sein",
+
+		ErrorKeyGoIdentifier:    "{{title}} muss ein gültiger Go-Bezeichner sein",
+		ErrorKeyNotGoIdentifier: "{{title}} darf kein gültiger Go-Bezeichner sein",
+
+		ErrorKeyAlpha:    "{{title}} darf nur Buchstaben von a bis z enthalten",
+		ErrorKeyNotAlpha: "{{title}} darf nicht nur Buchstaben von a bis z enthalten",
+
+		ErrorKeyAlphaUnicode:    "{{title}} darf nur Buchstaben enthalten",
+		ErrorKeyNotAlphaUnicode: "{{title}} darf nicht nur Buchstaben enthalten",
+
+		ErrorKeyAlphanumeric:    "{{title}} darf nur Buchstaben von a bis z und Ziffern enthalten",
+		ErrorKeyNotAlphanumeric: "{{title}} darf nicht nur Buchstaben von a bis z und Ziffern enthalten",
+
+		ErrorKeyAlphanumericUnicode:    "{{title}} darf nur Buchstaben und Ziffern enthalten",
+		ErrorKeyNotAlphanumericUnicode: "{{title}} darf nicht nur Buchstaben und Ziffern enthalten",
+
+		ErrorKeyNumeric:    "{{title}} darf nur Ziffern von 0 bis 9 enthalten",
+		ErrorKeyNotNumeric: "{{title}} darf nicht nur Ziffern von 0 bis 9 enthalten",
+
+		ErrorKeyNumericUnicode:    "{{title}} darf nur Ziffern enthalten",
+		ErrorKeyNotNumericUnicode: "{{title}} darf nicht nur Ziffern enthalten",
+
+		ErrorKeyASCII:    "{{title}} darf nur ASCII-Zeichen enthalten",
+		ErrorKeyNotASCII: "{{title}} darf nicht nur ASCII-Zeichen enthalten",
+
+		ErrorKeyHasPrefix:    "{{title}} muss mit \"{{value}}\" beginnen",
+		ErrorKeyNotHasPrefix: "{{title}} darf nicht mit \"{{value}}\" beginnen",
+
+		ErrorKeyHasSuffix:    "{{title}} muss mit \"{{value}}\" enden",
+		ErrorKeyNotHasSuffix: "{{title}} darf nicht mit \"{{value}}\" enden",
+
+		ErrorKeyContainsAny:    "{{title}} muss mindestens eines der Zeichen \"{{value}}\" enthalten",
+		ErrorKeyNotContainsAny: "{{title}} darf keines der Zeichen \"{{value}}\" enthalten",
+
+		ErrorKeyExcludesAll:    "{{title}} darf keines der Zeichen \"{{value}}\" enthalten",
+		ErrorKeyNotExcludesAll: "{{title}} muss mindestens eines der Zeichen \"{{value}}\" enthalten",
+
+		ErrorKeyContainsFold:    "{{title}} muss \"{{value}}\" enthalten, ohne Beachtung der Groß- und Kleinschreibung",
+		ErrorKeyNotContainsFold: "{{title}} darf \"{{value}}\" nicht enthalten, ohne Beachtung der Groß- und Kleinschreibung",
+
+		ErrorKeyEqualToFold:    "{{title}} muss gleich \"{{value}}\" sein, ohne Beachtung der Groß- und Kleinschreibung",
+		ErrorKeyNotEqualToFold: "{{title}} darf nicht gleich \"{{value}}\" sein, ohne Beachtung der Groß- und Kleinschreibung",
+
+		ErrorKeyWordCountBetween:    "{{title}} muss zwischen \"{{min}}\" und \"{{max}}\" Wörter haben",
+		ErrorKeyNotWordCountBetween: "{{title}} darf nicht zwischen \"{{min}}\" und \"{{max}}\" Wörter haben",
+
+		ErrorKeyHostname:    "{{title}} muss ein gültiger Hostname sein",
+		ErrorKeyNotHostname: "{{title}} darf kein gültiger Hostname sein",
+
+		ErrorKeyFQDN:    "{{title}} muss ein vollqualifizierter Domainname sein",
+		ErrorKeyNotFQDN: "{{title}} darf kein vollqualifizierter Domainname sein",
+
+		ErrorKeyDomainWithTLD:    "{{title}} muss ein Domainname mit einer Top-Level-Domain sein",
+		ErrorKeyNotDomainWithTLD: "{{title}} darf kein Domainname mit einer Top-Level-Domain sein",
+
+		ErrorKeyHostPort:    "{{title}} muss ein Host und ein Port sein, wie \"example.com:443\"",
+		ErrorKeyNotHostPort: "{{title}} darf kein Host und Port sein",
+
+		ErrorKeyPort:    "{{title}} muss eine Portnummer zwischen 1 und 65535 sein",
+		ErrorKeyNotPort: "{{title}} darf keine Portnummer zwischen 1 und 65535 sein",
+
+		ErrorKeyPunycodeHostname:    "{{title}} muss ein gültiger Hostname mit internationalisierten Labels in Punycode sein",
+		ErrorKeyNotPunycodeHostname: "{{title}} darf kein gültiger Hostname mit internationalisierten Labels in Punycode sein",
+
+		ErrorKeyHostnameLabel:    "{{title}} hat ein ungültiges Label \"{{label}}\"",
+		ErrorKeyNotHostnameLabel: "{{title}} muss ein ungültiges Label haben",
+
+		ErrorKeyHostnameEmptyLabel:    "{{title}} darf keine leeren Labels haben",
+		ErrorKeyNotHostnameEmptyLabel: "{{title}} muss leere Labels haben",
+
+		ErrorKeyHostnameLabelLength:    "{{title}} hat das Label \"{{label}}\" mit mehr als {{max}} Zeichen",
+		ErrorKeyNotHostnameLabelLength: "{{title}} muss ein Label mit mehr als {{max}} Zeichen haben",
+
+		ErrorKeyHostnameLength:    "{{title}} darf nicht länger als {{max}} Zeichen sein",
+		ErrorKeyNotHostnameLength: "{{title}} muss länger als {{max}} Zeichen sein",
+
+		ErrorKeyOneOfRanges:    "{{title}} muss in einem der Bereiche {{ranges}} liegen",
+		ErrorKeyNotOneOfRanges: "{{title}} darf in keinem der Bereiche {{ranges}} liegen",
+
+		ErrorKeyEven:    "{{title}} muss eine gerade Zahl sein",
+		ErrorKeyNotEven: "{{title}} darf keine gerade Zahl sein",
+
+		ErrorKeyOdd:    "{{title}} muss eine ungerade Zahl sein",
+		ErrorKeyNotOdd: "{{title}} darf keine ungerade Zahl sein",
+
+		ErrorKeyPowerOfTwo:    "{{title}} muss eine Zweierpotenz sein",
+		ErrorKeyNotPowerOfTwo: "{{title}} darf keine Zweierpotenz sein",
+
+		ErrorKeyEqualWithin:    "{{title}} muss mit einer Toleranz von \"{{epsilon}}\" gleich \"{{value}}\" sein",
+		ErrorKeyNotEqualWithin: "{{title}} darf mit einer Toleranz von \"{{epsilon}}\" nicht gleich \"{{value}}\" sein",
+
+		ErrorKeyFitsIn:    "{{title}} muss in den Typ \"{{type}}\" passen",
+		ErrorKeyNotFitsIn: "{{title}} darf nicht in den Typ \"{{type}}\" passen",
+
+		ErrorKeyInFuture:    "{{title}} muss in der Zukunft liegen",
+		ErrorKeyNotInFuture: "{{title}} darf nicht in der Zukunft liegen",
+
+		ErrorKeyInPast:    "{{title}} muss in der Vergangenheit liegen",
+		ErrorKeyNotInPast: "{{title}} darf nicht in der Vergangenheit liegen",
+
+		ErrorKeyWithinLast:    "{{title}} muss innerhalb der letzten \"{{duration}}\" liegen",
+		ErrorKeyNotWithinLast: "{{title}} darf nicht innerhalb der letzten \"{{duration}}\" liegen",
+
+		ErrorKeyWithinNext:    "{{title}} muss innerhalb der nächsten \"{{duration}}\" liegen",
+		ErrorKeyNotWithinNext: "{{title}} darf nicht innerhalb der nächsten \"{{duration}}\" liegen",
+
+		ErrorKeyOlderThan:    "{{title}} muss älter als \"{{duration}}\" sein",
+		ErrorKeyNotOlderThan: "{{title}} darf nicht älter als \"{{duration}}\" sein",
+
+		ErrorKeyAgeAtLeast:    "{{title}} muss mindestens {{years}} Jahre zurückliegen",
+		ErrorKeyNotAgeAtLeast: "{{title}} darf nicht mindestens {{years}} Jahre zurückliegen",
+
+		ErrorKeyWeekday:    "{{title}} muss auf {{days}} fallen",
+		ErrorKeyNotWeekday: "{{title}} darf nicht auf {{days}} fallen",
+
+		ErrorKeyTimeOfDayBetween:    "{{title}} muss zwischen \"{{from}}\" und \"{{to}}\" Uhr liegen",
+		ErrorKeyNotTimeOfDayBetween: "{{title}} darf nicht zwischen \"{{from}}\" und \"{{to}}\" Uhr liegen",
+
+		ErrorKeySameDay:    "{{title}} muss am selben Tag wie \"{{date}}\" liegen",
+		ErrorKeyNotSameDay: "{{title}} darf nicht am selben Tag wie \"{{date}}\" liegen",
+
+		ErrorKeySameMonth:    "{{title}} muss im selben Monat wie \"{{date}}\" liegen",
+		ErrorKeyNotSameMonth: "{{title}} darf nicht im selben Monat wie \"{{date}}\" liegen",
+
+		ErrorKeyInLocation:    "{{title}} muss in der Zeitzone \"{{location}}\" sein",
+		ErrorKeyNotInLocation: "{{title}} darf nicht in der Zeitzone \"{{location}}\" sein",
+
+		ErrorKeyExcludedDate:    "{{title}} darf nicht auf den \"{{date}}\" fallen",
+		ErrorKeyNotExcludedDate: "{{title}} muss auf eines der ausgeschlossenen Daten fallen",
+
+		ErrorKeyBusinessDay:    "{{title}} muss ein Werktag sein",
+		ErrorKeyNotBusinessDay: "{{title}} darf kein Werktag sein",
+
+		WeekdayKeySunday:    "Sonntag",
+		WeekdayKeyMonday:    "Montag",
+		WeekdayKeyTuesday:   "Dienstag",
+		WeekdayKeyWednesday: "Mittwoch",
+		WeekdayKeyThursday:  "Donnerstag",
+		WeekdayKeyFriday:    "Freitag",
+		WeekdayKeySaturday:  "Samstag",
+
+		LayoutKeyDate:             "02.01.2006",
+		LayoutKeyTimeOfDay:        "15:04",
+		LayoutKeyTimeOfDaySeconds: "15:04:05",
+
+		ErrorKeyValidDate:    "{{title}} muss ein gültiges Datum sein",
+		ErrorKeyNotValidDate: "{{title}} darf kein gültiges Datum sein",
+
+		ErrorKeyValidTimeOfDay:    "{{title}} muss eine gültige Uhrzeit sein",
+		ErrorKeyNotValidTimeOfDay: "{{title}} darf keine gültige Uhrzeit sein",
+
+		ErrorKeyLeapDay:    "{{title}} muss der 29. Februar sein",
+		ErrorKeyNotLeapDay: "{{title}} darf nicht der 29. Februar sein",
+
+		ErrorKeyTime:    "{{title}} muss eine Zeitangabe im Format \"{{layout}}\" sein",
+		ErrorKeyNotTime: "{{title}} darf keine Zeitangabe im Format \"{{layout}}\" sein",
+
+		ErrorKeyRFC3339:    "{{title}} muss ein RFC-3339-Zeitstempel sein",
+		ErrorKeyNotRFC3339: "{{title}} darf kein RFC-3339-Zeitstempel sein",
+
+		ErrorKeyISO8601Date:    "{{title}} muss ein ISO-8601-Datum sein",
+		ErrorKeyNotISO8601Date: "{{title}} darf kein ISO-8601-Datum sein",
+
+		ErrorKeyISO8601Duration:    "{{title}} muss eine ISO-8601-Dauer sein",
+		ErrorKeyNotISO8601Duration: "{{title}} darf keine ISO-8601-Dauer sein",
+
+		ErrorKeyUnixTimestamp:    "{{title}} muss ein Unix-Zeitstempel sein",
+		ErrorKeyNotUnixTimestamp: "{{title}} darf kein Unix-Zeitstempel sein",
+
+		ErrorKeyStartBeforeEnd:    "{{title}} muss beginnen, bevor es endet",
+		ErrorKeyNotStartBeforeEnd: "{{title}} darf nicht beginnen, bevor es endet",
+
+		ErrorKeyMaxSpan:    "{{title}} darf nicht länger als {{span}} sein",
+		ErrorKeyNotMaxSpan: "{{title}} muss länger als {{span}} sein",
+
+		ErrorKeyMinSpan:    "{{title}} darf nicht kürzer als {{span}} sein",
+		ErrorKeyNotMinSpan: "{{title}} muss kürzer als {{span}} sein",
+
+		ErrorKeyAlignedTo:    "{{title}} muss auf {{duration}} ausgerichtet sein",
+		ErrorKeyNotAlignedTo: "{{title}} darf nicht auf {{duration}} ausgerichtet sein",
+
+		ErrorKeyNonOverlapping:    "{{title}} darf sich nicht mit {{other}} überschneiden",
+		ErrorKeyNotNonOverlapping: "{{title}} muss sich mit {{other}} überschneiden",
+
+		ErrorKeyContiguous:    "{{title}} muss beginnen, wenn {{other}} endet",
+		ErrorKeyNotContiguous: "{{title}} darf nicht beginnen, wenn {{other}} endet",
+
+		ErrorKeySorted:    "{{title}} darf nicht vor {{other}} beginnen",
+		ErrorKeyNotSorted: "{{title}} muss vor {{other}} beginnen",
+
 		OrKeyPair:   " oder ",
 		OrKeyMiddle: "; ",
 		OrKeyEnd:    "; oder ",
@@ -95,6 +95,449 @@ func getLocaleEn() *Locale {
 		ErrorKeyFinite:    "{{title}} must be finite",
 		ErrorKeyNotFinite: "{{title}} must not be finite",
 
+		ErrorKeyContains:    "{{title}} must contain \"{{value}}\"",
+		ErrorKeyNotContains: "{{title}} can't contain \"{{value}}\"",
+
+		ErrorKeyUnique:    "{{title}} must not contain duplicate values",
+		ErrorKeyNotUnique: "{{title}} must contain duplicate values",
+
+		ErrorKeyHasKeys:    "{{title}} must have the keys \"{{keys}}\"",
+		ErrorKeyNotHasKeys: "{{title}} can't have the keys \"{{keys}}\"",
+
+		ErrorKeyOnlyKeys:    "{{title}} can only have the keys \"{{keys}}\"",
+		ErrorKeyNotOnlyKeys: "{{title}} must have keys other than \"{{keys}}\"",
+
+		ErrorKeyMultipleOf:    "{{title}} must be a multiple of \"{{value}}\"",
+		ErrorKeyNotMultipleOf: "{{title}} can't be a multiple of \"{{value}}\"",
+
+		ErrorKeyTruncated:    "{{title}} can't be more precise than \"{{value}}\"",
+		ErrorKeyNotTruncated: "{{title}} must be more precise than \"{{value}}\"",
+
+		ErrorKeyDecimal:    "{{title}} must be a decimal number",
+		ErrorKeyNotDecimal: "{{title}} can't be a decimal number",
+
+		ErrorKeyMaxPrecision:    "{{title}} must not have more than \"{{precision}}\" digits",
+		ErrorKeyNotMaxPrecision: "{{title}} must have more than \"{{precision}}\" digits",
+
+		ErrorKeyMaxScale:    "{{title}} must not have more than \"{{scale}}\" decimal places",
+		ErrorKeyNotMaxScale: "{{title}} must have more than \"{{scale}}\" decimal places",
+
+		ErrorKeyScale:    "{{title}} must have exactly \"{{scale}}\" decimal places",
+		ErrorKeyNotScale: "{{title}} can't have exactly \"{{scale}}\" decimal places",
+
+		ErrorKeyNonNegative:    "{{title}} can't be negative",
+		ErrorKeyNotNonNegative: "{{title}} must be negative",
+
+		ErrorKeyCurrencyMinorUnits:    "{{title}} is not a valid amount for the currency \"{{currency}}\"",
+		ErrorKeyNotCurrencyMinorUnits: "{{title}} can't be a valid amount for the currency \"{{currency}}\"",
+		ErrorKeyUnknownCurrency:       "{{title}} can't be checked against the unknown currency \"{{currency}}\"",
+
+		ErrorKeyIP:    "{{title}} must be a valid IP address",
+		ErrorKeyNotIP: "{{title}} can't be a valid IP address",
+
+		ErrorKeyPrefix:    "{{title}} must be a valid network prefix",
+		ErrorKeyNotPrefix: "{{title}} can't be a valid network prefix",
+
+		ErrorKeyAddrPort:    "{{title}} must be a valid IP address and port",
+		ErrorKeyNotAddrPort: "{{title}} can't be a valid IP address and port",
+
+		ErrorKeyIPv4:    "{{title}} must be IPv4",
+		ErrorKeyNotIPv4: "{{title}} can't be IPv4",
+
+		ErrorKeyIPv6:    "{{title}} must be IPv6",
+		ErrorKeyNotIPv6: "{{title}} can't be IPv6",
+
+		ErrorKeyPrivate:    "{{title}} must be private",
+		ErrorKeyNotPrivate: "{{title}} can't be private",
+
+		ErrorKeyLoopback:    "{{title}} must be loopback",
+		ErrorKeyNotLoopback: "{{title}} can't be loopback",
+
+		ErrorKeyGlobal:    "{{title}} must be a public address",
+		ErrorKeyNotGlobal: "{{title}} can't be a public address",
+
+		ErrorKeyMulticast:    "{{title}} must be multicast",
+		ErrorKeyNotMulticast: "{{title}} can't be multicast",
+
+		ErrorKeyMasked:    "{{title}} must not have bits set after the prefix length",
+		ErrorKeyNotMasked: "{{title}} must have bits set after the prefix length",
+
+		ErrorKeyInPrefix:    "{{title}} must be within \"{{prefix}}\"",
+		ErrorKeyNotInPrefix: "{{title}} can't be within \"{{prefix}}\"",
+
+		ErrorKeyOutsidePrefixes:    "{{title}} can't be within \"{{prefixes}}\"",
+		ErrorKeyNotOutsidePrefixes: "{{title}} must be within \"{{prefixes}}\"",
+
+		ErrorKeyPortBetween:    "{{title}} must have a port between \"{{min}}\" and \"{{max}}\"",
+		ErrorKeyNotPortBetween: "{{title}} can't have a port between \"{{min}}\" and \"{{max}}\"",
+
+		ErrorKeyURL:    "{{title}} must be a valid URL",
+		ErrorKeyNotURL: "{{title}} can't be a valid URL",
+
+		ErrorKeyAbsoluteURL:    "{{title}} must be an absolute URL",
+		ErrorKeyNotAbsoluteURL: "{{title}} can't be an absolute URL",
+
+		ErrorKeySchemeIn:    "{{title}} must use one of the schemes \"{{schemes}}\"",
+		ErrorKeyNotSchemeIn: "{{title}} can't use any of the schemes \"{{schemes}}\"",
+
+		ErrorKeyHostIn:    "{{title}} must have one of the hosts \"{{hosts}}\"",
+		ErrorKeyNotHostIn: "{{title}} can't have any of the hosts \"{{hosts}}\"",
+
+		ErrorKeyWithoutUserInfo:    "{{title}} can't contain user information",
+		ErrorKeyNotWithoutUserInfo: "{{title}} must contain user information",
+
+		ErrorKeyWithoutFragment:    "{{title}} can't contain a fragment",
+		ErrorKeyNotWithoutFragment: "{{title}} must contain a fragment",
+
+		ErrorKeyPathPrefix:    "{{title}} must have a path starting with \"{{prefix}}\"",
+		ErrorKeyNotPathPrefix: "{{title}} can't have a path starting with \"{{prefix}}\"",
+
+		ErrorKeyExternalHost:    "{{title}} can't point to an internal host",
+		ErrorKeyNotExternalHost: "{{title}} must point to an internal host",
+
+		ErrorKeyEmail:    "{{title}} must be a valid email address",
+		ErrorKeyNotEmail: "{{title}} can't be a valid email address",
+
+		ErrorKeyEmailDomainIn:    "{{title}} must use one of the domains \"{{domains}}\"",
+		ErrorKeyNotEmailDomainIn: "{{title}} can't use any of the domains \"{{domains}}\"",
+
+		ErrorKeyEmailDomainExcluded:    "{{title}} can't use any of the domains \"{{domains}}\"",
+		ErrorKeyNotEmailDomainExcluded: "{{title}} must use one of the domains \"{{domains}}\"",
+
+		ErrorKeyUUID:    "{{title}} must be a valid UUID",
+		ErrorKeyNotUUID: "{{title}} can't be a valid UUID",
+
+		ErrorKeyUUIDVersion:    "{{title}} must be a UUID of version \"{{versions}}\"",
+		ErrorKeyNotUUIDVersion: "{{title}} can't be a UUID of version \"{{versions}}\"",
+
+		ErrorKeyULID:    "{{title}} must be a valid ULID",
+		ErrorKeyNotULID: "{{title}} can't be a valid ULID",
+
+		ErrorKeyKSUID:    "{{title}} must be a valid KSUID",
+		ErrorKeyNotKSUID: "{{title}} can't be a valid KSUID",
+
+		ErrorKeyHex:    "{{title}} must be a hexadecimal string",
+		ErrorKeyNotHex: "{{title}} can't be a hexadecimal string",
+
+		ErrorKeyHexLength:    "{{title}} must be a hexadecimal string of \"{{length}}\" characters",
+		ErrorKeyNotHexLength: "{{title}} can't be a hexadecimal string of \"{{length}}\" characters",
+
+		ErrorKeyBase64:    "{{title}} must be a valid Base64 string",
+		ErrorKeyNotBase64: "{{title}} can't be a valid Base64 string",
+
+		ErrorKeyBase32:    "{{title}} must be a valid Base32 string",
+		ErrorKeyNotBase32: "{{title}} can't be a valid Base32 string",
+
+		ErrorKeyLuhn:    "{{title}} must be a valid Luhn number",
+		ErrorKeyNotLuhn: "{{title}} can't be a valid Luhn number",
+
+		ErrorKeyCreditCard:    "{{title}} must be a valid credit card number",
+		ErrorKeyNotCreditCard: "{{title}} can't be a valid credit card number",
+
+		ErrorKeyCreditCardBrand:    "{{title}} must be a card of the brands \"{{brands}}\"",
+		ErrorKeyNotCreditCardBrand: "{{title}} can't be a card of the brands \"{{brands}}\"",
+
+		ErrorKeyIBAN:    "{{title}} must be a valid IBAN",
+		ErrorKeyNotIBAN: "{{title}} can't be a valid IBAN",
+
+		ErrorKeyIBANCountry:    "{{title}} must be an IBAN of the countries \"{{countries}}\"",
+		ErrorKeyNotIBANCountry: "{{title}} can't be an IBAN of the countries \"{{countries}}\"",
+
+		ErrorKeyBIC:    "{{title}} must be a valid BIC",
+		ErrorKeyNotBIC: "{{title}} can't be a valid BIC",
+
+		ErrorKeyISBN10:    "{{title}} must be a valid ISBN-10",
+		ErrorKeyNotISBN10: "{{title}} can't be a valid ISBN-10",
+
+		ErrorKeyISBN13:    "{{title}} must be a valid ISBN-13",
+		ErrorKeyNotISBN13: "{{title}} can't be a valid ISBN-13",
+
+		ErrorKeyEAN13:    "{{title}} must be a valid EAN-13",
+		ErrorKeyNotEAN13: "{{title}} can't be a valid EAN-13",
+
+		ErrorKeyISSN:    "{{title}} must be a valid ISSN",
+		ErrorKeyNotISSN: "{{title}} can't be a valid ISSN",
+
+		ErrorKeyChecksum:    "{{title}} has an invalid check digit",
+		ErrorKeyNotChecksum: "{{title}} must have an invalid check digit",
+
+		ErrorKeyPhone:    "{{title}} must be a valid phone number in international format",
+		ErrorKeyNotPhone: "{{title}} can't be a valid phone number in international format",
+
+		ErrorKeyPhoneForRegion:    "{{title}} must be a valid phone number for {{region}}",
+		ErrorKeyNotPhoneForRegion: "{{title}} can't be a valid phone number for {{region}}",
+
+		ErrorKeySemVer:    "{{title}} must be a valid semantic version",
+		ErrorKeyNotSemVer: "{{title}} can't be a valid semantic version",
+
+		ErrorKeySatisfiesConstraint:    "{{title}} must satisfy the version constraint \"{{constraint}}\"",
+		ErrorKeyNotSatisfiesConstraint: "{{title}} can't satisfy the version constraint \"{{constraint}}\"",
+		ErrorKeyMalformedConstraint:    "{{title}} can't be checked against the malformed version constraint \"{{constraint}}\"",
+
+		ErrorKeySemVerGreaterThan:    "{{title}} must be a version greater than \"{{version}}\"",
+		ErrorKeyNotSemVerGreaterThan: "{{title}} can't be a version greater than \"{{version}}\"",
+
+		ErrorKeySemVerBetween:    "{{title}} must be a version between \"{{min}}\" and \"{{max}}\"",
+		ErrorKeyNotSemVerBetween: "{{title}} can't be a version between \"{{min}}\" and \"{{max}}\"",
+
+		ErrorKeyJSON:    "{{title}} must be valid JSON, error at line {{line}}, column {{column}}",
+		ErrorKeyNotJSON: "{{title}} can't be valid JSON",
+
+		ErrorKeyJSONObject:    "{{title}} must be a JSON object",
+		ErrorKeyNotJSONObject: "{{title}} can't be a JSON object",
+
+		ErrorKeyJSONArray:    "{{title}} must be a JSON array",
+		ErrorKeyNotJSONArray: "{{title}} can't be a JSON array",
+
+		ErrorKeyRegexp:    "{{title}} must be a valid regular expression, error at line {{line}}, column {{column}}",
+		ErrorKeyNotRegexp: "{{title}} can't be a valid regular expression",
+
+		ErrorKeyGoTemplate:    "{{title}} must be a valid Go template, error at line {{line}}",
+		ErrorKeyNotGoTemplate: "{{title}} can't be a valid Go template",
+
+		ErrorKeyPassword:    "{{title}} must {{requirements}}",
+		ErrorKeyNotPassword: "{{title}} can't meet the password requirements",
+
+		ErrorKeyPasswordMinLength:   "have at least {{min}} characters",
+		ErrorKeyPasswordLowercase:   "contain a lowercase letter",
+		ErrorKeyPasswordUppercase:   "contain an uppercase letter",
+		ErrorKeyPasswordDigit:       "contain a digit",
+		ErrorKeyPasswordSymbol:      "contain a symbol",
+		ErrorKeyPasswordMaxRepeated: "not repeat a character more than {{max}} times in a row",
+		ErrorKeyPasswordEntropy:     "be less predictable",
+		ErrorKeyPasswordCommon:      "not be a common password",
+
+		ErrorKeyPrintable:    "{{title}} must only contain printable characters",
+		ErrorKeyNotPrintable: "{{title}} can't only contain printable characters",
+
+		ErrorKeyWithoutControlChars:    "{{title}} must not contain control characters",
+		ErrorKeyNotWithoutControlChars: "{{title}} must contain control characters",
+
+		ErrorKeyNormalizedNFC:    "{{title}} must be in Unicode normalization form NFC",
+		ErrorKeyNotNormalizedNFC: "{{title}} can't be in Unicode normalization form NFC",
+
+		ErrorKeySingleScript:    "{{title}} must not mix characters of different scripts",
+		ErrorKeyNotSingleScript: "{{title}} must mix characters of different scripts",
+
+		ErrorKeyScriptIn:    "{{title}} must only contain characters of the scripts {{scripts}}",
+		ErrorKeyNotScriptIn: "{{title}} can't only contain characters of the scripts {{scripts}}",
+
+		ErrorKeyWithoutConfusables:    "{{title}} must not contain characters that look like Latin letters",
+		ErrorKeyNotWithoutConfusables: "{{title}} must contain characters that look like Latin letters",
+
+		ErrorKeySingleLine:    "{{title}} must be a single line",
+		ErrorKeyNotSingleLine: "{{title}} can't be a single line",
+
+		ErrorKeyLowercase:    "{{title}} must not contain uppercase letters",
+		ErrorKeyNotLowercase: "{{title}} must contain uppercase letters",
+
+		ErrorKeyUppercase:    "{{title}} must not contain lowercase letters",
+		ErrorKeyNotUppercase: "{{title}} must contain lowercase letters",
+
+		ErrorKeySlug:    "{{title}} must be a slug of lowercase letters, digits and hyphens",
+		ErrorKeyNotSlug: "{{title}} can't be a slug",
+
+		ErrorKeySnakeCase:    "{{title}} must be in snake_case",
+		ErrorKeyNotSnakeCase: "{{title}} can't be in snake_case",
+
+		ErrorKeyKebabCase:    "{{title}} must be in kebab-case",
+		ErrorKeyNotKebabCase: "{{title}} can't be in kebab-case",
+
+		ErrorKeyCamelCase:    "{{title}} must be in camelCase",
+		ErrorKeyNotCamelCase: "{{title}} can't be in camelCase",
+
+		ErrorKeyPascalCase:    "{{title}} must be in PascalCase",
+		ErrorKeyNotPascalCase: "{{title}} can't be in PascalCase",
+
+		ErrorKeyGoIdentifier:    "{{title}} must be a valid Go identifier",
+		ErrorKeyNotGoIdentifier: "{{title}} can't be a valid Go identifier",
+
+		ErrorKeyAlpha:    "{{title}} must only contain letters from a to z",
+		ErrorKeyNotAlpha: "{{title}} can't only contain letters from a to z",
+
+		ErrorKeyAlphaUnicode:    "{{title}} must only contain letters",
+		ErrorKeyNotAlphaUnicode: "{{title}} can't only contain letters",
+
+		ErrorKeyAlphanumeric:    "{{title}} must only contain letters from a to z and digits",
+		ErrorKeyNotAlphanumeric: "{{title}} can't only contain letters from a to z and digits",
+
+		ErrorKeyAlphanumericUnicode:    "{{title}} must only contain letters and digits",
+		ErrorKeyNotAlphanumericUnicode: "{{title}} can't only contain letters and digits",
+
+		ErrorKeyNumeric:    "{{title}} must only contain digits from 0 to 9",
+		ErrorKeyNotNumeric: "{{title}} can't only contain digits from 0 to 9",
+
+		ErrorKeyNumericUnicode:    "{{title}} must only contain digits",
+		ErrorKeyNotNumericUnicode: "{{title}} can't only contain digits",
+
+		ErrorKeyASCII:    "{{title}} must only contain ASCII characters",
+		ErrorKeyNotASCII: "{{title}} can't only contain ASCII characters",
+
+		ErrorKeyHasPrefix:    "{{title}} must start with \"{{value}}\"",
+		ErrorKeyNotHasPrefix: "{{title}} can't start with \"{{value}}\"",
+
+		ErrorKeyHasSuffix:    "{{title}} must end with \"{{value}}\"",
+		ErrorKeyNotHasSuffix: "{{title}} can't end with \"{{value}}\"",
+
+		ErrorKeyContainsAny:    "{{title}} must contain at least one of the characters \"{{value}}\"",
+		ErrorKeyNotContainsAny: "{{title}} can't contain any of the characters \"{{value}}\"",
+
+		ErrorKeyExcludesAll:    "{{title}} can't contain any of the characters \"{{value}}\"",
+		ErrorKeyNotExcludesAll: "{{title}} must contain at least one of the characters \"{{value}}\"",
+
+		ErrorKeyContainsFold:    "{{title}} must contain \"{{value}}\", ignoring case",
+		ErrorKeyNotContainsFold: "{{title}} can't contain \"{{value}}\", ignoring case",
+
+		ErrorKeyEqualToFold:    "{{title}} must be equal to \"{{value}}\", ignoring case",
+		ErrorKeyNotEqualToFold: "{{title}} can't be equal to \"{{value}}\", ignoring case",
+
+		ErrorKeyWordCountBetween:    "{{title}} must have between \"{{min}}\" and \"{{max}}\" words",
+		ErrorKeyNotWordCountBetween: "{{title}} can't have between \"{{min}}\" and \"{{max}}\" words",
+
+		ErrorKeyHostname:    "{{title}} must be a valid hostname",
+		ErrorKeyNotHostname: "{{title}} can't be a valid hostname",
+
+		ErrorKeyFQDN:    "{{title}} must be a fully qualified domain name",
+		ErrorKeyNotFQDN: "{{title}} can't be a fully qualified domain name",
+
+		ErrorKeyDomainWithTLD:    "{{title}} must be a domain name with a top-level domain",
+		ErrorKeyNotDomainWithTLD: "{{title}} can't be a domain name with a top-level domain",
+
+		ErrorKeyHostPort:    "{{title}} must be a host and a port, like \"example.com:443\"",
+		ErrorKeyNotHostPort: "{{title}} can't be a host and a port",
+
+		ErrorKeyPort:    "{{title}} must be a port number between 1 and 65535",
+		ErrorKeyNotPort: "{{title}} can't be a port number between 1 and 65535",
+
+		ErrorKeyPunycodeHostname:    "{{title}} must be a valid hostname with internationalized labels in Punycode",
+		ErrorKeyNotPunycodeHostname: "{{title}} can't be a valid hostname with internationalized labels in Punycode",
+
+		ErrorKeyHostnameLabel:    "{{title}} has an invalid label \"{{label}}\"",
+		ErrorKeyNotHostnameLabel: "{{title}} must have an invalid label",
+
+		ErrorKeyHostnameEmptyLabel:    "{{title}} can't have empty labels",
+		ErrorKeyNotHostnameEmptyLabel: "{{title}} must have empty labels",
+
+		ErrorKeyHostnameLabelLength:    "{{title}} has the label \"{{label}}\" longer than {{max}} characters",
+		ErrorKeyNotHostnameLabelLength: "{{title}} must have a label longer than {{max}} characters",
+
+		ErrorKeyHostnameLength:    "{{title}} can't be longer than {{max}} characters",
+		ErrorKeyNotHostnameLength: "{{title}} must be longer than {{max}} characters",
+
+		ErrorKeyOneOfRanges:    "{{title}} must be in one of the ranges {{ranges}}",
+		ErrorKeyNotOneOfRanges: "{{title}} can't be in any of the ranges {{ranges}}",
+
+		ErrorKeyEven:    "{{title}} must be an even number",
+		ErrorKeyNotEven: "{{title}} can't be an even number",
+
+		ErrorKeyOdd:    "{{title}} must be an odd number",
+		ErrorKeyNotOdd: "{{title}} can't be an odd number",
+
+		ErrorKeyPowerOfTwo:    "{{title}} must be a power of two",
+		ErrorKeyNotPowerOfTwo: "{{title}} can't be a power of two",
+
+		ErrorKeyEqualWithin:    "{{title}} must be equal to \"{{value}}\" within \"{{epsilon}}\"",
+		ErrorKeyNotEqualWithin: "{{title}} can't be equal to \"{{value}}\" within \"{{epsilon}}\"",
+
+		ErrorKeyFitsIn:    "{{title}} must fit in the \"{{type}}\" type",
+		ErrorKeyNotFitsIn: "{{title}} can't fit in the \"{{type}}\" type",
+
+		ErrorKeyInFuture:    "{{title}} must be in the future",
+		ErrorKeyNotInFuture: "{{title}} can't be in the future",
+
+		ErrorKeyInPast:    "{{title}} must be in the past",
+		ErrorKeyNotInPast: "{{title}} can't be in the past",
+
+		ErrorKeyWithinLast:    "{{title}} must be within the last \"{{duration}}\"",
+		ErrorKeyNotWithinLast: "{{title}} can't be within the last \"{{duration}}\"",
+
+		ErrorKeyWithinNext:    "{{title}} must be within the next \"{{duration}}\"",
+		ErrorKeyNotWithinNext: "{{title}} can't be within the next \"{{duration}}\"",
+
+		ErrorKeyOlderThan:    "{{title}} must be older than \"{{duration}}\"",
+		ErrorKeyNotOlderThan: "{{title}} can't be older than \"{{duration}}\"",
+
+		ErrorKeyAgeAtLeast:    "{{title}} must be at least {{years}} years ago",
+		ErrorKeyNotAgeAtLeast: "{{title}} can't be at least {{years}} years ago",
+
+		ErrorKeyWeekday:    "{{title}} must be on {{days}}",
+		ErrorKeyNotWeekday: "{{title}} can't be on {{days}}",
+
+		ErrorKeyTimeOfDayBetween:    "{{title}} must be between \"{{from}}\" and \"{{to}}\"",
+		ErrorKeyNotTimeOfDayBetween: "{{title}} can't be between \"{{from}}\" and \"{{to}}\"",
+
+		ErrorKeySameDay:    "{{title}} must be on the same day as \"{{date}}\"",
+		ErrorKeyNotSameDay: "{{title}} can't be on the same day as \"{{date}}\"",
+
+		ErrorKeySameMonth:    "{{title}} must be in the same month as \"{{date}}\"",
+		ErrorKeyNotSameMonth: "{{title}} can't be in the same month as \"{{date}}\"",
+
+		ErrorKeyInLocation:    "{{title}} must be in the time zone \"{{location}}\"",
+		ErrorKeyNotInLocation: "{{title}} can't be in the time zone \"{{location}}\"",
+
+		ErrorKeyExcludedDate:    "{{title}} can't be on \"{{date}}\"",
+		ErrorKeyNotExcludedDate: "{{title}} must be on one of the excluded dates",
+
+		ErrorKeyBusinessDay:    "{{title}} must be a business day",
+		ErrorKeyNotBusinessDay: "{{title}} can't be a business day",
+
+		WeekdayKeySunday:    "Sunday",
+		WeekdayKeyMonday:    "Monday",
+		WeekdayKeyTuesday:   "Tuesday",
+		WeekdayKeyWednesday: "Wednesday",
+		WeekdayKeyThursday:  "Thursday",
+		WeekdayKeyFriday:    "Friday",
+		WeekdayKeySaturday:  "Saturday",
+
+		LayoutKeyDate:             "2006-01-02",
+		LayoutKeyTimeOfDay:        "15:04",
+		LayoutKeyTimeOfDaySeconds: "15:04:05",
+
+		ErrorKeyValidDate:    "{{title}} must be a valid date",
+		ErrorKeyNotValidDate: "{{title}} can't be a valid date",
+
+		ErrorKeyValidTimeOfDay:    "{{title}} must be a valid time of day",
+		ErrorKeyNotValidTimeOfDay: "{{title}} can't be a valid time of day",
+
+		ErrorKeyLeapDay:    "{{title}} must be February 29",
+		ErrorKeyNotLeapDay: "{{title}} can't be February 29",
+
+		ErrorKeyTime:    "{{title}} must be a time in the format \"{{layout}}\"",
+		ErrorKeyNotTime: "{{title}} can't be a time in the format \"{{layout}}\"",
+
+		ErrorKeyRFC3339:    "{{title}} must be an RFC 3339 timestamp",
+		ErrorKeyNotRFC3339: "{{title}} can't be an RFC 3339 timestamp",
+
+		ErrorKeyISO8601Date:    "{{title}} must be an ISO 8601 date",
+		ErrorKeyNotISO8601Date: "{{title}} can't be an ISO 8601 date",
+
+		ErrorKeyISO8601Duration:    "{{title}} must be an ISO 8601 duration",
+		ErrorKeyNotISO8601Duration: "{{title}} can't be an ISO 8601 duration",
+
+		ErrorKeyUnixTimestamp:    "{{title}} must be a Unix timestamp",
+		ErrorKeyNotUnixTimestamp: "{{title}} can't be a Unix timestamp",
+
+		ErrorKeyStartBeforeEnd:    "{{title}} must start before it ends",
+		ErrorKeyNotStartBeforeEnd: "{{title}} can't start before it ends",
+
+		ErrorKeyMaxSpan:    "{{title}} can't be longer than {{span}}",
+		ErrorKeyNotMaxSpan: "{{title}} must be longer than {{span}}",
+
+		ErrorKeyMinSpan:    "{{title}} can't be shorter than {{span}}",
+		ErrorKeyNotMinSpan: "{{title}} must be shorter than {{span}}",
+
+		ErrorKeyAlignedTo:    "{{title}} must be aligned to {{duration}}",
+		ErrorKeyNotAlignedTo: "{{title}} can't be aligned to {{duration}}",
+
+		ErrorKeyNonOverlapping:    "{{title}} can't overlap with {{other}}",
+		ErrorKeyNotNonOverlapping: "{{title}} must overlap with {{other}}",
+
+		ErrorKeyContiguous:    "{{title}} must start when {{other}} ends",
+		ErrorKeyNotContiguous: "{{title}} can't start when {{other}} ends",
+
+		ErrorKeySorted:    "{{title}} can't start before {{other}}",
+		ErrorKeyNotSorted: "{{title}} must start before {{other}}",
+
 		OrKeyPair:   " or ",
 		OrKeyMiddle: "; ",
 		OrKeyEnd:    "; or ",
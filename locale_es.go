@@ -95,6 +95,449 @@ func getLocaleEs() *Locale {
 		ErrorKeyFinite:    "{{title}} debe ser finito",
 		ErrorKeyNotFinite: "{{title}} no debe ser finito",
 
+		ErrorKeyContains:    "{{title}} debe contener \"{{value}}\"",
+		ErrorKeyNotContains: "{{title}} no puede contener \"{{value}}\"",
+
+		ErrorKeyUnique:    "{{title}} no debe contener valores duplicados",
+		ErrorKeyNotUnique: "{{title}} debe contener valores duplicados",
+
+		ErrorKeyHasKeys:    "{{title}} debe tener las claves \"{{keys}}\"",
+		ErrorKeyNotHasKeys: "{{title}} no puede tener las claves \"{{keys}}\"",
+
+		ErrorKeyOnlyKeys:    "{{title}} solo puede tener las claves \"{{keys}}\"",
+		ErrorKeyNotOnlyKeys: "{{title}} debe tener claves distintas a \"{{keys}}\"",
+
+		ErrorKeyMultipleOf:    "{{title}} debe ser múltiplo de \"{{value}}\"",
+		ErrorKeyNotMultipleOf: "{{title}} no puede ser múltiplo de \"{{value}}\"",
+
+		ErrorKeyTruncated:    "{{title}} no puede ser más preciso que \"{{value}}\"",
+		ErrorKeyNotTruncated: "{{title}} debe ser más preciso que \"{{value}}\"",
+
+		ErrorKeyDecimal:    "{{title}} debe ser un número decimal",
+		ErrorKeyNotDecimal: "{{title}} no puede ser un número decimal",
+
+		ErrorKeyMaxPrecision:    "{{title}} no debe tener más de \"{{precision}}\" dígitos",
+		ErrorKeyNotMaxPrecision: "{{title}} debe tener más de \"{{precision}}\" dígitos",
+
+		ErrorKeyMaxScale:    "{{title}} no debe tener más de \"{{scale}}\" decimales",
+		ErrorKeyNotMaxScale: "{{title}} debe tener más de \"{{scale}}\" decimales",
+
+		ErrorKeyScale:    "{{title}} debe tener exactamente \"{{scale}}\" decimales",
+		ErrorKeyNotScale: "{{title}} no puede tener exactamente \"{{scale}}\" decimales",
+
+		ErrorKeyNonNegative:    "{{title}} no puede ser negativo",
+		ErrorKeyNotNonNegative: "{{title}} debe ser negativo",
+
+		ErrorKeyCurrencyMinorUnits:    "{{title}} no es un importe válido para la moneda \"{{currency}}\"",
+		ErrorKeyNotCurrencyMinorUnits: "{{title}} no puede ser un importe válido para la moneda \"{{currency}}\"",
+		ErrorKeyUnknownCurrency:       "{{title}} no se puede comprobar con la moneda desconocida \"{{currency}}\"",
+
+		ErrorKeyIP:    "{{title}} debe ser una dirección IP válida",
+		ErrorKeyNotIP: "{{title}} no puede ser una dirección IP válida",
+
+		ErrorKeyPrefix:    "{{title}} debe ser un prefijo de red válido",
+		ErrorKeyNotPrefix: "{{title}} no puede ser un prefijo de red válido",
+
+		ErrorKeyAddrPort:    "{{title}} debe ser una dirección IP y puerto válidos",
+		ErrorKeyNotAddrPort: "{{title}} no puede ser una dirección IP y puerto válidos",
+
+		ErrorKeyIPv4:    "{{title}} debe ser IPv4",
+		ErrorKeyNotIPv4: "{{title}} no puede ser IPv4",
+
+		ErrorKeyIPv6:    "{{title}} debe ser IPv6",
+		ErrorKeyNotIPv6: "{{title}} no puede ser IPv6",
+
+		ErrorKeyPrivate:    "{{title}} debe ser privada",
+		ErrorKeyNotPrivate: "{{title}} no puede ser privada",
+
+		ErrorKeyLoopback:    "{{title}} debe ser de loopback",
+		ErrorKeyNotLoopback: "{{title}} no puede ser de loopback",
+
+		ErrorKeyGlobal:    "{{title}} debe ser una dirección pública",
+		ErrorKeyNotGlobal: "{{title}} no puede ser una dirección pública",
+
+		ErrorKeyMulticast:    "{{title}} debe ser multicast",
+		ErrorKeyNotMulticast: "{{title}} no puede ser multicast",
+
+		ErrorKeyMasked:    "{{title}} no debe tener bits activos después de la longitud del prefijo",
+		ErrorKeyNotMasked: "{{title}} debe tener bits activos después de la longitud del prefijo",
+
+		ErrorKeyInPrefix:    "{{title}} debe estar dentro de \"{{prefix}}\"",
+		ErrorKeyNotInPrefix: "{{title}} no puede estar dentro de \"{{prefix}}\"",
+
+		ErrorKeyOutsidePrefixes:    "{{title}} no puede estar dentro de \"{{prefixes}}\"",
+		ErrorKeyNotOutsidePrefixes: "{{title}} debe estar dentro de \"{{prefixes}}\"",
+
+		ErrorKeyPortBetween:    "{{title}} debe tener un puerto entre \"{{min}}\" y \"{{max}}\"",
+		ErrorKeyNotPortBetween: "{{title}} no puede tener un puerto entre \"{{min}}\" y \"{{max}}\"",
+
+		ErrorKeyURL:    "{{title}} debe ser una URL válida",
+		ErrorKeyNotURL: "{{title}} no puede ser una URL válida",
+
+		ErrorKeyAbsoluteURL:    "{{title}} debe ser una URL absoluta",
+		ErrorKeyNotAbsoluteURL: "{{title}} no puede ser una URL absoluta",
+
+		ErrorKeySchemeIn:    "{{title}} debe usar uno de los esquemas \"{{schemes}}\"",
+		ErrorKeyNotSchemeIn: "{{title}} no puede usar ninguno de los esquemas \"{{schemes}}\"",
+
+		ErrorKeyHostIn:    "{{title}} debe tener uno de los hosts \"{{hosts}}\"",
+		ErrorKeyNotHostIn: "{{title}} no puede tener ninguno de los hosts \"{{hosts}}\"",
+
+		ErrorKeyWithoutUserInfo:    "{{title}} no puede contener información de usuario",
+		ErrorKeyNotWithoutUserInfo: "{{title}} debe contener información de usuario",
+
+		ErrorKeyWithoutFragment:    "{{title}} no puede contener un fragmento",
+		ErrorKeyNotWithoutFragment: "{{title}} debe contener un fragmento",
+
+		ErrorKeyPathPrefix:    "{{title}} debe tener una ruta que empiece por \"{{prefix}}\"",
+		ErrorKeyNotPathPrefix: "{{title}} no puede tener una ruta que empiece por \"{{prefix}}\"",
+
+		ErrorKeyExternalHost:    "{{title}} no puede apuntar a un host interno",
+		ErrorKeyNotExternalHost: "{{title}} debe apuntar a un host interno",
+
+		ErrorKeyEmail:    "{{title}} debe ser una dirección de correo electrónico válida",
+		ErrorKeyNotEmail: "{{title}} no puede ser una dirección de correo electrónico válida",
+
+		ErrorKeyEmailDomainIn:    "{{title}} debe usar uno de los dominios \"{{domains}}\"",
+		ErrorKeyNotEmailDomainIn: "{{title}} no puede usar ninguno de los dominios \"{{domains}}\"",
+
+		ErrorKeyEmailDomainExcluded:    "{{title}} no puede usar ninguno de los dominios \"{{domains}}\"",
+		ErrorKeyNotEmailDomainExcluded: "{{title}} debe usar uno de los dominios \"{{domains}}\"",
+
+		ErrorKeyUUID:    "{{title}} debe ser un UUID válido",
+		ErrorKeyNotUUID: "{{title}} no puede ser un UUID válido",
+
+		ErrorKeyUUIDVersion:    "{{title}} debe ser un UUID de la versión \"{{versions}}\"",
+		ErrorKeyNotUUIDVersion: "{{title}} no puede ser un UUID de la versión \"{{versions}}\"",
+
+		ErrorKeyULID:    "{{title}} debe ser un ULID válido",
+		ErrorKeyNotULID: "{{title}} no puede ser un ULID válido",
+
+		ErrorKeyKSUID:    "{{title}} debe ser un KSUID válido",
+		ErrorKeyNotKSUID: "{{title}} no puede ser un KSUID válido",
+
+		ErrorKeyHex:    "{{title}} debe ser una cadena hexadecimal",
+		ErrorKeyNotHex: "{{title}} no puede ser una cadena hexadecimal",
+
+		ErrorKeyHexLength:    "{{title}} debe ser una cadena hexadecimal de \"{{length}}\" caracteres",
+		ErrorKeyNotHexLength: "{{title}} no puede ser una cadena hexadecimal de \"{{length}}\" caracteres",
+
+		ErrorKeyBase64:    "{{title}} debe ser una cadena Base64 válida",
+		ErrorKeyNotBase64: "{{title}} no puede ser una cadena Base64 válida",
+
+		ErrorKeyBase32:    "{{title}} debe ser una cadena Base32 válida",
+		ErrorKeyNotBase32: "{{title}} no puede ser una cadena Base32 válida",
+
+		ErrorKeyLuhn:    "{{title}} debe ser un número Luhn válido",
+		ErrorKeyNotLuhn: "{{title}} no puede ser un número Luhn válido",
+
+		ErrorKeyCreditCard:    "{{title}} debe ser un número de tarjeta de crédito válido",
+		ErrorKeyNotCreditCard: "{{title}} no puede ser un número de tarjeta de crédito válido",
+
+		ErrorKeyCreditCardBrand:    "{{title}} debe ser una tarjeta de las marcas \"{{brands}}\"",
+		ErrorKeyNotCreditCardBrand: "{{title}} no puede ser una tarjeta de las marcas \"{{brands}}\"",
+
+		ErrorKeyIBAN:    "{{title}} debe ser un IBAN válido",
+		ErrorKeyNotIBAN: "{{title}} no puede ser un IBAN válido",
+
+		ErrorKeyIBANCountry:    "{{title}} debe ser un IBAN de los países \"{{countries}}\"",
+		ErrorKeyNotIBANCountry: "{{title}} no puede ser un IBAN de los países \"{{countries}}\"",
+
+		ErrorKeyBIC:    "{{title}} debe ser un BIC válido",
+		ErrorKeyNotBIC: "{{title}} no puede ser un BIC válido",
+
+		ErrorKeyISBN10:    "{{title}} debe ser un ISBN-10 válido",
+		ErrorKeyNotISBN10: "{{title}} no puede ser un ISBN-10 válido",
+
+		ErrorKeyISBN13:    "{{title}} debe ser un ISBN-13 válido",
+		ErrorKeyNotISBN13: "{{title}} no puede ser un ISBN-13 válido",
+
+		ErrorKeyEAN13:    "{{title}} debe ser un EAN-13 válido",
+		ErrorKeyNotEAN13: "{{title}} no puede ser un EAN-13 válido",
+
+		ErrorKeyISSN:    "{{title}} debe ser un ISSN válido",
+		ErrorKeyNotISSN: "{{title}} no puede ser un ISSN válido",
+
+		ErrorKeyChecksum:    "{{title}} tiene un dígito de control inválido",
+		ErrorKeyNotChecksum: "{{title}} debe tener un dígito de control inválido",
+
+		ErrorKeyPhone:    "{{title}} debe ser un número de teléfono válido en formato internacional",
+		ErrorKeyNotPhone: "{{title}} no puede ser un número de teléfono válido en formato internacional",
+
+		ErrorKeyPhoneForRegion:    "{{title}} debe ser un número de teléfono válido para {{region}}",
+		ErrorKeyNotPhoneForRegion: "{{title}} no puede ser un número de teléfono válido para {{region}}",
+
+		ErrorKeySemVer:    "{{title}} debe ser una versión semántica válida",
+		ErrorKeyNotSemVer: "{{title}} no puede ser una versión semántica válida",
+
+		ErrorKeySatisfiesConstraint:    "{{title}} debe cumplir la restricción de versión \"{{constraint}}\"",
+		ErrorKeyNotSatisfiesConstraint: "{{title}} no puede cumplir la restricción de versión \"{{constraint}}\"",
+		ErrorKeyMalformedConstraint:    "{{title}} no se puede comprobar con la restricción de versión mal formada \"{{constraint}}\"",
+
+		ErrorKeySemVerGreaterThan:    "{{title}} debe ser una versión mayor que \"{{version}}\"",
+		ErrorKeyNotSemVerGreaterThan: "{{title}} no puede ser una versión mayor que \"{{version}}\"",
+
+		ErrorKeySemVerBetween:    "{{title}} debe ser una versión entre \"{{min}}\" y \"{{max}}\"",
+		ErrorKeyNotSemVerBetween: "{{title}} no puede ser una versión entre \"{{min}}\" y \"{{max}}\"",
+
+		ErrorKeyJSON:    "{{title}} debe ser un JSON válido, error en la línea {{line}}, columna {{column}}",
+		ErrorKeyNotJSON: "{{title}} no puede ser un JSON válido",
+
+		ErrorKeyJSONObject:    "{{title}} debe ser un objeto JSON",
+		ErrorKeyNotJSONObject: "{{title}} no puede ser un objeto JSON",
+
+		ErrorKeyJSONArray:    "{{title}} debe ser un arreglo JSON",
+		ErrorKeyNotJSONArray: "{{title}} no puede ser un arreglo JSON",
+
+		ErrorKeyRegexp:    "{{title}} debe ser una expresión regular válida, error en la línea {{line}}, columna {{column}}",
+		ErrorKeyNotRegexp: "{{title}} no puede ser una expresión regular válida",
+
+		ErrorKeyGoTemplate:    "{{title}} debe ser una plantilla de Go válida, error en la línea {{line}}",
+		ErrorKeyNotGoTemplate: "{{title}} no puede ser una plantilla de Go válida",
+
+		ErrorKeyPassword:    "{{title}} debe {{requirements}}",
+		ErrorKeyNotPassword: "{{title}} no puede cumplir los requisitos de contraseña",
+
+		ErrorKeyPasswordMinLength:   "tener al menos {{min}} caracteres",
+		ErrorKeyPasswordLowercase:   "contener una letra minúscula",
+		ErrorKeyPasswordUppercase:   "contener una letra mayúscula",
+		ErrorKeyPasswordDigit:       "contener un dígito",
+		ErrorKeyPasswordSymbol:      "contener un símbolo",
+		ErrorKeyPasswordMaxRepeated: "no repetir un carácter más de {{max}} veces seguidas",
+		ErrorKeyPasswordEntropy:     "ser menos predecible",
+		ErrorKeyPasswordCommon:      "no ser una contraseña común",
+
+		ErrorKeyPrintable:    "{{title}} solo debe contener caracteres imprimibles",
+		ErrorKeyNotPrintable: "{{title}} no puede contener solo caracteres imprimibles",
+
+		ErrorKeyWithoutControlChars:    "{{title}} no debe contener caracteres de control",
+		ErrorKeyNotWithoutControlChars: "{{title}} debe contener caracteres de control",
+
+		ErrorKeyNormalizedNFC:    "{{title}} debe estar en la forma de normalización Unicode NFC",
+		ErrorKeyNotNormalizedNFC: "{{title}} no puede estar en la forma de normalización Unicode NFC",
+
+		ErrorKeySingleScript:    "{{title}} no debe mezclar caracteres de distintos sistemas de escritura",
+		ErrorKeyNotSingleScript: "{{title}} debe mezclar caracteres de distintos sistemas de escritura",
+
+		ErrorKeyScriptIn:    "{{title}} solo debe contener caracteres de los sistemas de escritura {{scripts}}",
+		ErrorKeyNotScriptIn: "{{title}} no puede contener solo caracteres de los sistemas de escritura {{scripts}}",
+
+		ErrorKeyWithoutConfusables:    "{{title}} no debe contener caracteres que parecen letras latinas",
+		ErrorKeyNotWithoutConfusables: "{{title}} debe contener caracteres que parecen letras latinas",
+
+		ErrorKeySingleLine:    "{{title}} debe ser una sola línea",
+		ErrorKeyNotSingleLine: "{{title}} no puede ser una sola línea",
+
+		ErrorKeyLowercase:    "{{title}} no debe contener letras mayúsculas",
+		ErrorKeyNotLowercase: "{{title}} debe contener letras mayúsculas",
+
+		ErrorKeyUppercase:    "{{title}} no debe contener letras minúsculas",
+		ErrorKeyNotUppercase: "{{title}} debe contener letras minúsculas",
+
+		ErrorKeySlug:    "{{title}} debe ser un slug de letras minúsculas, dígitos y guiones",
+		ErrorKeyNotSlug: "{{title}} no puede ser un slug",
+
+		ErrorKeySnakeCase:    "{{title}} debe estar en snake_case",
+		ErrorKeyNotSnakeCase: "{{title}} no puede estar en snake_case",
+
+		ErrorKeyKebabCase:    "{{title}} debe estar en kebab-case",
+		ErrorKeyNotKebabCase: "{{title}} no puede estar en kebab-case",
+
+		ErrorKeyCamelCase:    "{{title}} debe estar en camelCase",
+		ErrorKeyNotCamelCase: "{{title}} no puede estar en camelCase",
+
+		ErrorKeyPascalCase:    "{{title}} debe estar en PascalCase",
+		ErrorKeyNotPascalCase: "{{title}} no puede estar en PascalCase",
+
+		ErrorKeyGoIdentifier:    "{{title}} debe ser un identificador de Go válido",
+		ErrorKeyNotGoIdentifier: "{{title}} no puede ser un identificador de Go válido",
+
+		ErrorKeyAlpha:    "{{title}} solo debe contener letras de la a a la z",
+		ErrorKeyNotAlpha: "{{title}} no puede contener solo letras de la a a la z",
+
+		ErrorKeyAlphaUnicode:    "{{title}} solo debe contener letras",
+		ErrorKeyNotAlphaUnicode: "{{title}} no puede contener solo letras",
+
+		ErrorKeyAlphanumeric:    "{{title}} solo debe contener letras de la a a la z y dígitos",
+		ErrorKeyNotAlphanumeric: "{{title}} no puede contener solo letras de la a a la z y dígitos",
+
+		ErrorKeyAlphanumericUnicode:    "{{title}} solo debe contener letras y dígitos",
+		ErrorKeyNotAlphanumericUnicode: "{{title}} no puede contener solo letras y dígitos",
+
+		ErrorKeyNumeric:    "{{title}} solo debe contener dígitos del 0 al 9",
+		ErrorKeyNotNumeric: "{{title}} no puede contener solo dígitos del 0 al 9",
+
+		ErrorKeyNumericUnicode:    "{{title}} solo debe contener dígitos",
+		ErrorKeyNotNumericUnicode: "{{title}} no puede contener solo dígitos",
+
+		ErrorKeyASCII:    "{{title}} solo debe contener caracteres ASCII",
+		ErrorKeyNotASCII: "{{title}} no puede contener solo caracteres ASCII",
+
+		ErrorKeyHasPrefix:    "{{title}} debe empezar con \"{{value}}\"",
+		ErrorKeyNotHasPrefix: "{{title}} no puede empezar con \"{{value}}\"",
+
+		ErrorKeyHasSuffix:    "{{title}} debe terminar con \"{{value}}\"",
+		ErrorKeyNotHasSuffix: "{{title}} no puede terminar con \"{{value}}\"",
+
+		ErrorKeyContainsAny:    "{{title}} debe contener al menos uno de los caracteres \"{{value}}\"",
+		ErrorKeyNotContainsAny: "{{title}} no puede contener ninguno de los caracteres \"{{value}}\"",
+
+		ErrorKeyExcludesAll:    "{{title}} no puede contener ninguno de los caracteres \"{{value}}\"",
+		ErrorKeyNotExcludesAll: "{{title}} debe contener al menos uno de los caracteres \"{{value}}\"",
+
+		ErrorKeyContainsFold:    "{{title}} debe contener \"{{value}}\", sin distinguir mayúsculas y minúsculas",
+		ErrorKeyNotContainsFold: "{{title}} no puede contener \"{{value}}\", sin distinguir mayúsculas y minúsculas",
+
+		ErrorKeyEqualToFold:    "{{title}} debe ser igual a \"{{value}}\", sin distinguir mayúsculas y minúsculas",
+		ErrorKeyNotEqualToFold: "{{title}} no puede ser igual a \"{{value}}\", sin distinguir mayúsculas y minúsculas",
+
+		ErrorKeyWordCountBetween:    "{{title}} debe tener entre \"{{min}}\" y \"{{max}}\" palabras",
+		ErrorKeyNotWordCountBetween: "{{title}} no puede tener entre \"{{min}}\" y \"{{max}}\" palabras",
+
+		ErrorKeyHostname:    "{{title}} debe ser un nombre de host válido",
+		ErrorKeyNotHostname: "{{title}} no puede ser un nombre de host válido",
+
+		ErrorKeyFQDN:    "{{title}} debe ser un nombre de dominio completo",
+		ErrorKeyNotFQDN: "{{title}} no puede ser un nombre de dominio completo",
+
+		ErrorKeyDomainWithTLD:    "{{title}} debe ser un nombre de dominio con un dominio de nivel superior",
+		ErrorKeyNotDomainWithTLD: "{{title}} no puede ser un nombre de dominio con un dominio de nivel superior",
+
+		ErrorKeyHostPort:    "{{title}} debe ser un host y un puerto, como \"example.com:443\"",
+		ErrorKeyNotHostPort: "{{title}} no puede ser un host y un puerto",
+
+		ErrorKeyPort:    "{{title}} debe ser un número de puerto entre 1 y 65535",
+		ErrorKeyNotPort: "{{title}} no puede ser un número de puerto entre 1 y 65535",
+
+		ErrorKeyPunycodeHostname:    "{{title}} debe ser un nombre de host válido con las etiquetas internacionalizadas en Punycode",
+		ErrorKeyNotPunycodeHostname: "{{title}} no puede ser un nombre de host válido con las etiquetas internacionalizadas en Punycode",
+
+		ErrorKeyHostnameLabel:    "{{title}} tiene una etiqueta inválida \"{{label}}\"",
+		ErrorKeyNotHostnameLabel: "{{title}} debe tener una etiqueta inválida",
+
+		ErrorKeyHostnameEmptyLabel:    "{{title}} no puede tener etiquetas vacías",
+		ErrorKeyNotHostnameEmptyLabel: "{{title}} debe tener etiquetas vacías",
+
+		ErrorKeyHostnameLabelLength:    "{{title}} tiene la etiqueta \"{{label}}\" de más de {{max}} caracteres",
+		ErrorKeyNotHostnameLabelLength: "{{title}} debe tener una etiqueta de más de {{max}} caracteres",
+
+		ErrorKeyHostnameLength:    "{{title}} no puede tener más de {{max}} caracteres",
+		ErrorKeyNotHostnameLength: "{{title}} debe tener más de {{max}} caracteres",
+
+		ErrorKeyOneOfRanges:    "{{title}} debe estar en uno de los rangos {{ranges}}",
+		ErrorKeyNotOneOfRanges: "{{title}} no puede estar en ninguno de los rangos {{ranges}}",
+
+		ErrorKeyEven:    "{{title}} debe ser un número par",
+		ErrorKeyNotEven: "{{title}} no puede ser un número par",
+
+		ErrorKeyOdd:    "{{title}} debe ser un número impar",
+		ErrorKeyNotOdd: "{{title}} no puede ser un número impar",
+
+		ErrorKeyPowerOfTwo:    "{{title}} debe ser una potencia de dos",
+		ErrorKeyNotPowerOfTwo: "{{title}} no puede ser una potencia de dos",
+
+		ErrorKeyEqualWithin:    "{{title}} debe ser igual a \"{{value}}\" con un margen de \"{{epsilon}}\"",
+		ErrorKeyNotEqualWithin: "{{title}} no puede ser igual a \"{{value}}\" con un margen de \"{{epsilon}}\"",
+
+		ErrorKeyFitsIn:    "{{title}} debe caber en el tipo \"{{type}}\"",
+		ErrorKeyNotFitsIn: "{{title}} no puede caber en el tipo \"{{type}}\"",
+
+		ErrorKeyInFuture:    "{{title}} debe estar en el futuro",
+		ErrorKeyNotInFuture: "{{title}} no puede estar en el futuro",
+
+		ErrorKeyInPast:    "{{title}} debe estar en el pasado",
+		ErrorKeyNotInPast: "{{title}} no puede estar en el pasado",
+
+		ErrorKeyWithinLast:    "{{title}} debe estar dentro de los últimos \"{{duration}}\"",
+		ErrorKeyNotWithinLast: "{{title}} no puede estar dentro de los últimos \"{{duration}}\"",
+
+		ErrorKeyWithinNext:    "{{title}} debe estar dentro de los próximos \"{{duration}}\"",
+		ErrorKeyNotWithinNext: "{{title}} no puede estar dentro de los próximos \"{{duration}}\"",
+
+		ErrorKeyOlderThan:    "{{title}} debe tener una antigüedad de más de \"{{duration}}\"",
+		ErrorKeyNotOlderThan: "{{title}} no puede tener una antigüedad de más de \"{{duration}}\"",
+
+		ErrorKeyAgeAtLeast:    "{{title}} debe ser de hace al menos {{years}} años",
+		ErrorKeyNotAgeAtLeast: "{{title}} no puede ser de hace al menos {{years}} años",
+
+		ErrorKeyWeekday:    "{{title}} debe ser en {{days}}",
+		ErrorKeyNotWeekday: "{{title}} no puede ser en {{days}}",
+
+		ErrorKeyTimeOfDayBetween:    "{{title}} debe estar entre las \"{{from}}\" y las \"{{to}}\"",
+		ErrorKeyNotTimeOfDayBetween: "{{title}} no puede estar entre las \"{{from}}\" y las \"{{to}}\"",
+
+		ErrorKeySameDay:    "{{title}} debe ser el mismo día que \"{{date}}\"",
+		ErrorKeyNotSameDay: "{{title}} no puede ser el mismo día que \"{{date}}\"",
+
+		ErrorKeySameMonth:    "{{title}} debe ser en el mismo mes que \"{{date}}\"",
+		ErrorKeyNotSameMonth: "{{title}} no puede ser en el mismo mes que \"{{date}}\"",
+
+		ErrorKeyInLocation:    "{{title}} debe estar en la zona horaria \"{{location}}\"",
+		ErrorKeyNotInLocation: "{{title}} no puede estar en la zona horaria \"{{location}}\"",
+
+		ErrorKeyExcludedDate:    "{{title}} no puede ser el \"{{date}}\"",
+		ErrorKeyNotExcludedDate: "{{title}} debe ser en una de las fechas excluidas",
+
+		ErrorKeyBusinessDay:    "{{title}} debe ser un día hábil",
+		ErrorKeyNotBusinessDay: "{{title}} no puede ser un día hábil",
+
+		WeekdayKeySunday:    "domingo",
+		WeekdayKeyMonday:    "lunes",
+		WeekdayKeyTuesday:   "martes",
+		WeekdayKeyWednesday: "miércoles",
+		WeekdayKeyThursday:  "jueves",
+		WeekdayKeyFriday:    "viernes",
+		WeekdayKeySaturday:  "sábado",
+
+		LayoutKeyDate:             "02/01/2006",
+		LayoutKeyTimeOfDay:        "15:04",
+		LayoutKeyTimeOfDaySeconds: "15:04:05",
+
+		ErrorKeyValidDate:    "{{title}} debe ser una fecha válida",
+		ErrorKeyNotValidDate: "{{title}} no puede ser una fecha válida",
+
+		ErrorKeyValidTimeOfDay:    "{{title}} debe ser una hora del día válida",
+		ErrorKeyNotValidTimeOfDay: "{{title}} no puede ser una hora del día válida",
+
+		ErrorKeyLeapDay:    "{{title}} debe ser el 29 de febrero",
+		ErrorKeyNotLeapDay: "{{title}} no puede ser el 29 de febrero",
+
+		ErrorKeyTime:    "{{title}} debe ser una hora con el formato \"{{layout}}\"",
+		ErrorKeyNotTime: "{{title}} no puede ser una hora con el formato \"{{layout}}\"",
+
+		ErrorKeyRFC3339:    "{{title}} debe ser una marca de tiempo RFC 3339",
+		ErrorKeyNotRFC3339: "{{title}} no puede ser una marca de tiempo RFC 3339",
+
+		ErrorKeyISO8601Date:    "{{title}} debe ser una fecha ISO 8601",
+		ErrorKeyNotISO8601Date: "{{title}} no puede ser una fecha ISO 8601",
+
+		ErrorKeyISO8601Duration:    "{{title}} debe ser una duración ISO 8601",
+		ErrorKeyNotISO8601Duration: "{{title}} no puede ser una duración ISO 8601",
+
+		ErrorKeyUnixTimestamp:    "{{title}} debe ser una marca de tiempo Unix",
+		ErrorKeyNotUnixTimestamp: "{{title}} no puede ser una marca de tiempo Unix",
+
+		ErrorKeyStartBeforeEnd:    "{{title}} debe comenzar antes de terminar",
+		ErrorKeyNotStartBeforeEnd: "{{title}} no puede comenzar antes de terminar",
+
+		ErrorKeyMaxSpan:    "{{title}} no puede durar más de {{span}}",
+		ErrorKeyNotMaxSpan: "{{title}} debe durar más de {{span}}",
+
+		ErrorKeyMinSpan:    "{{title}} no puede durar menos de {{span}}",
+		ErrorKeyNotMinSpan: "{{title}} debe durar menos de {{span}}",
+
+		ErrorKeyAlignedTo:    "{{title}} debe estar alineado a {{duration}}",
+		ErrorKeyNotAlignedTo: "{{title}} no puede estar alineado a {{duration}}",
+
+		ErrorKeyNonOverlapping:    "{{title}} no puede superponerse con {{other}}",
+		ErrorKeyNotNonOverlapping: "{{title}} debe superponerse con {{other}}",
+
+		ErrorKeyContiguous:    "{{title}} debe comenzar cuando termina {{other}}",
+		ErrorKeyNotContiguous: "{{title}} no puede comenzar cuando termina {{other}}",
+
+		ErrorKeySorted:    "{{title}} no puede comenzar antes que {{other}}",
+		ErrorKeyNotSorted: "{{title}} debe comenzar antes que {{other}}",
+
 		OrKeyPair:   " o ",
 		OrKeyMiddle: "; ",
 		OrKeyEnd:    "; o ",
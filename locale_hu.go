@@ -95,6 +95,449 @@ func getLocaleHu() *Locale {
 		ErrorKeyFinite:    "{{title}} véges kell legyen",
 		ErrorKeyNotFinite: "{{title}} nem lehet véges",
 
+		ErrorKeyContains:    "{{title}} tartalmaznia kell \"{{value}}\" értéket",
+		ErrorKeyNotContains: "{{title}} nem tartalmazhat \"{{value}}\" értéket",
+
+		ErrorKeyUnique:    "{{title}} nem tartalmazhat ismétlődő értékeket",
+		ErrorKeyNotUnique: "{{title}} ismétlődő értékeket kell tartalmazzon",
+
+		ErrorKeyHasKeys:    "{{title}} tartalmaznia kell a \"{{keys}}\" kulcsokat",
+		ErrorKeyNotHasKeys: "{{title}} nem tartalmazhatja a \"{{keys}}\" kulcsokat",
+
+		ErrorKeyOnlyKeys:    "{{title}} csak a \"{{keys}}\" kulcsokat tartalmazhatja",
+		ErrorKeyNotOnlyKeys: "{{title}} a \"{{keys}}\" kulcsoktól eltérő kulcsokat kell tartalmazzon",
+
+		ErrorKeyMultipleOf:    "{{title}} \"{{value}}\" többszöröse kell legyen",
+		ErrorKeyNotMultipleOf: "{{title}} nem lehet \"{{value}}\" többszöröse",
+
+		ErrorKeyTruncated:    "{{title}} nem lehet pontosabb, mint \"{{value}}\"",
+		ErrorKeyNotTruncated: "{{title}} pontosabb kell legyen, mint \"{{value}}\"",
+
+		ErrorKeyDecimal:    "{{title}} decimális szám kell legyen",
+		ErrorKeyNotDecimal: "{{title}} nem lehet decimális szám",
+
+		ErrorKeyMaxPrecision:    "{{title}} legfeljebb \"{{precision}}\" számjegyből állhat",
+		ErrorKeyNotMaxPrecision: "{{title}} több mint \"{{precision}}\" számjegyből kell álljon",
+
+		ErrorKeyMaxScale:    "{{title}} legfeljebb \"{{scale}}\" tizedesjegyet tartalmazhat",
+		ErrorKeyNotMaxScale: "{{title}} több mint \"{{scale}}\" tizedesjegyet kell tartalmazzon",
+
+		ErrorKeyScale:    "{{title}} pontosan \"{{scale}}\" tizedesjegyet kell tartalmazzon",
+		ErrorKeyNotScale: "{{title}} nem tartalmazhat pontosan \"{{scale}}\" tizedesjegyet",
+
+		ErrorKeyNonNegative:    "{{title}} nem lehet negatív",
+		ErrorKeyNotNonNegative: "{{title}} negatív kell legyen",
+
+		ErrorKeyCurrencyMinorUnits:    "{{title}} nem érvényes összeg a(z) \"{{currency}}\" pénznemben",
+		ErrorKeyNotCurrencyMinorUnits: "{{title}} nem lehet érvényes összeg a(z) \"{{currency}}\" pénznemben",
+		ErrorKeyUnknownCurrency:       "{{title}} nem ellenőrizhető a(z) \"{{currency}}\" ismeretlen pénznemmel",
+
+		ErrorKeyIP:    "{{title}} érvényes IP-cím kell legyen",
+		ErrorKeyNotIP: "{{title}} nem lehet érvényes IP-cím",
+
+		ErrorKeyPrefix:    "{{title}} érvényes hálózati előtag kell legyen",
+		ErrorKeyNotPrefix: "{{title}} nem lehet érvényes hálózati előtag",
+
+		ErrorKeyAddrPort:    "{{title}} érvényes IP-cím és port kell legyen",
+		ErrorKeyNotAddrPort: "{{title}} nem lehet érvényes IP-cím és port",
+
+		ErrorKeyIPv4:    "{{title}} IPv4 kell legyen",
+		ErrorKeyNotIPv4: "{{title}} nem lehet IPv4",
+
+		ErrorKeyIPv6:    "{{title}} IPv6 kell legyen",
+		ErrorKeyNotIPv6: "{{title}} nem lehet IPv6",
+
+		ErrorKeyPrivate:    "{{title}} privát kell legyen",
+		ErrorKeyNotPrivate: "{{title}} nem lehet privát",
+
+		ErrorKeyLoopback:    "{{title}} loopback kell legyen",
+		ErrorKeyNotLoopback: "{{title}} nem lehet loopback",
+
+		ErrorKeyGlobal:    "{{title}} nyilvános cím kell legyen",
+		ErrorKeyNotGlobal: "{{title}} nem lehet nyilvános cím",
+
+		ErrorKeyMulticast:    "{{title}} multicast kell legyen",
+		ErrorKeyNotMulticast: "{{title}} nem lehet multicast",
+
+		ErrorKeyMasked:    "{{title}} nem tartalmazhat beállított biteket az előtaghossz után",
+		ErrorKeyNotMasked: "{{title}} beállított biteket kell tartalmazzon az előtaghossz után",
+
+		ErrorKeyInPrefix:    "{{title}} a(z) \"{{prefix}}\" tartományba kell essen",
+		ErrorKeyNotInPrefix: "{{title}} nem eshet a(z) \"{{prefix}}\" tartományba",
+
+		ErrorKeyOutsidePrefixes:    "{{title}} nem eshet a(z) \"{{prefixes}}\" tartományokba",
+		ErrorKeyNotOutsidePrefixes: "{{title}} a(z) \"{{prefixes}}\" tartományokba kell essen",
+
+		ErrorKeyPortBetween:    "{{title}} portja \"{{min}}\" és \"{{max}}\" között kell legyen",
+		ErrorKeyNotPortBetween: "{{title}} portja nem lehet \"{{min}}\" és \"{{max}}\" között",
+
+		ErrorKeyURL:    "{{title}} érvényes URL kell legyen",
+		ErrorKeyNotURL: "{{title}} nem lehet érvényes URL",
+
+		ErrorKeyAbsoluteURL:    "{{title}} abszolút URL kell legyen",
+		ErrorKeyNotAbsoluteURL: "{{title}} nem lehet abszolút URL",
+
+		ErrorKeySchemeIn:    "{{title}} a(z) \"{{schemes}}\" sémák egyikét kell használja",
+		ErrorKeyNotSchemeIn: "{{title}} nem használhatja a(z) \"{{schemes}}\" sémák egyikét sem",
+
+		ErrorKeyHostIn:    "{{title}} hosztja a(z) \"{{hosts}}\" egyike kell legyen",
+		ErrorKeyNotHostIn: "{{title}} hosztja nem lehet a(z) \"{{hosts}}\" egyike sem",
+
+		ErrorKeyWithoutUserInfo:    "{{title}} nem tartalmazhat felhasználói adatokat",
+		ErrorKeyNotWithoutUserInfo: "{{title}} felhasználói adatokat kell tartalmazzon",
+
+		ErrorKeyWithoutFragment:    "{{title}} nem tartalmazhat fragmentumot",
+		ErrorKeyNotWithoutFragment: "{{title}} fragmentumot kell tartalmazzon",
+
+		ErrorKeyPathPrefix:    "{{title}} útvonala \"{{prefix}}\" kezdetű kell legyen",
+		ErrorKeyNotPathPrefix: "{{title}} útvonala nem lehet \"{{prefix}}\" kezdetű",
+
+		ErrorKeyExternalHost:    "{{title}} nem mutathat belső hosztra",
+		ErrorKeyNotExternalHost: "{{title}} belső hosztra kell mutasson",
+
+		ErrorKeyEmail:    "{{title}} érvényes e-mail-cím kell legyen",
+		ErrorKeyNotEmail: "{{title}} nem lehet érvényes e-mail-cím",
+
+		ErrorKeyEmailDomainIn:    "{{title}} a(z) \"{{domains}}\" domainek egyikét kell használja",
+		ErrorKeyNotEmailDomainIn: "{{title}} nem használhatja a(z) \"{{domains}}\" domainek egyikét sem",
+
+		ErrorKeyEmailDomainExcluded:    "{{title}} nem használhatja a(z) \"{{domains}}\" domainek egyikét sem",
+		ErrorKeyNotEmailDomainExcluded: "{{title}} a(z) \"{{domains}}\" domainek egyikét kell használja",
+
+		ErrorKeyUUID:    "{{title}} érvényes UUID kell legyen",
+		ErrorKeyNotUUID: "{{title}} nem lehet érvényes UUID",
+
+		ErrorKeyUUIDVersion:    "{{title}} \"{{versions}}\" verziójú UUID kell legyen",
+		ErrorKeyNotUUIDVersion: "{{title}} nem lehet \"{{versions}}\" verziójú UUID",
+
+		ErrorKeyULID:    "{{title}} érvényes ULID kell legyen",
+		ErrorKeyNotULID: "{{title}} nem lehet érvényes ULID",
+
+		ErrorKeyKSUID:    "{{title}} érvényes KSUID kell legyen",
+		ErrorKeyNotKSUID: "{{title}} nem lehet érvényes KSUID",
+
+		ErrorKeyHex:    "{{title}} hexadecimális karakterlánc kell legyen",
+		ErrorKeyNotHex: "{{title}} nem lehet hexadecimális karakterlánc",
+
+		ErrorKeyHexLength:    "{{title}} \"{{length}}\" karakteres hexadecimális karakterlánc kell legyen",
+		ErrorKeyNotHexLength: "{{title}} nem lehet \"{{length}}\" karakteres hexadecimális karakterlánc",
+
+		ErrorKeyBase64:    "{{title}} érvényes Base64 karakterlánc kell legyen",
+		ErrorKeyNotBase64: "{{title}} nem lehet érvényes Base64 karakterlánc",
+
+		ErrorKeyBase32:    "{{title}} érvényes Base32 karakterlánc kell legyen",
+		ErrorKeyNotBase32: "{{title}} nem lehet érvényes Base32 karakterlánc",
+
+		ErrorKeyLuhn:    "{{title}} érvényes Luhn-szám kell legyen",
+		ErrorKeyNotLuhn: "{{title}} nem lehet érvényes Luhn-szám",
+
+		ErrorKeyCreditCard:    "{{title}} érvényes bankkártyaszám kell legyen",
+		ErrorKeyNotCreditCard: "{{title}} nem lehet érvényes bankkártyaszám",
+
+		ErrorKeyCreditCardBrand:    "{{title}} a(z) \"{{brands}}\" márkák egyikének kártyája kell legyen",
+		ErrorKeyNotCreditCardBrand: "{{title}} nem lehet a(z) \"{{brands}}\" márkák egyikének kártyája",
+
+		ErrorKeyIBAN:    "{{title}} érvényes IBAN kell legyen",
+		ErrorKeyNotIBAN: "{{title}} nem lehet érvényes IBAN",
+
+		ErrorKeyIBANCountry:    "{{title}} a(z) \"{{countries}}\" országok egyikének IBAN-ja kell legyen",
+		ErrorKeyNotIBANCountry: "{{title}} nem lehet a(z) \"{{countries}}\" országok egyikének IBAN-ja",
+
+		ErrorKeyBIC:    "{{title}} érvényes BIC kell legyen",
+		ErrorKeyNotBIC: "{{title}} nem lehet érvényes BIC",
+
+		ErrorKeyISBN10:    "{{title}} érvényes ISBN-10 kell legyen",
+		ErrorKeyNotISBN10: "{{title}} nem lehet érvényes ISBN-10",
+
+		ErrorKeyISBN13:    "{{title}} érvényes ISBN-13 kell legyen",
+		ErrorKeyNotISBN13: "{{title}} nem lehet érvényes ISBN-13",
+
+		ErrorKeyEAN13:    "{{title}} érvényes EAN-13 kell legyen",
+		ErrorKeyNotEAN13: "{{title}} nem lehet érvényes EAN-13",
+
+		ErrorKeyISSN:    "{{title}} érvényes ISSN kell legyen",
+		ErrorKeyNotISSN: "{{title}} nem lehet érvényes ISSN",
+
+		ErrorKeyChecksum:    "{{title}} ellenőrző számjegye érvénytelen",
+		ErrorKeyNotChecksum: "{{title}} ellenőrző számjegye érvénytelen kell legyen",
+
+		ErrorKeyPhone:    "{{title}} érvényes nemzetközi formátumú telefonszám kell legyen",
+		ErrorKeyNotPhone: "{{title}} nem lehet érvényes nemzetközi formátumú telefonszám",
+
+		ErrorKeyPhoneForRegion:    "{{title}} érvényes {{region}} telefonszám kell legyen",
+		ErrorKeyNotPhoneForRegion: "{{title}} nem lehet érvényes {{region}} telefonszám",
+
+		ErrorKeySemVer:    "{{title}} érvényes szemantikus verzió kell legyen",
+		ErrorKeyNotSemVer: "{{title}} nem lehet érvényes szemantikus verzió",
+
+		ErrorKeySatisfiesConstraint:    "{{title}} meg kell feleljen a(z) \"{{constraint}}\" verziófeltételnek",
+		ErrorKeyNotSatisfiesConstraint: "{{title}} nem felelhet meg a(z) \"{{constraint}}\" verziófeltételnek",
+		ErrorKeyMalformedConstraint:    "{{title}} nem ellenőrizhető a(z) \"{{constraint}}\" hibás verziófeltétellel",
+
+		ErrorKeySemVerGreaterThan:    "{{title}} \"{{version}}\" verziónál nagyobb kell legyen",
+		ErrorKeyNotSemVerGreaterThan: "{{title}} nem lehet \"{{version}}\" verziónál nagyobb",
+
+		ErrorKeySemVerBetween:    "{{title}} \"{{min}}\" és \"{{max}}\" közötti verzió kell legyen",
+		ErrorKeyNotSemVerBetween: "{{title}} nem lehet \"{{min}}\" és \"{{max}}\" közötti verzió",
+
+		ErrorKeyJSON:    "{{title}} érvényes JSON kell legyen, hiba a(z) {{line}}. sor {{column}}. oszlopában",
+		ErrorKeyNotJSON: "{{title}} nem lehet érvényes JSON",
+
+		ErrorKeyJSONObject:    "{{title}} JSON objektum kell legyen",
+		ErrorKeyNotJSONObject: "{{title}} nem lehet JSON objektum",
+
+		ErrorKeyJSONArray:    "{{title}} JSON tömb kell legyen",
+		ErrorKeyNotJSONArray: "{{title}} nem lehet JSON tömb",
+
+		ErrorKeyRegexp:    "{{title}} érvényes reguláris kifejezés kell legyen, hiba a(z) {{line}}. sor {{column}}. oszlopában",
+		ErrorKeyNotRegexp: "{{title}} nem lehet érvényes reguláris kifejezés",
+
+		ErrorKeyGoTemplate:    "{{title}} érvényes Go sablon kell legyen, hiba a(z) {{line}}. sorban",
+		ErrorKeyNotGoTemplate: "{{title}} nem lehet érvényes Go sablon",
+
+		ErrorKeyPassword:    "{{title}} {{requirements}}",
+		ErrorKeyNotPassword: "{{title}} nem felelhet meg a jelszókövetelményeknek",
+
+		ErrorKeyPasswordMinLength:   "legalább {{min}} karakterből kell álljon",
+		ErrorKeyPasswordLowercase:   "kisbetűt kell tartalmazzon",
+		ErrorKeyPasswordUppercase:   "nagybetűt kell tartalmazzon",
+		ErrorKeyPasswordDigit:       "számjegyet kell tartalmazzon",
+		ErrorKeyPasswordSymbol:      "szimbólumot kell tartalmazzon",
+		ErrorKeyPasswordMaxRepeated: "nem ismételhet egy karaktert {{max}} alkalomnál többször egymás után",
+		ErrorKeyPasswordEntropy:     "kevésbé kiszámítható kell legyen",
+		ErrorKeyPasswordCommon:      "nem lehet gyakori jelszó",
+
+		ErrorKeyPrintable:    "{{title}} csak nyomtatható karaktereket tartalmazhat",
+		ErrorKeyNotPrintable: "{{title}} nem tartalmazhat csak nyomtatható karaktereket",
+
+		ErrorKeyWithoutControlChars:    "{{title}} nem tartalmazhat vezérlőkaraktereket",
+		ErrorKeyNotWithoutControlChars: "{{title}} vezérlőkaraktereket kell tartalmazzon",
+
+		ErrorKeyNormalizedNFC:    "{{title}} NFC Unicode normalizálási formában kell legyen",
+		ErrorKeyNotNormalizedNFC: "{{title}} nem lehet NFC Unicode normalizálási formában",
+
+		ErrorKeySingleScript:    "{{title}} nem keverhet különböző írásrendszerekből származó karaktereket",
+		ErrorKeyNotSingleScript: "{{title}} különböző írásrendszerekből származó karaktereket kell keverjen",
+
+		ErrorKeyScriptIn:    "{{title}} csak a(z) {{scripts}} írásrendszerek karaktereit tartalmazhatja",
+		ErrorKeyNotScriptIn: "{{title}} nem tartalmazhatja csak a(z) {{scripts}} írásrendszerek karaktereit",
+
+		ErrorKeyWithoutConfusables:    "{{title}} nem tartalmazhat latin betűkhöz hasonló karaktereket",
+		ErrorKeyNotWithoutConfusables: "{{title}} latin betűkhöz hasonló karaktereket kell tartalmazzon",
+
+		ErrorKeySingleLine:    "{{title}} egyetlen sor kell legyen",
+		ErrorKeyNotSingleLine: "{{title}} nem lehet egyetlen sor",
+
+		ErrorKeyLowercase:    "{{title}} nem tartalmazhat nagybetűket",
+		ErrorKeyNotLowercase: "{{title}} nagybetűket kell tartalmazzon",
+
+		ErrorKeyUppercase:    "{{title}} nem tartalmazhat kisbetűket",
+		ErrorKeyNotUppercase: "{{title}} kisbetűket kell tartalmazzon",
+
+		ErrorKeySlug:    "{{title}} kisbetűkből, számjegyekből és kötőjelekből álló slug kell legyen",
+		ErrorKeyNotSlug: "{{title}} nem lehet slug",
+
+		ErrorKeySnakeCase:    "{{title}} snake_case formátumú kell legyen",
+		ErrorKeyNotSnakeCase: "{{title}} nem lehet snake_case formátumú",
+
+		ErrorKeyKebabCase:    "{{title}} kebab-case formátumú kell legyen",
+		ErrorKeyNotKebabCase: "{{title}} nem lehet kebab-case formátumú",
+
+		ErrorKeyCamelCase:    "{{title}} camelCase formátumú kell legyen",
+		ErrorKeyNotCamelCase: "{{title}} nem lehet camelCase formátumú",
+
+		ErrorKeyPascalCase:    "{{title}} PascalCase formátumú kell legyen",
+		ErrorKeyNotPascalCase: "{{title}} nem lehet PascalCase formátumú",
+
+		ErrorKeyGoIdentifier:    "{{title}} érvényes Go azonosító kell legyen",
+		ErrorKeyNotGoIdentifier: "{{title}} nem lehet érvényes Go azonosító",
+
+		ErrorKeyAlpha:    "{{title}} csak a-tól z-ig terjedő betűket tartalmazhat",
+		ErrorKeyNotAlpha: "{{title}} nem tartalmazhat csak a-tól z-ig terjedő betűket",
+
+		ErrorKeyAlphaUnicode:    "{{title}} csak betűket tartalmazhat",
+		ErrorKeyNotAlphaUnicode: "{{title}} nem tartalmazhat csak betűket",
+
+		ErrorKeyAlphanumeric:    "{{title}} csak a-tól z-ig terjedő betűket és számjegyeket tartalmazhat",
+		ErrorKeyNotAlphanumeric: "{{title}} nem tartalmazhat csak a-tól z-ig terjedő betűket és számjegyeket",
+
+		ErrorKeyAlphanumericUnicode:    "{{title}} csak betűket és számjegyeket tartalmazhat",
+		ErrorKeyNotAlphanumericUnicode: "{{title}} nem tartalmazhat csak betűket és számjegyeket",
+
+		ErrorKeyNumeric:    "{{title}} csak 0-tól 9-ig terjedő számjegyeket tartalmazhat",
+		ErrorKeyNotNumeric: "{{title}} nem tartalmazhat csak 0-tól 9-ig terjedő számjegyeket",
+
+		ErrorKeyNumericUnicode:    "{{title}} csak számjegyeket tartalmazhat",
+		ErrorKeyNotNumericUnicode: "{{title}} nem tartalmazhat csak számjegyeket",
+
+		ErrorKeyASCII:    "{{title}} csak ASCII karaktereket tartalmazhat",
+		ErrorKeyNotASCII: "{{title}} nem tartalmazhat csak ASCII karaktereket",
+
+		ErrorKeyHasPrefix:    "{{title}} \"{{value}}\" értékkel kell kezdődjön",
+		ErrorKeyNotHasPrefix: "{{title}} nem kezdődhet \"{{value}}\" értékkel",
+
+		ErrorKeyHasSuffix:    "{{title}} \"{{value}}\" értékre kell végződjön",
+		ErrorKeyNotHasSuffix: "{{title}} nem végződhet \"{{value}}\" értékre",
+
+		ErrorKeyContainsAny:    "{{title}} tartalmaznia kell legalább egyet a(z) \"{{value}}\" karakterek közül",
+		ErrorKeyNotContainsAny: "{{title}} nem tartalmazhatja a(z) \"{{value}}\" karakterek egyikét sem",
+
+		ErrorKeyExcludesAll:    "{{title}} nem tartalmazhatja a(z) \"{{value}}\" karakterek egyikét sem",
+		ErrorKeyNotExcludesAll: "{{title}} tartalmaznia kell legalább egyet a(z) \"{{value}}\" karakterek közül",
+
+		ErrorKeyContainsFold:    "{{title}} tartalmaznia kell \"{{value}}\" értéket, kis- és nagybetűtől függetlenül",
+		ErrorKeyNotContainsFold: "{{title}} nem tartalmazhat \"{{value}}\" értéket, kis- és nagybetűtől függetlenül",
+
+		ErrorKeyEqualToFold:    "{{title}} egyenlő kell legyen \"{{value}}\" értékkel, kis- és nagybetűtől függetlenül",
+		ErrorKeyNotEqualToFold: "{{title}} nem lehet egyenlő \"{{value}}\" értékkel, kis- és nagybetűtől függetlenül",
+
+		ErrorKeyWordCountBetween:    "{{title}} \"{{min}}\" és \"{{max}}\" közötti számú szóból kell álljon",
+		ErrorKeyNotWordCountBetween: "{{title}} nem állhat \"{{min}}\" és \"{{max}}\" közötti számú szóból",
+
+		ErrorKeyHostname:    "{{title}} érvényes hosztnév kell legyen",
+		ErrorKeyNotHostname: "{{title}} nem lehet érvényes hosztnév",
+
+		ErrorKeyFQDN:    "{{title}} teljes tartománynév kell legyen",
+		ErrorKeyNotFQDN: "{{title}} nem lehet teljes tartománynév",
+
+		ErrorKeyDomainWithTLD:    "{{title}} legfelső szintű tartománnyal rendelkező tartománynév kell legyen",
+		ErrorKeyNotDomainWithTLD: "{{title}} nem lehet legfelső szintű tartománnyal rendelkező tartománynév",
+
+		ErrorKeyHostPort:    "{{title}} hoszt és port kell legyen, például \"example.com:443\"",
+		ErrorKeyNotHostPort: "{{title}} nem lehet hoszt és port",
+
+		ErrorKeyPort:    "{{title}} 1 és 65535 közötti portszám kell legyen",
+		ErrorKeyNotPort: "{{title}} nem lehet 1 és 65535 közötti portszám",
+
+		ErrorKeyPunycodeHostname:    "{{title}} érvényes hosztnév kell legyen, Punycode kódolású nemzetköziesített címkékkel",
+		ErrorKeyNotPunycodeHostname: "{{title}} nem lehet érvényes hosztnév Punycode kódolású nemzetköziesített címkékkel",
+
+		ErrorKeyHostnameLabel:    "{{title}} érvénytelen címkét tartalmaz: \"{{label}}\"",
+		ErrorKeyNotHostnameLabel: "{{title}} érvénytelen címkét kell tartalmazzon",
+
+		ErrorKeyHostnameEmptyLabel:    "{{title}} nem tartalmazhat üres címkéket",
+		ErrorKeyNotHostnameEmptyLabel: "{{title}} üres címkéket kell tartalmazzon",
+
+		ErrorKeyHostnameLabelLength:    "{{title}} \"{{label}}\" címkéje hosszabb {{max}} karakternél",
+		ErrorKeyNotHostnameLabelLength: "{{title}} {{max}} karakternél hosszabb címkét kell tartalmazzon",
+
+		ErrorKeyHostnameLength:    "{{title}} nem lehet hosszabb {{max}} karakternél",
+		ErrorKeyNotHostnameLength: "{{title}} hosszabb kell legyen {{max}} karakternél",
+
+		ErrorKeyOneOfRanges:    "{{title}} a következő tartományok egyikébe kell essen: {{ranges}}",
+		ErrorKeyNotOneOfRanges: "{{title}} nem eshet a következő tartományok egyikébe sem: {{ranges}}",
+
+		ErrorKeyEven:    "{{title}} páros szám kell legyen",
+		ErrorKeyNotEven: "{{title}} nem lehet páros szám",
+
+		ErrorKeyOdd:    "{{title}} páratlan szám kell legyen",
+		ErrorKeyNotOdd: "{{title}} nem lehet páratlan szám",
+
+		ErrorKeyPowerOfTwo:    "{{title}} kettő hatványa kell legyen",
+		ErrorKeyNotPowerOfTwo: "{{title}} nem lehet kettő hatványa",
+
+		ErrorKeyEqualWithin:    "{{title}} \"{{epsilon}}\" tűréssel egyenlő kell legyen ezzel: \"{{value}}\"",
+		ErrorKeyNotEqualWithin: "{{title}} \"{{epsilon}}\" tűréssel nem lehet egyenlő ezzel: \"{{value}}\"",
+
+		ErrorKeyFitsIn:    "{{title}} bele kell férjen a(z) \"{{type}}\" típusba",
+		ErrorKeyNotFitsIn: "{{title}} nem férhet bele a(z) \"{{type}}\" típusba",
+
+		ErrorKeyInFuture:    "{{title}} a jövőben kell legyen",
+		ErrorKeyNotInFuture: "{{title}} nem lehet a jövőben",
+
+		ErrorKeyInPast:    "{{title}} a múltban kell legyen",
+		ErrorKeyNotInPast: "{{title}} nem lehet a múltban",
+
+		ErrorKeyWithinLast:    "{{title}} az elmúlt \"{{duration}}\" időn belül kell legyen",
+		ErrorKeyNotWithinLast: "{{title}} nem lehet az elmúlt \"{{duration}}\" időn belül",
+
+		ErrorKeyWithinNext:    "{{title}} a következő \"{{duration}}\" időn belül kell legyen",
+		ErrorKeyNotWithinNext: "{{title}} nem lehet a következő \"{{duration}}\" időn belül",
+
+		ErrorKeyOlderThan:    "{{title}} régebbi kell legyen, mint \"{{duration}}\"",
+		ErrorKeyNotOlderThan: "{{title}} nem lehet régebbi, mint \"{{duration}}\"",
+
+		ErrorKeyAgeAtLeast:    "{{title}} legalább {{years}} évvel ezelőtti kell legyen",
+		ErrorKeyNotAgeAtLeast: "{{title}} nem lehet legalább {{years}} évvel ezelőtti",
+
+		ErrorKeyWeekday:    "{{title}} a következő napok egyikére kell essen: {{days}}",
+		ErrorKeyNotWeekday: "{{title}} nem eshet a következő napok egyikére sem: {{days}}",
+
+		ErrorKeyTimeOfDayBetween:    "{{title}} \"{{from}}\" és \"{{to}}\" között kell legyen",
+		ErrorKeyNotTimeOfDayBetween: "{{title}} nem lehet \"{{from}}\" és \"{{to}}\" között",
+
+		ErrorKeySameDay:    "{{title}} ugyanarra a napra kell essen, mint \"{{date}}\"",
+		ErrorKeyNotSameDay: "{{title}} nem eshet ugyanarra a napra, mint \"{{date}}\"",
+
+		ErrorKeySameMonth:    "{{title}} ugyanabba a hónapba kell essen, mint \"{{date}}\"",
+		ErrorKeyNotSameMonth: "{{title}} nem eshet ugyanabba a hónapba, mint \"{{date}}\"",
+
+		ErrorKeyInLocation:    "{{title}} a(z) \"{{location}}\" időzónában kell legyen",
+		ErrorKeyNotInLocation: "{{title}} nem lehet a(z) \"{{location}}\" időzónában",
+
+		ErrorKeyExcludedDate:    "{{title}} nem eshet erre a napra: \"{{date}}\"",
+		ErrorKeyNotExcludedDate: "{{title}} a kizárt dátumok egyikére kell essen",
+
+		ErrorKeyBusinessDay:    "{{title}} munkanapra kell essen",
+		ErrorKeyNotBusinessDay: "{{title}} nem eshet munkanapra",
+
+		WeekdayKeySunday:    "vasárnap",
+		WeekdayKeyMonday:    "hétfő",
+		WeekdayKeyTuesday:   "kedd",
+		WeekdayKeyWednesday: "szerda",
+		WeekdayKeyThursday:  "csütörtök",
+		WeekdayKeyFriday:    "péntek",
+		WeekdayKeySaturday:  "szombat",
+
+		LayoutKeyDate:             "2006.01.02.",
+		LayoutKeyTimeOfDay:        "15:04",
+		LayoutKeyTimeOfDaySeconds: "15:04:05",
+
+		ErrorKeyValidDate:    "{{title}} érvényes dátum kell legyen",
+		ErrorKeyNotValidDate: "{{title}} nem lehet érvényes dátum",
+
+		ErrorKeyValidTimeOfDay:    "{{title}} érvényes időpont kell legyen",
+		ErrorKeyNotValidTimeOfDay: "{{title}} nem lehet érvényes időpont",
+
+		ErrorKeyLeapDay:    "{{title}} február 29. kell legyen",
+		ErrorKeyNotLeapDay: "{{title}} nem lehet február 29.",
+
+		ErrorKeyTime:    "{{title}} \"{{layout}}\" formátumú időpont kell legyen",
+		ErrorKeyNotTime: "{{title}} nem lehet \"{{layout}}\" formátumú időpont",
+
+		ErrorKeyRFC3339:    "{{title}} RFC 3339 időbélyeg kell legyen",
+		ErrorKeyNotRFC3339: "{{title}} nem lehet RFC 3339 időbélyeg",
+
+		ErrorKeyISO8601Date:    "{{title}} ISO 8601 dátum kell legyen",
+		ErrorKeyNotISO8601Date: "{{title}} nem lehet ISO 8601 dátum",
+
+		ErrorKeyISO8601Duration:    "{{title}} ISO 8601 időtartam kell legyen",
+		ErrorKeyNotISO8601Duration: "{{title}} nem lehet ISO 8601 időtartam",
+
+		ErrorKeyUnixTimestamp:    "{{title}} Unix időbélyeg kell legyen",
+		ErrorKeyNotUnixTimestamp: "{{title}} nem lehet Unix időbélyeg",
+
+		ErrorKeyStartBeforeEnd:    "{{title}} kezdete a vége előtt kell legyen",
+		ErrorKeyNotStartBeforeEnd: "{{title}} kezdete nem lehet a vége előtt",
+
+		ErrorKeyMaxSpan:    "{{title}} nem lehet hosszabb, mint {{span}}",
+		ErrorKeyNotMaxSpan: "{{title}} hosszabb kell legyen, mint {{span}}",
+
+		ErrorKeyMinSpan:    "{{title}} nem lehet rövidebb, mint {{span}}",
+		ErrorKeyNotMinSpan: "{{title}} rövidebb kell legyen, mint {{span}}",
+
+		ErrorKeyAlignedTo:    "{{title}} {{duration}} egységekhez kell igazodjon",
+		ErrorKeyNotAlignedTo: "{{title}} nem igazodhat {{duration}} egységekhez",
+
+		ErrorKeyNonOverlapping:    "{{title}} nem fedheti át a következőt: {{other}}",
+		ErrorKeyNotNonOverlapping: "{{title}} át kell fedje a következőt: {{other}}",
+
+		ErrorKeyContiguous:    "{{title}} akkor kell kezdődjön, amikor {{other}} véget ér",
+		ErrorKeyNotContiguous: "{{title}} nem kezdődhet akkor, amikor {{other}} véget ér",
+
+		ErrorKeySorted:    "{{title}} nem kezdődhet {{other}} előtt",
+		ErrorKeyNotSorted: "{{title}} {{other}} előtt kell kezdődjön",
+
 		OrKeyPair:   " vagy ",
 		OrKeyMiddle: "; ",
 		OrKeyEnd:    "; vagy ",
@@ -128,3 +128,20 @@ func TestLocalesIsInValidationScope(t *testing.T) {
 	v = New().Is(String(" ").Not().Blank())
 	assert.Contains(t, v.Errors()["value_0"].Messages(), "Value 0 can't be blank")
 }
+
+func TestBuiltInLocalesHaveSameKeys(t *testing.T) {
+	en := getLocaleEn()
+
+	for code, locale := range map[string]*Locale{
+		LocaleCodeEs: getLocaleEs(),
+		LocaleCodeDe: getLocaleDe(),
+		LocaleCodeHu: getLocaleHu(),
+	} {
+		for key := range *en {
+			assert.Contains(t, *locale, key, "locale %q is missing the key %q", code, key)
+		}
+		for key := range *locale {
+			assert.Contains(t, *en, key, "locale %q has the unknown key %q", code, key)
+		}
+	}
+}
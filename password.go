@@ -0,0 +1,20 @@
+package valgo
+
+// A small list of the most common passwords found in public breach lists, used
+// by the `Password` rules when the [PasswordPolicy] rejects common passwords
+// without its own blocklist. The passwords are compared ignoring the case.
+var commonPasswords = []string{
+	"000000", "111111", "112233", "121212", "123123", "123321", "1234",
+	"12345", "123456", "1234567", "12345678", "123456789", "1234567890",
+	"123qwe", "131313", "159753", "1q2w3e", "1q2w3e4r", "1q2w3e4r5t",
+	"222222", "555555", "654321", "666666", "696969", "777777", "7777777",
+	"888888", "987654321", "aaaaaa", "abc123", "abcd1234", "access",
+	"admin", "admin123", "administrator", "asdfgh", "asdfghjkl", "azerty",
+	"baseball", "batman", "charlie", "daniel", "dragon", "football",
+	"freedom", "hello", "hello123", "iloveyou", "jennifer", "jordan",
+	"letmein", "login", "lovely", "master", "michael", "monkey", "mustang",
+	"passw0rd", "password", "password1", "password12", "password123",
+	"princess", "qazwsx", "qwerty", "qwerty123", "qwertyuiop", "secret",
+	"shadow", "starwars", "sunshine", "superman", "trustno1", "welcome",
+	"welcome1", "whatever", "zaq12wsx", "zxcvbn", "zxcvbnm",
+}
@@ -0,0 +1,50 @@
+package valgo
+
+// Numbering plan of a region, used to validate phone numbers in the national
+// and the international format. The lengths are the minimum and maximum number
+// of digits of the national significant number, which is the number without
+// the country calling code and the trunk prefix.
+type phoneNumberingPlan struct {
+	callingCode string
+	trunkPrefix string
+	minLength   int
+	maxLength   int
+	// The digits a national significant number can start with
+	leadingDigits string
+}
+
+// Numbering plans of common regions, by ISO 3166 alpha-2 code. The plans are a
+// simplification that accepts every assigned number, but may also accept some
+// unassigned ones.
+var phoneNumberingPlans = map[string]phoneNumberingPlan{
+	"AR": {"54", "0", 10, 11, "123456789"},
+	"AT": {"43", "0", 4, 13, "123456789"},
+	"AU": {"61", "0", 9, 9, "23478"},
+	"BE": {"32", "0", 8, 9, "123456789"},
+	"BR": {"55", "0", 10, 11, "123456789"},
+	"CA": {"1", "", 10, 10, "23456789"},
+	"CH": {"41", "0", 9, 9, "123456789"},
+	"CL": {"56", "", 9, 9, "23456789"},
+	"CN": {"86", "0", 9, 11, "123456789"},
+	"CO": {"57", "", 10, 10, "3456"},
+	"DE": {"49", "0", 6, 13, "123456789"},
+	"DK": {"45", "", 8, 8, "23456789"},
+	"ES": {"34", "", 9, 9, "6789"},
+	"FI": {"358", "0", 5, 12, "123456789"},
+	"FR": {"33", "0", 9, 9, "123456789"},
+	"GB": {"44", "0", 9, 10, "1235789"},
+	"HU": {"36", "06", 8, 9, "123456789"},
+	"IE": {"353", "0", 7, 10, "12456789"},
+	"IN": {"91", "0", 10, 10, "123456789"},
+	"IT": {"39", "", 6, 11, "03"},
+	"JP": {"81", "0", 9, 10, "123456789"},
+	"MX": {"52", "", 10, 10, "123456789"},
+	"NL": {"31", "0", 9, 9, "123456789"},
+	"NO": {"47", "", 8, 8, "2345679"},
+	"NZ": {"64", "0", 8, 10, "2345679"},
+	"PL": {"48", "", 9, 9, "123456789"},
+	"PT": {"351", "", 9, 9, "29"},
+	"SE": {"46", "0", 7, 13, "123456789"},
+	"US": {"1", "", 10, 10, "23456789"},
+	"ZA": {"27", "0", 9, 9, "123456789"},
+}
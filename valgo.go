@@ -24,6 +24,7 @@ func Factory(options FactoryOptions) *ValidationFactory {
 	factory := &ValidationFactory{
 		localeCodeDefault: localeCodeDefault,
 		marshalJsonFunc:   options.MarshalJsonFunc,
+		clock:             options.Clock,
 	}
 
 	if options.LocaleCodeDefault != "" {
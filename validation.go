@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // The [Validation] session in Valgo is the main structure for validating one or
@@ -38,13 +39,15 @@ type Validation struct {
 	invalidateMap   map[string]bool
 	currentIndex    int
 	marshalJsonFunc func(e *Error) ([]byte, error)
+	clock           Clock
 }
 
 // Options struct is used to specify options when creating a new [Validation]
 // session with the [New()] function.
 //
 // It contains parameters for specifying a specific locale code, modify or add a
-// locale, and set a custom JSON marshaler for [Error].
+// locale, set a custom JSON marshaler for [Error], and set the [Clock] used by
+// the rules relative to the current time.
 
 type Options struct {
 	localeCodeDefaultFromFactory string             // Only specified by the factory
@@ -57,6 +60,9 @@ type Options struct {
 	Locale *Locale
 	// A function field that allows to set a custom JSON marshaler for [Error]
 	MarshalJsonFunc func(e *Error) ([]byte, error)
+	// A field that allows to set the [Clock] used by the rules relative to the
+	// current time. The system time is used when it's not set
+	Clock Clock
 }
 
 // Add one or more validators to a [Validation] session.
@@ -697,6 +703,26 @@ func (validation *Validation) getOrCreateValueError(name string, title *string)
 	return ev
 }
 
+// Create a new [Validation] session that shares the locale and the JSON
+// marshaler with this session. It is used to collect the errors of nested
+// validators before they are merged into this session.
+func (validation *Validation) newNestedValidation() *Validation {
+	return &Validation{
+		valid:           true,
+		_locale:         validation._locale,
+		marshalJsonFunc: validation.marshalJsonFunc,
+		clock:           validation.clock,
+	}
+}
+
+// Return the current time according to the [Clock] of the session.
+func (validation *Validation) now() time.Time {
+	if validation.clock != nil {
+		return validation.clock.Now()
+	}
+	return time.Now()
+}
+
 func newValidation(options ...Options) *Validation {
 	v := &Validation{
 		valid: true,
@@ -725,6 +751,7 @@ func newValidation(options ...Options) *Validation {
 			v._locale.merge(_options.Locale)
 		}
 		v.marshalJsonFunc = _options.MarshalJsonFunc
+		v.clock = _options.Clock
 	}
 
 	return v
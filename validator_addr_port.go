@@ -0,0 +1,308 @@
+package valgo
+
+import (
+	"net/netip"
+	"reflect"
+)
+
+// Custom generic type covering the address and port types accepted by
+// [ValidatorAddrPort]: a [netip.AddrPort], or a string type holding the textual
+// form of the address and port.
+type TypeAddrPort interface {
+	netip.AddrPort | ~string
+}
+
+// Convert the value to a [netip.AddrPort]. Returns false if the value is not a
+// valid address and port.
+func addrPortOf[T TypeAddrPort](v T) (netip.AddrPort, bool) {
+	if addrPort, ok := any(v).(netip.AddrPort); ok {
+		return addrPort, addrPort.IsValid()
+	}
+	// The value is a string type, but the generic type can't be converted to a
+	// string directly because netip.AddrPort is part of the type set.
+	addrPort, err := netip.ParseAddrPort(reflect.ValueOf(v).String())
+	return addrPort, err == nil
+}
+
+// The `ValidatorAddrPort` provides functions for setting validation rules for
+// an IP address and port, either a [netip.AddrPort] value or a string with the
+// textual form of the address and port, such as "192.168.0.1:443" or
+// "[2001:db8::1]:443".
+//
+// The address rules are the same as in [ValidatorIP]. A string that is not a
+// valid address and port, or the zero [netip.AddrPort], doesn't satisfy any
+// rule.
+type ValidatorAddrPort[T TypeAddrPort] struct {
+	context *ValidatorContext
+}
+
+// Receive an IP address and port to validate.
+//
+// The value can be a [netip.AddrPort], a string, or a custom string type such
+// as type Endpoint string;.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A `value_%N` pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name phone_number will be
+// humanized as Phone Number.
+func AddrPort[T TypeAddrPort](value T, nameAndTitle ...string) *ValidatorAddrPort[T] {
+	return &ValidatorAddrPort[T]{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorAddrPort[T]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the boolean value associated with the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Loopback() function
+//	Is(v.AddrPort("127.0.0.1:8080").Not().Loopback()).Valid()
+func (validator *ValidatorAddrPort[T]) Not() *ValidatorAddrPort[T] {
+	validator.context.Not()
+
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the address is private (Loopback() OR Private()).
+//	isValid := v.Is(v.AddrPort("10.0.0.1:8080").Loopback().Or().Private()).Valid()
+func (validator *ValidatorAddrPort[T]) Or() *ValidatorAddrPort[T] {
+	validator.context.Or()
+
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the address is a loopback address, the chain succeeds and the rest is not evaluated.
+//	// Otherwise, the address must be a global IPv4 address.
+//	isValid := v.Is(v.AddrPort(endpoint).Loopback().OrElse().Global().IPv4()).Valid()
+func (validator *ValidatorAddrPort[T]) OrElse() *ValidatorAddrPort[T] {
+	validator.context.OrElse()
+
+	return validator
+}
+
+// Validate if the value is a valid IP address and port.
+// For example:
+//
+//	Is(v.AddrPort("192.168.0.1:443").Valid()) // Will be true
+//	Is(v.AddrPort("192.168.0.1:65536").Valid()) // Will be false
+func (validator *ValidatorAddrPort[T]) Valid(template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			_, ok := addrPortOf(validator.context.Value().(T))
+			return ok
+		},
+		ErrorKeyAddrPort, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the IP address of an address and port is an IPv4 address.
+// IPv4-mapped IPv6 addresses, such as "::ffff:10.0.0.1", are considered IPv4
+// addresses.
+// For example:
+//
+//	Is(v.AddrPort("10.0.0.1:8080").IPv4())
+func (validator *ValidatorAddrPort[T]) IPv4(template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addrPort, ok := addrPortOf(validator.context.Value().(T))
+			return ok && isAddrIPv4(addrPort.Addr())
+		},
+		ErrorKeyIPv4, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the IP address of an address and port is an IPv6 address,
+// excluding the IPv4-mapped IPv6 addresses.
+// For example:
+//
+//	Is(v.AddrPort("[2001:db8::1]:443").IPv6())
+func (validator *ValidatorAddrPort[T]) IPv6(template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addrPort, ok := addrPortOf(validator.context.Value().(T))
+			return ok && isAddrIPv6(addrPort.Addr())
+		},
+		ErrorKeyIPv6, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the IP address of an address and port is a private address,
+// according to RFC 1918 for IPv4 and RFC 4193 for IPv6.
+// For example:
+//
+//	Is(v.AddrPort("192.168.0.1:443").Private())
+func (validator *ValidatorAddrPort[T]) Private(template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addrPort, ok := addrPortOf(validator.context.Value().(T))
+			return ok && isAddrPrivate(addrPort.Addr())
+		},
+		ErrorKeyPrivate, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the IP address of an address and port is a loopback address.
+// For example:
+//
+//	Is(v.AddrPort("[::1]:8080").Loopback())
+func (validator *ValidatorAddrPort[T]) Loopback(template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addrPort, ok := addrPortOf(validator.context.Value().(T))
+			return ok && isAddrLoopback(addrPort.Addr())
+		},
+		ErrorKeyLoopback, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the IP address of an address and port is a global unicast
+// address that is not private. Loopback, link-local, multicast, unspecified,
+// and private addresses are not global.
+// For example:
+//
+//	Is(v.AddrPort("8.8.8.8:53").Global()) // Will be true
+//	Is(v.AddrPort("10.0.0.1:8080").Global()) // Will be false
+func (validator *ValidatorAddrPort[T]) Global(template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addrPort, ok := addrPortOf(validator.context.Value().(T))
+			return ok && isAddrGlobal(addrPort.Addr())
+		},
+		ErrorKeyGlobal, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the IP address of an address and port is a multicast address.
+// For example:
+//
+//	Is(v.AddrPort("224.0.0.1:5353").Multicast())
+func (validator *ValidatorAddrPort[T]) Multicast(template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addrPort, ok := addrPortOf(validator.context.Value().(T))
+			return ok && isAddrMulticast(addrPort.Addr())
+		},
+		ErrorKeyMulticast, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the IP address of an address and port belongs to a network prefix.
+// For example:
+//
+//	Is(v.AddrPort("10.1.2.3:22").InPrefix(netip.MustParsePrefix("10.0.0.0/8")))
+func (validator *ValidatorAddrPort[T]) InPrefix(prefix netip.Prefix, template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			addrPort, ok := addrPortOf(validator.context.Value().(T))
+			return ok && isAddrInPrefixes(addrPort.Addr(), []netip.Prefix{prefix})
+		},
+		ErrorKeyInPrefix,
+		map[string]any{"title": validator.context.title, "prefix": prefix.String(), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the IP address of an address and port doesn't belong to any of
+// the network prefixes. This is useful to reject reserved or internal networks.
+// For example:
+//
+//	reserved := []netip.Prefix{
+//		netip.MustParsePrefix("169.254.0.0/16"),
+//		netip.MustParsePrefix("100.64.0.0/10"),
+//	}
+//	Is(v.AddrPort("8.8.8.8:53").NotInPrefixes(reserved))
+func (validator *ValidatorAddrPort[T]) NotInPrefixes(prefixes []netip.Prefix, template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			addrPort, ok := addrPortOf(validator.context.Value().(T))
+			return ok && !isAddrInPrefixes(addrPort.Addr(), prefixes)
+		},
+		ErrorKeyOutsidePrefixes,
+		map[string]any{"title": validator.context.title, "prefixes": joinPrefixes(prefixes), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the port of an address and port is within a range (inclusive).
+// For example:
+//
+//	Is(v.AddrPort("10.0.0.1:8080").PortBetween(1024, 49151))
+func (validator *ValidatorAddrPort[T]) PortBetween(min uint16, max uint16, template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			addrPort, ok := addrPortOf(validator.context.Value().(T))
+			return ok && addrPort.Port() >= min && addrPort.Port() <= max
+		},
+		ErrorKeyPortBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if an address and port passes a custom function.
+// For example:
+//
+//	Is(v.AddrPort(endpoint).Passing(func(ap netip.AddrPort) bool {
+//		return ap.Addr().Zone() == ""
+//	}))
+func (validator *ValidatorAddrPort[T]) Passing(function func(v0 T) bool, template ...string) *ValidatorAddrPort[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(T))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
@@ -0,0 +1,107 @@
+package valgo
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorAddrPortNot(t *testing.T) {
+
+	v := Is(AddrPort("10.0.0.1:8080").Not().Loopback())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorAddrPortValid(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(AddrPort("192.168.0.1:443").Valid())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(AddrPort("[2001:db8::1]:443").Valid())
+	assert.True(t, v.Valid())
+
+	v = Is(AddrPort(netip.MustParseAddrPort("10.0.0.1:53")).Valid())
+	assert.True(t, v.Valid())
+
+	v = Is(AddrPort("192.168.0.1:65536", "listen").Valid())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Listen must be a valid IP address and port",
+		v.Errors()["listen"].Messages()[0])
+
+	v = Is(AddrPort("192.168.0.1").Valid())
+	assert.False(t, v.Valid())
+
+	v = Is(AddrPort("localhost:80").Valid())
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorAddrPortAddressRules(t *testing.T) {
+
+	assert.True(t, Is(AddrPort("10.0.0.1:8080").IPv4().Private()).Valid())
+	assert.True(t, Is(AddrPort("[2001:db8::1]:443").IPv6()).Valid())
+	assert.True(t, Is(AddrPort("[::1]:8080").Loopback()).Valid())
+	assert.True(t, Is(AddrPort("8.8.8.8:53").Global()).Valid())
+	assert.True(t, Is(AddrPort("224.0.0.1:5353").Multicast()).Valid())
+
+	v := Is(AddrPort("8.8.8.8:53").Private())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be private",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorAddrPortPrefixes(t *testing.T) {
+
+	var v *Validation
+
+	internal := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	v = Is(AddrPort("10.1.2.3:22").InPrefix(internal[0]))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(AddrPort("10.1.2.3:22", "upstream").NotInPrefixes(internal))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Upstream can't be within \"10.0.0.0/8\"",
+		v.Errors()["upstream"].Messages()[0])
+}
+
+func TestValidatorAddrPortPortBetween(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(AddrPort("10.0.0.1:8080").PortBetween(1024, 49151))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(AddrPort("10.0.0.1:1024").PortBetween(1024, 49151))
+	assert.True(t, v.Valid())
+
+	v = Is(AddrPort("10.0.0.1:80", "listen").PortBetween(1024, 49151))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Listen must have a port between \"1024\" and \"49151\"",
+		v.Errors()["listen"].Messages()[0])
+}
+
+func TestValidatorAddrPortPassing(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(AddrPort("10.0.0.1:8080").Passing(func(s string) bool { return s != "" }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(AddrPort(netip.MustParseAddrPort("10.0.0.1:0")).Passing(func(ap netip.AddrPort) bool { return ap.Port() != 0 }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
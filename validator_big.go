@@ -0,0 +1,81 @@
+package valgo
+
+import (
+	"math/big"
+)
+
+// Generic type covering the arbitrary-precision number types of the
+// `math/big` package. This type is used to share the comparison functions
+// between [ValidatorBigInt], [ValidatorBigFloat], and [ValidatorBigRat].
+type bigNumber[T any] interface {
+	*big.Int | *big.Float | *big.Rat
+	Cmp(y T) int
+	Sign() int
+}
+
+func isBigEqualTo[T bigNumber[T]](v0 T, v1 T) bool {
+	return v0 != nil && v1 != nil && v0.Cmp(v1) == 0
+}
+
+func isBigGreaterThan[T bigNumber[T]](v0 T, v1 T) bool {
+	return v0 != nil && v1 != nil && v0.Cmp(v1) > 0
+}
+
+func isBigGreaterOrEqualTo[T bigNumber[T]](v0 T, v1 T) bool {
+	return v0 != nil && v1 != nil && v0.Cmp(v1) >= 0
+}
+
+func isBigLessThan[T bigNumber[T]](v0 T, v1 T) bool {
+	return v0 != nil && v1 != nil && v0.Cmp(v1) < 0
+}
+
+func isBigLessOrEqualTo[T bigNumber[T]](v0 T, v1 T) bool {
+	return v0 != nil && v1 != nil && v0.Cmp(v1) <= 0
+}
+
+func isBigBetween[T bigNumber[T]](v T, min T, max T) bool {
+	return v != nil && min != nil && max != nil && v.Cmp(min) >= 0 && v.Cmp(max) <= 0
+}
+
+func isBigZero[T bigNumber[T]](v T) bool {
+	return v != nil && v.Sign() == 0
+}
+
+func isBigPositive[T bigNumber[T]](v T) bool {
+	return v != nil && v.Sign() > 0
+}
+
+func isBigNegative[T bigNumber[T]](v T) bool {
+	return v != nil && v.Sign() < 0
+}
+
+func isBigInSlice[T bigNumber[T]](v T, slice []T) bool {
+	if v == nil {
+		return false
+	}
+	for _, _v := range slice {
+		if _v != nil && v.Cmp(_v) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Format an arbitrary-precision number to be displayed in the error messages.
+// Floats are formatted in decimal notation without exponent, and rationals
+// with an integer value are formatted without the denominator, so "3/1" is
+// displayed as "3".
+func bigNumberString[T bigNumber[T]](v T) string {
+	if v == nil {
+		return "nil"
+	}
+	switch n := any(v).(type) {
+	case *big.Int:
+		return n.String()
+	case *big.Float:
+		return n.Text('f', -1)
+	case *big.Rat:
+		return n.RatString()
+	}
+	return ""
+}
@@ -0,0 +1,307 @@
+package valgo
+
+import (
+	"math/big"
+)
+
+// The [ValidatorBigFloat] provides functions for setting validation rules for a
+// [big.Float] pointer value.
+//
+// The values are compared using [big.Float.Cmp], so the precision and rounding
+// mode of the operands are ignored and only their numeric values are compared.
+// Values are displayed in the error messages in decimal notation, for example
+// "0.1" or "1000000000000000000".
+// As in the pointer validators, a nil value doesn't satisfy any rule except
+// [ValidatorBigFloat.Nil] and [ValidatorBigFloat.ZeroOrNil].
+type ValidatorBigFloat struct {
+	context *ValidatorContext
+}
+
+// Receives a [big.Float] pointer value to validate.
+//
+// Optionally, the function can receive a name and title, in that order,
+// to be displayed in the error messages. A `value_%N` pattern is used as a name in
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name `phone_number` will be
+// humanized as `Phone Number`
+func BigFloat(value *big.Float, nameAndTitle ...string) *ValidatorBigFloat {
+	return &ValidatorBigFloat{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorBigFloat) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the boolean value associated with the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Zero() function
+//	Is(v.BigFloat(big.NewFloat(0)).Not().Zero()).Valid()
+func (validator *ValidatorBigFloat) Not() *ValidatorBigFloat {
+	validator.context.Not()
+
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because input is Zero (GreaterThan(5) OR Zero()).
+//	input := big.NewFloat(0)
+//	isValid := v.Is(v.BigFloat(input).GreaterThan(big.NewFloat(5)).Or().Zero()).Valid()
+func (validator *ValidatorBigFloat) Or() *ValidatorBigFloat {
+	validator.context.Or()
+
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If input is Zero, the chain succeeds and GreaterThan/LessThan are not evaluated.
+//	// Otherwise, input must be within (5, 10).
+//	input := big.NewFloat(0)
+//	isValid := v.Is(v.BigFloat(input).Zero().OrElse().GreaterThan(big.NewFloat(5)).LessThan(big.NewFloat(10))).Valid()
+func (validator *ValidatorBigFloat) OrElse() *ValidatorBigFloat {
+	validator.context.OrElse()
+
+	return validator
+}
+
+// Validate if a [big.Float] value is equal to another. This function internally
+// uses [big.Float.Cmp].
+// For example:
+//
+//	Is(v.BigFloat(big.NewFloat(2)).EqualTo(big.NewFloat(2)))
+func (validator *ValidatorBigFloat) EqualTo(value *big.Float, template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigEqualTo(validator.context.Value().(*big.Float), value)
+		},
+		ErrorKeyEqualTo, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value is greater than another. This function
+// internally uses [big.Float.Cmp].
+// For example:
+//
+//	Is(v.BigFloat(big.NewFloat(3)).GreaterThan(big.NewFloat(2)))
+func (validator *ValidatorBigFloat) GreaterThan(value *big.Float, template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigGreaterThan(validator.context.Value().(*big.Float), value)
+		},
+		ErrorKeyGreaterThan, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value is greater than or equal to another. This
+// function internally uses [big.Float.Cmp].
+// For example:
+//
+//	Is(v.BigFloat(big.NewFloat(2)).GreaterOrEqualTo(big.NewFloat(2)))
+func (validator *ValidatorBigFloat) GreaterOrEqualTo(value *big.Float, template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigGreaterOrEqualTo(validator.context.Value().(*big.Float), value)
+		},
+		ErrorKeyGreaterOrEqualTo, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value is less than another. This function internally
+// uses [big.Float.Cmp].
+// For example:
+//
+//	Is(v.BigFloat(big.NewFloat(1)).LessThan(big.NewFloat(2)))
+func (validator *ValidatorBigFloat) LessThan(value *big.Float, template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigLessThan(validator.context.Value().(*big.Float), value)
+		},
+		ErrorKeyLessThan, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value is less than or equal to another. This function
+// internally uses [big.Float.Cmp].
+// For example:
+//
+//	Is(v.BigFloat(big.NewFloat(2)).LessOrEqualTo(big.NewFloat(2)))
+func (validator *ValidatorBigFloat) LessOrEqualTo(value *big.Float, template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigLessOrEqualTo(validator.context.Value().(*big.Float), value)
+		},
+		ErrorKeyLessOrEqualTo, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value is within a range (inclusive).
+// For example:
+//
+//	Is(v.BigFloat(big.NewFloat(3)).Between(big.NewFloat(2), big.NewFloat(6)))
+func (validator *ValidatorBigFloat) Between(min *big.Float, max *big.Float, template ...string) *ValidatorBigFloat {
+	validator.context.AddWithParams(
+		func() bool {
+			return isBigBetween(validator.context.Value().(*big.Float), min, max)
+		},
+		ErrorKeyBetween,
+		map[string]any{
+			"title": validator.context.title,
+			"min":   bigNumberString(min),
+			"max":   bigNumberString(max),
+			"value": bigNumberString(validator.context.Value().(*big.Float))},
+		template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value is zero.
+//
+// For example:
+//
+//	Is(v.BigFloat(big.NewFloat(0)).Zero())
+func (validator *ValidatorBigFloat) Zero(template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigZero(validator.context.Value().(*big.Float))
+		},
+		ErrorKeyZero, bigNumberString(validator.context.Value().(*big.Float)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value is zero or nil.
+//
+// For example:
+//
+//	var amount *big.Float
+//	Is(v.BigFloat(amount).ZeroOrNil()) // Will be true
+func (validator *ValidatorBigFloat) ZeroOrNil(template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*big.Float) == nil || isBigZero(validator.context.Value().(*big.Float))
+		},
+		ErrorKeyZero, bigNumberString(validator.context.Value().(*big.Float)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value is positive (greater than zero).
+//
+// For example:
+//
+//	Is(v.BigFloat(big.NewFloat(5)).Positive())
+func (validator *ValidatorBigFloat) Positive(template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigPositive(validator.context.Value().(*big.Float))
+		},
+		ErrorKeyPositive, bigNumberString(validator.context.Value().(*big.Float)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value is negative (less than zero).
+//
+// For example:
+//
+//	Is(v.BigFloat(big.NewFloat(-5)).Negative())
+func (validator *ValidatorBigFloat) Negative(template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigNegative(validator.context.Value().(*big.Float))
+		},
+		ErrorKeyNegative, bigNumberString(validator.context.Value().(*big.Float)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] pointer value is nil.
+//
+// For example:
+//
+//	var amount *big.Float
+//	Is(v.BigFloat(amount).Nil()) // Will be true
+func (validator *ValidatorBigFloat) Nil(template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*big.Float) == nil
+		},
+		ErrorKeyNil, bigNumberString(validator.context.Value().(*big.Float)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value passes a custom function.
+// For example:
+//
+//	Is(v.BigFloat(amount).Passing((v *big.Float) bool {
+//		return v.IsInt()
+//	})
+func (validator *ValidatorBigFloat) Passing(function func(v *big.Float) bool, template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(*big.Float))
+		},
+		ErrorKeyPassing, bigNumberString(validator.context.Value().(*big.Float)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Float] value is present in a slice. The values are compared
+// using [big.Float.Cmp].
+// For example:
+//
+//	validAmounts := []*big.Float{big.NewFloat(1), big.NewFloat(3)}
+//	Is(v.BigFloat(big.NewFloat(3)).InSlice(validAmounts))
+func (validator *ValidatorBigFloat) InSlice(slice []*big.Float, template ...string) *ValidatorBigFloat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigInSlice(validator.context.Value().(*big.Float), slice)
+		},
+		ErrorKeyInSlice, bigNumberString(validator.context.Value().(*big.Float)), template...)
+
+	return validator
+}
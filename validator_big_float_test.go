@@ -0,0 +1,101 @@
+package valgo
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorBigFloatNot(t *testing.T) {
+
+	v := Is(BigFloat(big.NewFloat(0.5)).Not().Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorBigFloatComparisons(t *testing.T) {
+
+	var v *Validation
+
+	// Different precisions, same value
+	a := new(big.Float).SetPrec(200).SetInt64(3)
+
+	v = Is(BigFloat(big.NewFloat(3)).
+		EqualTo(a).
+		GreaterThan(big.NewFloat(2.5)).
+		GreaterOrEqualTo(big.NewFloat(3)).
+		LessThan(big.NewFloat(3.5)).
+		LessOrEqualTo(big.NewFloat(3)).
+		Between(big.NewFloat(2), big.NewFloat(6)))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigFloat(big.NewFloat(0.1), "amount").GreaterThan(big.NewFloat(0.25)))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Amount must be greater than \"0.25\"",
+		v.Errors()["amount"].Messages()[0])
+
+	v = Is(BigFloat(big.NewFloat(0.1)).LessThan(big.NewFloat(-1e18)))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be less than \"-1000000000000000000\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(BigFloat(big.NewFloat(7)).Between(big.NewFloat(0.5), big.NewFloat(6)))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be between \"0.5\" and \"6\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorBigFloatSign(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(BigFloat(new(big.Float)).Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigFloat(big.NewFloat(0.001)).Positive())
+	assert.True(t, v.Valid())
+
+	v = Is(BigFloat(big.NewFloat(-0.001)).Negative())
+	assert.True(t, v.Valid())
+
+	v = Is(BigFloat(big.NewFloat(-0.001)).Positive())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be positive",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorBigFloatNilValue(t *testing.T) {
+
+	var amount *big.Float
+
+	assert.True(t, Is(BigFloat(amount).Nil()).Valid())
+	assert.True(t, Is(BigFloat(amount).ZeroOrNil()).Valid())
+	assert.False(t, Is(BigFloat(amount).Zero()).Valid())
+	assert.False(t, Is(BigFloat(amount).GreaterOrEqualTo(big.NewFloat(0))).Valid())
+	assert.False(t, Is(BigFloat(big.NewFloat(1)).Nil()).Valid())
+}
+
+func TestValidatorBigFloatPassingAndInSlice(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(BigFloat(big.NewFloat(2)).Passing(func(v *big.Float) bool { return v.IsInt() }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigFloat(big.NewFloat(2.5)).InSlice([]*big.Float{big.NewFloat(2.5)}))
+	assert.True(t, v.Valid())
+
+	v = Is(BigFloat(big.NewFloat(2)).InSlice([]*big.Float{big.NewFloat(2.5)}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
@@ -0,0 +1,304 @@
+package valgo
+
+import (
+	"math/big"
+)
+
+// The [ValidatorBigInt] provides functions for setting validation rules for a
+// [big.Int] pointer value.
+//
+// The values are compared using [big.Int.Cmp], so there is no precision loss.
+// As in the pointer validators, a nil value doesn't satisfy any rule except
+// [ValidatorBigInt.Nil] and [ValidatorBigInt.ZeroOrNil].
+type ValidatorBigInt struct {
+	context *ValidatorContext
+}
+
+// Receives a [big.Int] pointer value to validate.
+//
+// Optionally, the function can receive a name and title, in that order,
+// to be displayed in the error messages. A `value_%N` pattern is used as a name in
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name `phone_number` will be
+// humanized as `Phone Number`
+func BigInt(value *big.Int, nameAndTitle ...string) *ValidatorBigInt {
+	return &ValidatorBigInt{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorBigInt) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the boolean value associated with the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Zero() function
+//	Is(v.BigInt(big.NewInt(0)).Not().Zero()).Valid()
+func (validator *ValidatorBigInt) Not() *ValidatorBigInt {
+	validator.context.Not()
+
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because input is Zero (GreaterThan(5) OR Zero()).
+//	input := big.NewInt(0)
+//	isValid := v.Is(v.BigInt(input).GreaterThan(big.NewInt(5)).Or().Zero()).Valid()
+func (validator *ValidatorBigInt) Or() *ValidatorBigInt {
+	validator.context.Or()
+
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If input is Zero, the chain succeeds and GreaterThan/LessThan are not evaluated.
+//	// Otherwise, input must be within (5, 10).
+//	input := big.NewInt(0)
+//	isValid := v.Is(v.BigInt(input).Zero().OrElse().GreaterThan(big.NewInt(5)).LessThan(big.NewInt(10))).Valid()
+func (validator *ValidatorBigInt) OrElse() *ValidatorBigInt {
+	validator.context.OrElse()
+
+	return validator
+}
+
+// Validate if a [big.Int] value is equal to another. This function internally
+// uses [big.Int.Cmp].
+// For example:
+//
+//	Is(v.BigInt(big.NewInt(2)).EqualTo(big.NewInt(2)))
+func (validator *ValidatorBigInt) EqualTo(value *big.Int, template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigEqualTo(validator.context.Value().(*big.Int), value)
+		},
+		ErrorKeyEqualTo, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value is greater than another. This function
+// internally uses [big.Int.Cmp].
+// For example:
+//
+//	Is(v.BigInt(big.NewInt(3)).GreaterThan(big.NewInt(2)))
+func (validator *ValidatorBigInt) GreaterThan(value *big.Int, template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigGreaterThan(validator.context.Value().(*big.Int), value)
+		},
+		ErrorKeyGreaterThan, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value is greater than or equal to another. This
+// function internally uses [big.Int.Cmp].
+// For example:
+//
+//	Is(v.BigInt(big.NewInt(2)).GreaterOrEqualTo(big.NewInt(2)))
+func (validator *ValidatorBigInt) GreaterOrEqualTo(value *big.Int, template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigGreaterOrEqualTo(validator.context.Value().(*big.Int), value)
+		},
+		ErrorKeyGreaterOrEqualTo, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value is less than another. This function internally
+// uses [big.Int.Cmp].
+// For example:
+//
+//	Is(v.BigInt(big.NewInt(1)).LessThan(big.NewInt(2)))
+func (validator *ValidatorBigInt) LessThan(value *big.Int, template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigLessThan(validator.context.Value().(*big.Int), value)
+		},
+		ErrorKeyLessThan, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value is less than or equal to another. This function
+// internally uses [big.Int.Cmp].
+// For example:
+//
+//	Is(v.BigInt(big.NewInt(2)).LessOrEqualTo(big.NewInt(2)))
+func (validator *ValidatorBigInt) LessOrEqualTo(value *big.Int, template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigLessOrEqualTo(validator.context.Value().(*big.Int), value)
+		},
+		ErrorKeyLessOrEqualTo, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value is within a range (inclusive).
+// For example:
+//
+//	Is(v.BigInt(big.NewInt(3)).Between(big.NewInt(2), big.NewInt(6)))
+func (validator *ValidatorBigInt) Between(min *big.Int, max *big.Int, template ...string) *ValidatorBigInt {
+	validator.context.AddWithParams(
+		func() bool {
+			return isBigBetween(validator.context.Value().(*big.Int), min, max)
+		},
+		ErrorKeyBetween,
+		map[string]any{
+			"title": validator.context.title,
+			"min":   bigNumberString(min),
+			"max":   bigNumberString(max),
+			"value": bigNumberString(validator.context.Value().(*big.Int))},
+		template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value is zero.
+//
+// For example:
+//
+//	Is(v.BigInt(big.NewInt(0)).Zero())
+func (validator *ValidatorBigInt) Zero(template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigZero(validator.context.Value().(*big.Int))
+		},
+		ErrorKeyZero, bigNumberString(validator.context.Value().(*big.Int)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value is zero or nil.
+//
+// For example:
+//
+//	var amount *big.Int
+//	Is(v.BigInt(amount).ZeroOrNil()) // Will be true
+func (validator *ValidatorBigInt) ZeroOrNil(template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*big.Int) == nil || isBigZero(validator.context.Value().(*big.Int))
+		},
+		ErrorKeyZero, bigNumberString(validator.context.Value().(*big.Int)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value is positive (greater than zero).
+//
+// For example:
+//
+//	Is(v.BigInt(big.NewInt(5)).Positive())
+func (validator *ValidatorBigInt) Positive(template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigPositive(validator.context.Value().(*big.Int))
+		},
+		ErrorKeyPositive, bigNumberString(validator.context.Value().(*big.Int)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value is negative (less than zero).
+//
+// For example:
+//
+//	Is(v.BigInt(big.NewInt(-5)).Negative())
+func (validator *ValidatorBigInt) Negative(template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigNegative(validator.context.Value().(*big.Int))
+		},
+		ErrorKeyNegative, bigNumberString(validator.context.Value().(*big.Int)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] pointer value is nil.
+//
+// For example:
+//
+//	var amount *big.Int
+//	Is(v.BigInt(amount).Nil()) // Will be true
+func (validator *ValidatorBigInt) Nil(template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*big.Int) == nil
+		},
+		ErrorKeyNil, bigNumberString(validator.context.Value().(*big.Int)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value passes a custom function.
+// For example:
+//
+//	Is(v.BigInt(amount).Passing((v *big.Int) bool {
+//		return v.BitLen() <= 128
+//	})
+func (validator *ValidatorBigInt) Passing(function func(v *big.Int) bool, template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(*big.Int))
+		},
+		ErrorKeyPassing, bigNumberString(validator.context.Value().(*big.Int)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Int] value is present in a slice. The values are compared
+// using [big.Int.Cmp].
+// For example:
+//
+//	validAmounts := []*big.Int{big.NewInt(1), big.NewInt(3)}
+//	Is(v.BigInt(big.NewInt(3)).InSlice(validAmounts))
+func (validator *ValidatorBigInt) InSlice(slice []*big.Int, template ...string) *ValidatorBigInt {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigInSlice(validator.context.Value().(*big.Int), slice)
+		},
+		ErrorKeyInSlice, bigNumberString(validator.context.Value().(*big.Int)), template...)
+
+	return validator
+}
@@ -0,0 +1,197 @@
+package valgo
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorBigIntNot(t *testing.T) {
+
+	v := Is(BigInt(big.NewInt(1)).Not().Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorBigIntEqualTo(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(BigInt(big.NewInt(2)).EqualTo(big.NewInt(2)))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	v = Is(BigInt(big.NewInt(2)).EqualTo(huge))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be equal to \"123456789012345678901234567890\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorBigIntComparisons(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(BigInt(big.NewInt(3)).
+		GreaterThan(big.NewInt(2)).
+		GreaterOrEqualTo(big.NewInt(3)).
+		LessThan(big.NewInt(4)).
+		LessOrEqualTo(big.NewInt(3)))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigInt(big.NewInt(3), "amount").GreaterThan(big.NewInt(3)))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Amount must be greater than \"3\"",
+		v.Errors()["amount"].Messages()[0])
+
+	v = Is(BigInt(big.NewInt(2)).GreaterOrEqualTo(big.NewInt(3)))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be greater than or equal to \"3\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(BigInt(big.NewInt(3)).LessThan(big.NewInt(3)))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be less than \"3\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(BigInt(big.NewInt(4)).LessOrEqualTo(big.NewInt(-3)))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be less than or equal to \"-3\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorBigIntBetween(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(BigInt(big.NewInt(2)).Between(big.NewInt(2), big.NewInt(6)))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigInt(big.NewInt(7)).Between(big.NewInt(2), big.NewInt(6)))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be between \"2\" and \"6\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	// Nil bounds never match
+	v = Is(BigInt(big.NewInt(3)).Between(nil, big.NewInt(6)))
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorBigIntSign(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(BigInt(big.NewInt(0)).Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigInt(big.NewInt(1)).Zero())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be zero",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(BigInt(big.NewInt(1)).Positive())
+	assert.True(t, v.Valid())
+
+	v = Is(BigInt(big.NewInt(0)).Positive())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be positive",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(BigInt(big.NewInt(-1)).Negative())
+	assert.True(t, v.Valid())
+
+	v = Is(BigInt(big.NewInt(0)).Negative())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be negative",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorBigIntNilValue(t *testing.T) {
+
+	var v *Validation
+
+	var amount *big.Int
+
+	v = Is(BigInt(amount).Nil())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigInt(amount).ZeroOrNil())
+	assert.True(t, v.Valid())
+
+	v = Is(BigInt(big.NewInt(0)).ZeroOrNil())
+	assert.True(t, v.Valid())
+
+	v = Is(BigInt(big.NewInt(1)).Nil())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be nil",
+		v.Errors()["value_0"].Messages()[0])
+
+	// A nil value doesn't satisfy any other rule
+	for _, validator := range []*ValidatorBigInt{
+		BigInt(amount).EqualTo(big.NewInt(0)),
+		BigInt(amount).GreaterThan(big.NewInt(0)),
+		BigInt(amount).LessThan(big.NewInt(0)),
+		BigInt(amount).Between(big.NewInt(-1), big.NewInt(1)),
+		BigInt(amount).Zero(),
+		BigInt(amount).Positive(),
+		BigInt(amount).Negative(),
+		BigInt(amount).InSlice([]*big.Int{nil, big.NewInt(0)}),
+	} {
+		assert.False(t, Is(validator).Valid())
+	}
+
+	// A nil argument doesn't match either
+	v = Is(BigInt(big.NewInt(0)).EqualTo(nil))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be equal to \"nil\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorBigIntPassing(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(BigInt(big.NewInt(10)).Passing(func(v *big.Int) bool { return v.BitLen() <= 64 }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigInt(new(big.Int).Lsh(big.NewInt(1), 80)).Passing(func(v *big.Int) bool { return v.BitLen() <= 64 }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorBigIntInSlice(t *testing.T) {
+
+	var v *Validation
+
+	slice := []*big.Int{big.NewInt(1), big.NewInt(3)}
+
+	v = Is(BigInt(big.NewInt(3)).InSlice(slice))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigInt(big.NewInt(2)).InSlice(slice))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
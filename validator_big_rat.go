@@ -0,0 +1,306 @@
+package valgo
+
+import (
+	"math/big"
+)
+
+// The [ValidatorBigRat] provides functions for setting validation rules for a
+// [big.Rat] pointer value.
+//
+// The values are compared using [big.Rat.Cmp], so there is no precision loss.
+// Values are displayed in the error messages as fractions, for example "1/3",
+// or as integers when the denominator is one.
+// As in the pointer validators, a nil value doesn't satisfy any rule except
+// [ValidatorBigRat.Nil] and [ValidatorBigRat.ZeroOrNil].
+type ValidatorBigRat struct {
+	context *ValidatorContext
+}
+
+// Receives a [big.Rat] pointer value to validate.
+//
+// Optionally, the function can receive a name and title, in that order,
+// to be displayed in the error messages. A `value_%N` pattern is used as a name in
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name `phone_number` will be
+// humanized as `Phone Number`
+func BigRat(value *big.Rat, nameAndTitle ...string) *ValidatorBigRat {
+	return &ValidatorBigRat{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorBigRat) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the boolean value associated with the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Zero() function
+//	Is(v.BigRat(big.NewRat(0, 1)).Not().Zero()).Valid()
+func (validator *ValidatorBigRat) Not() *ValidatorBigRat {
+	validator.context.Not()
+
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because input is Zero (GreaterThan(5) OR Zero()).
+//	input := big.NewRat(0, 1)
+//	isValid := v.Is(v.BigRat(input).GreaterThan(big.NewRat(5, 1)).Or().Zero()).Valid()
+func (validator *ValidatorBigRat) Or() *ValidatorBigRat {
+	validator.context.Or()
+
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If input is Zero, the chain succeeds and GreaterThan/LessThan are not evaluated.
+//	// Otherwise, input must be within (5, 10).
+//	input := big.NewRat(0, 1)
+//	isValid := v.Is(v.BigRat(input).Zero().OrElse().GreaterThan(big.NewRat(5, 1)).LessThan(big.NewRat(10, 1))).Valid()
+func (validator *ValidatorBigRat) OrElse() *ValidatorBigRat {
+	validator.context.OrElse()
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is equal to another. This function internally
+// uses [big.Rat.Cmp].
+// For example:
+//
+//	Is(v.BigRat(big.NewRat(2, 1)).EqualTo(big.NewRat(2, 1)))
+func (validator *ValidatorBigRat) EqualTo(value *big.Rat, template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigEqualTo(validator.context.Value().(*big.Rat), value)
+		},
+		ErrorKeyEqualTo, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is greater than another. This function
+// internally uses [big.Rat.Cmp].
+// For example:
+//
+//	Is(v.BigRat(big.NewRat(3, 1)).GreaterThan(big.NewRat(2, 1)))
+func (validator *ValidatorBigRat) GreaterThan(value *big.Rat, template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigGreaterThan(validator.context.Value().(*big.Rat), value)
+		},
+		ErrorKeyGreaterThan, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is greater than or equal to another. This
+// function internally uses [big.Rat.Cmp].
+// For example:
+//
+//	Is(v.BigRat(big.NewRat(2, 1)).GreaterOrEqualTo(big.NewRat(2, 1)))
+func (validator *ValidatorBigRat) GreaterOrEqualTo(value *big.Rat, template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigGreaterOrEqualTo(validator.context.Value().(*big.Rat), value)
+		},
+		ErrorKeyGreaterOrEqualTo, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is less than another. This function internally
+// uses [big.Rat.Cmp].
+// For example:
+//
+//	Is(v.BigRat(big.NewRat(1, 1)).LessThan(big.NewRat(2, 1)))
+func (validator *ValidatorBigRat) LessThan(value *big.Rat, template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigLessThan(validator.context.Value().(*big.Rat), value)
+		},
+		ErrorKeyLessThan, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is less than or equal to another. This function
+// internally uses [big.Rat.Cmp].
+// For example:
+//
+//	Is(v.BigRat(big.NewRat(2, 1)).LessOrEqualTo(big.NewRat(2, 1)))
+func (validator *ValidatorBigRat) LessOrEqualTo(value *big.Rat, template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigLessOrEqualTo(validator.context.Value().(*big.Rat), value)
+		},
+		ErrorKeyLessOrEqualTo, bigNumberString(value), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is within a range (inclusive).
+// For example:
+//
+//	Is(v.BigRat(big.NewRat(3, 1)).Between(big.NewRat(2, 1), big.NewRat(6, 1)))
+func (validator *ValidatorBigRat) Between(min *big.Rat, max *big.Rat, template ...string) *ValidatorBigRat {
+	validator.context.AddWithParams(
+		func() bool {
+			return isBigBetween(validator.context.Value().(*big.Rat), min, max)
+		},
+		ErrorKeyBetween,
+		map[string]any{
+			"title": validator.context.title,
+			"min":   bigNumberString(min),
+			"max":   bigNumberString(max),
+			"value": bigNumberString(validator.context.Value().(*big.Rat))},
+		template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is zero.
+//
+// For example:
+//
+//	Is(v.BigRat(big.NewRat(0, 1)).Zero())
+func (validator *ValidatorBigRat) Zero(template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigZero(validator.context.Value().(*big.Rat))
+		},
+		ErrorKeyZero, bigNumberString(validator.context.Value().(*big.Rat)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is zero or nil.
+//
+// For example:
+//
+//	var amount *big.Rat
+//	Is(v.BigRat(amount).ZeroOrNil()) // Will be true
+func (validator *ValidatorBigRat) ZeroOrNil(template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*big.Rat) == nil || isBigZero(validator.context.Value().(*big.Rat))
+		},
+		ErrorKeyZero, bigNumberString(validator.context.Value().(*big.Rat)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is positive (greater than zero).
+//
+// For example:
+//
+//	Is(v.BigRat(big.NewRat(5, 1)).Positive())
+func (validator *ValidatorBigRat) Positive(template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigPositive(validator.context.Value().(*big.Rat))
+		},
+		ErrorKeyPositive, bigNumberString(validator.context.Value().(*big.Rat)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is negative (less than zero).
+//
+// For example:
+//
+//	Is(v.BigRat(big.NewRat(-5, 1)).Negative())
+func (validator *ValidatorBigRat) Negative(template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigNegative(validator.context.Value().(*big.Rat))
+		},
+		ErrorKeyNegative, bigNumberString(validator.context.Value().(*big.Rat)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] pointer value is nil.
+//
+// For example:
+//
+//	var amount *big.Rat
+//	Is(v.BigRat(amount).Nil()) // Will be true
+func (validator *ValidatorBigRat) Nil(template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*big.Rat) == nil
+		},
+		ErrorKeyNil, bigNumberString(validator.context.Value().(*big.Rat)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value passes a custom function.
+// For example:
+//
+//	Is(v.BigRat(amount).Passing((v *big.Rat) bool {
+//		return v.IsInt()
+//	})
+func (validator *ValidatorBigRat) Passing(function func(v *big.Rat) bool, template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(*big.Rat))
+		},
+		ErrorKeyPassing, bigNumberString(validator.context.Value().(*big.Rat)), template...)
+
+	return validator
+}
+
+// Validate if a [big.Rat] value is present in a slice. The values are compared
+// using [big.Rat.Cmp].
+// For example:
+//
+//	validAmounts := []*big.Rat{big.NewRat(1, 1), big.NewRat(3, 1)}
+//	Is(v.BigRat(big.NewRat(3, 1)).InSlice(validAmounts))
+func (validator *ValidatorBigRat) InSlice(slice []*big.Rat, template ...string) *ValidatorBigRat {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBigInSlice(validator.context.Value().(*big.Rat), slice)
+		},
+		ErrorKeyInSlice, bigNumberString(validator.context.Value().(*big.Rat)), template...)
+
+	return validator
+}
@@ -0,0 +1,92 @@
+package valgo
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorBigRatNot(t *testing.T) {
+
+	v := Is(BigRat(big.NewRat(1, 3)).Not().Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorBigRatComparisons(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(BigRat(big.NewRat(1, 3)).
+		EqualTo(big.NewRat(2, 6)).
+		GreaterThan(big.NewRat(1, 4)).
+		GreaterOrEqualTo(big.NewRat(1, 3)).
+		LessThan(big.NewRat(1, 2)).
+		LessOrEqualTo(big.NewRat(1, 3)).
+		Between(big.NewRat(0, 1), big.NewRat(1, 1)))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigRat(big.NewRat(1, 4), "share").GreaterThan(big.NewRat(1, 3)))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Share must be greater than \"1/3\"",
+		v.Errors()["share"].Messages()[0])
+
+	v = Is(BigRat(big.NewRat(3, 2)).Between(big.NewRat(0, 1), big.NewRat(1, 1)))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be between \"0\" and \"1\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorBigRatSign(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(BigRat(new(big.Rat)).Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigRat(big.NewRat(1, 1000)).Positive())
+	assert.True(t, v.Valid())
+
+	v = Is(BigRat(big.NewRat(-1, 1000)).Negative())
+	assert.True(t, v.Valid())
+
+	v = Is(BigRat(big.NewRat(1, 1000)).Negative())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be negative",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorBigRatNilValue(t *testing.T) {
+
+	var share *big.Rat
+
+	assert.True(t, Is(BigRat(share).Nil()).Valid())
+	assert.True(t, Is(BigRat(share).ZeroOrNil()).Valid())
+	assert.False(t, Is(BigRat(share).Zero()).Valid())
+	assert.False(t, Is(BigRat(share).LessThan(big.NewRat(1, 1))).Valid())
+	assert.False(t, Is(BigRat(big.NewRat(1, 1)).Nil()).Valid())
+}
+
+func TestValidatorBigRatPassingAndInSlice(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(BigRat(big.NewRat(4, 2)).Passing(func(v *big.Rat) bool { return v.IsInt() }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(BigRat(big.NewRat(1, 2)).InSlice([]*big.Rat{big.NewRat(2, 4)}))
+	assert.True(t, v.Valid())
+
+	v = Is(BigRat(big.NewRat(1, 3)).InSlice([]*big.Rat{big.NewRat(2, 4)}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
@@ -0,0 +1,320 @@
+package valgo
+
+import "time"
+
+func isDateBetween(v Date, min Date, max Date) bool {
+	return !v.Before(min) && !v.After(max)
+}
+
+func isDateInSlice(v Date, slice []Date) bool {
+	for _, _v := range slice {
+		if v == _v {
+			return true
+		}
+	}
+	return false
+}
+
+func isDateWeekday(v Date, days []time.Weekday) bool {
+	for _, day := range days {
+		if v.Weekday() == day {
+			return true
+		}
+	}
+	return false
+}
+
+// The `ValidatorCivilDate` structure provides a set of methods to perform
+// validation checks on [Date] values, which are calendar dates without a time
+// of day or a time zone.
+type ValidatorCivilDate struct {
+	context *ValidatorContext
+}
+
+// The CivilDate function initiates a new `ValidatorCivilDate` instance to
+// validate a given date. The optional name and title parameters can be used for
+// enhanced error reporting. If a name is provided without a title, the name is
+// humanized to be used as the title.
+//
+// Dates in the error messages use the [LayoutKeyDate] layout of the locale, so
+// the English locale prints dates such as "2024-02-29".
+//
+// For example:
+//
+//	birthday := v.Date{Year: 2000, Month: time.February, Day: 29}
+//	v.CivilDate(birthday, "birthday", "Birthday")
+func CivilDate(value Date, nameAndTitle ...string) *ValidatorCivilDate {
+	return &ValidatorCivilDate{context: NewContext(value, nameAndTitle...)}
+}
+
+// The Context method returns the current context of the validator, which can
+// be utilized to create custom validations by extending this validator.
+func (validator *ValidatorCivilDate) Context() *ValidatorContext {
+	return validator.context
+}
+
+// The Not method inverts the boolean value associated with the next validator
+// method. This can be used to negate the check performed by the next validation
+// method in the chain.
+//
+// For example:
+//
+//	// Will return false because Not() inverts the boolean value of the LeapDay() function
+//	Is(v.CivilDate(v.Date{Year: 2024, Month: time.February, Day: 29}).Not().LeapDay()).Valid()
+func (validator *ValidatorCivilDate) Not() *ValidatorCivilDate {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the date is Zero (Zero() OR After(start)).
+//	isValid := v.Is(v.CivilDate(v.Date{}).Zero().Or().After(start)).Valid()
+func (validator *ValidatorCivilDate) Or() *ValidatorCivilDate {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the date is Zero, the chain succeeds and Valid/After are not evaluated.
+//	// Otherwise, the date must be a valid date after start.
+//	isValid := v.Is(v.CivilDate(date).Zero().OrElse().Valid().After(start)).Valid()
+func (validator *ValidatorCivilDate) OrElse() *ValidatorCivilDate {
+	validator.context.OrElse()
+	return validator
+}
+
+// The EqualTo method validates if the date is the same as another date.
+//
+// For example:
+//
+//	date := v.Date{Year: 2024, Month: time.February, Day: 29}
+//	Is(v.CivilDate(date).EqualTo(date)).Valid()
+func (validator *ValidatorCivilDate) EqualTo(value Date, template ...string) *ValidatorCivilDate {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(Date) == value
+		},
+		ErrorKeyEqualTo, localizedCivilDate(value), template...)
+
+	return validator
+}
+
+// The After method checks if the date is after a specified date.
+//
+// For example:
+//
+//	start := v.Date{Year: 2024, Month: time.January, Day: 1}
+//	Is(v.CivilDate(v.Date{Year: 2024, Month: time.February, Day: 29}).After(start)).Valid()
+func (validator *ValidatorCivilDate) After(value Date, template ...string) *ValidatorCivilDate {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(Date).After(value)
+		},
+		ErrorKeyAfter, localizedCivilDate(value), template...)
+
+	return validator
+}
+
+// The AfterOrEqualTo method checks if the date is either after or the same as a
+// specified date.
+//
+// For example:
+//
+//	start := v.Date{Year: 2024, Month: time.January, Day: 1}
+//	Is(v.CivilDate(start).AfterOrEqualTo(start)).Valid()
+func (validator *ValidatorCivilDate) AfterOrEqualTo(value Date, template ...string) *ValidatorCivilDate {
+	validator.context.AddWithValue(
+		func() bool {
+			return !validator.context.Value().(Date).Before(value)
+		},
+		ErrorKeyAfterOrEqualTo, localizedCivilDate(value), template...)
+
+	return validator
+}
+
+// The Before method checks if the date is before a specified date.
+//
+// For example:
+//
+//	end := v.Date{Year: 2024, Month: time.December, Day: 31}
+//	Is(v.CivilDate(v.Date{Year: 2024, Month: time.February, Day: 29}).Before(end)).Valid()
+func (validator *ValidatorCivilDate) Before(value Date, template ...string) *ValidatorCivilDate {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(Date).Before(value)
+		},
+		ErrorKeyBefore, localizedCivilDate(value), template...)
+
+	return validator
+}
+
+// The BeforeOrEqualTo method checks if the date is either before or the same as
+// a specified date.
+//
+// For example:
+//
+//	end := v.Date{Year: 2024, Month: time.December, Day: 31}
+//	Is(v.CivilDate(end).BeforeOrEqualTo(end)).Valid()
+func (validator *ValidatorCivilDate) BeforeOrEqualTo(value Date, template ...string) *ValidatorCivilDate {
+	validator.context.AddWithValue(
+		func() bool {
+			return !validator.context.Value().(Date).After(value)
+		},
+		ErrorKeyBeforeOrEqualTo, localizedCivilDate(value), template...)
+
+	return validator
+}
+
+// The Between method verifies if the date falls within a given range of dates,
+// inclusive.
+//
+// For example:
+//
+//	start := v.Date{Year: 2024, Month: time.January, Day: 1}
+//	end := v.Date{Year: 2024, Month: time.December, Day: 31}
+//	Is(v.CivilDate(v.Date{Year: 2024, Month: time.February, Day: 29}).Between(start, end)).Valid()
+func (validator *ValidatorCivilDate) Between(min Date, max Date, template ...string) *ValidatorCivilDate {
+	validator.context.AddWithParams(
+		func() bool {
+			return isDateBetween(validator.context.Value().(Date), min, max)
+		},
+		ErrorKeyBetween,
+		map[string]any{"title": validator.context.title, "min": localizedCivilDate(min), "max": localizedCivilDate(max)},
+		template...)
+
+	return validator
+}
+
+// The Zero method verifies if the date is the zero value.
+//
+// For example:
+//
+//	Is(v.CivilDate(v.Date{}).Zero()).Valid()
+func (validator *ValidatorCivilDate) Zero(template ...string) *ValidatorCivilDate {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(Date).IsZero()
+		},
+		ErrorKeyZero, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The Valid method verifies if the date exists in the calendar. For example,
+// February 29 is only valid in leap years, and the zero date is not valid.
+//
+// For example:
+//
+//	Is(v.CivilDate(v.Date{Year: 2023, Month: time.February, Day: 29}).Valid()).Valid() // false
+func (validator *ValidatorCivilDate) Valid(template ...string) *ValidatorCivilDate {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(Date).IsValid()
+		},
+		ErrorKeyValidDate, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The LeapDay method verifies if the date is February 29. Combined with Not(),
+// it rejects leap days, for example in recurring dates that must exist every
+// year.
+//
+// For example:
+//
+//	Is(v.CivilDate(renewal).Not().LeapDay()).Valid()
+func (validator *ValidatorCivilDate) LeapDay(template ...string) *ValidatorCivilDate {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(Date).IsLeapDay()
+		},
+		ErrorKeyLeapDay, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The Weekday method verifies if the date falls on one of the days of the week.
+//
+// For example:
+//
+//	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+//	Is(v.CivilDate(deliveryDate).Weekday(weekdays)).Valid()
+func (validator *ValidatorCivilDate) Weekday(days []time.Weekday, template ...string) *ValidatorCivilDate {
+	validator.context.AddWithParams(
+		func() bool {
+			return isDateWeekday(validator.context.Value().(Date), days)
+		},
+		ErrorKeyWeekday,
+		map[string]any{"title": validator.context.title, "days": localizedWeekdays(days)},
+		template...)
+
+	return validator
+}
+
+// The InSlice method validates if the date is present in a specified slice of
+// dates.
+//
+// For example:
+//
+//	date := v.Date{Year: 2024, Month: time.December, Day: 25}
+//	Is(v.CivilDate(date).InSlice([]v.Date{date})).Valid()
+func (validator *ValidatorCivilDate) InSlice(slice []Date, template ...string) *ValidatorCivilDate {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDateInSlice(validator.context.Value().(Date), slice)
+		},
+		ErrorKeyInSlice, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The Passing method allows for custom validation logic to be applied on the
+// date.
+//
+// For example:
+//
+//	Is(v.CivilDate(date).Passing(func(d v.Date) bool { return d.Day <= 28 })).Valid()
+func (validator *ValidatorCivilDate) Passing(function func(v0 Date) bool, template ...string) *ValidatorCivilDate {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(Date))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
@@ -0,0 +1,304 @@
+package valgo
+
+import "time"
+
+// The Date pointer validator type that keeps its validator context.
+type ValidatorCivilDateP struct {
+	context *ValidatorContext
+}
+
+// Receive a pointer to a [Date] to validate.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A value_%N pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name phone_number will be
+// humanized as Phone Number.
+//
+// Example:
+//
+//	var birthday *v.Date
+//	v.Is(v.CivilDateP(birthday, "birthday").Nil())
+func CivilDateP(value *Date, nameAndTitle ...string) *ValidatorCivilDateP {
+	return &ValidatorCivilDateP{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorCivilDateP) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the logical value associated with the next validator function.
+// For example:
+//
+//	// It will return false because `Not()` inverts the boolean value associated with `LeapDay()`
+//	date := v.Date{Year: 2024, Month: time.February, Day: 29}
+//	Is(v.CivilDateP(&date).Not().LeapDay()).Valid()
+func (validator *ValidatorCivilDateP) Not() *ValidatorCivilDateP {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the date is nil (Nil() OR After(start)).
+//	var date *v.Date
+//	isValid := v.Is(v.CivilDateP(date).Nil().Or().After(start)).Valid()
+func (validator *ValidatorCivilDateP) Or() *ValidatorCivilDateP {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the date is nil, the chain succeeds and Valid/After are not evaluated.
+//	// Otherwise, the date must be a valid date after start.
+//	isValid := v.Is(v.CivilDateP(date).Nil().OrElse().Valid().After(start)).Valid()
+func (validator *ValidatorCivilDateP) OrElse() *ValidatorCivilDateP {
+	validator.context.OrElse()
+	return validator
+}
+
+// Validate if the date pointer is pointing to the same date as another. For
+// example:
+//
+//	date := v.Date{Year: 2024, Month: time.February, Day: 29}
+//	Is(v.CivilDateP(&date).EqualTo(date)).Valid()
+func (validator *ValidatorCivilDateP) EqualTo(value Date, template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && *(validator.context.Value().(*Date)) == value
+		},
+		ErrorKeyEqualTo, localizedCivilDate(value), template...)
+
+	return validator
+}
+
+// Validate if the date pointer is pointing to a date after another. For
+// example:
+//
+//	date := v.Date{Year: 2024, Month: time.February, Day: 29}
+//	Is(v.CivilDateP(&date).After(v.Date{Year: 2024, Month: time.January, Day: 1})).Valid()
+func (validator *ValidatorCivilDateP) After(value Date, template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && validator.context.Value().(*Date).After(value)
+		},
+		ErrorKeyAfter, localizedCivilDate(value), template...)
+
+	return validator
+}
+
+// Validate if the date pointer is pointing to a date after or the same as
+// another. For example:
+//
+//	date := v.Date{Year: 2024, Month: time.January, Day: 1}
+//	Is(v.CivilDateP(&date).AfterOrEqualTo(date)).Valid()
+func (validator *ValidatorCivilDateP) AfterOrEqualTo(value Date, template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && !validator.context.Value().(*Date).Before(value)
+		},
+		ErrorKeyAfterOrEqualTo, localizedCivilDate(value), template...)
+
+	return validator
+}
+
+// Validate if the date pointer is pointing to a date before another. For
+// example:
+//
+//	date := v.Date{Year: 2024, Month: time.February, Day: 29}
+//	Is(v.CivilDateP(&date).Before(v.Date{Year: 2024, Month: time.December, Day: 31})).Valid()
+func (validator *ValidatorCivilDateP) Before(value Date, template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && validator.context.Value().(*Date).Before(value)
+		},
+		ErrorKeyBefore, localizedCivilDate(value), template...)
+
+	return validator
+}
+
+// Validate if the date pointer is pointing to a date before or the same as
+// another. For example:
+//
+//	date := v.Date{Year: 2024, Month: time.December, Day: 31}
+//	Is(v.CivilDateP(&date).BeforeOrEqualTo(date)).Valid()
+func (validator *ValidatorCivilDateP) BeforeOrEqualTo(value Date, template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && !validator.context.Value().(*Date).After(value)
+		},
+		ErrorKeyBeforeOrEqualTo, localizedCivilDate(value), template...)
+
+	return validator
+}
+
+// Validate if the date pointer is pointing to a date within a range of dates,
+// inclusive. For example:
+//
+//	start := v.Date{Year: 2024, Month: time.January, Day: 1}
+//	end := v.Date{Year: 2024, Month: time.December, Day: 31}
+//	Is(v.CivilDateP(&date).Between(start, end)).Valid()
+func (validator *ValidatorCivilDateP) Between(min Date, max Date, template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && isDateBetween(*(validator.context.Value().(*Date)), min, max)
+		},
+		ErrorKeyBetween,
+		map[string]any{"title": validator.context.title, "min": localizedCivilDate(min), "max": localizedCivilDate(max)},
+		template...)
+
+	return validator
+}
+
+// Validate if the date pointer is pointing to the zero date. For example:
+//
+//	date := v.Date{}
+//	Is(v.CivilDateP(&date).Zero()).Valid()
+func (validator *ValidatorCivilDateP) Zero(template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && validator.context.Value().(*Date).IsZero()
+		},
+		ErrorKeyZero, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the date pointer is pointing to a date that exists in the
+// calendar. A nil pointer is not valid. For example:
+//
+//	date := v.Date{Year: 2023, Month: time.February, Day: 29}
+//	Is(v.CivilDateP(&date).Valid()).Valid() // false
+func (validator *ValidatorCivilDateP) Valid(template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && validator.context.Value().(*Date).IsValid()
+		},
+		ErrorKeyValidDate, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the date pointer is pointing to February 29. For example:
+//
+//	Is(v.CivilDateP(&renewal).Not().LeapDay()).Valid()
+func (validator *ValidatorCivilDateP) LeapDay(template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && validator.context.Value().(*Date).IsLeapDay()
+		},
+		ErrorKeyLeapDay, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the date pointer is pointing to a date that falls on one of the
+// days of the week. For example:
+//
+//	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+//	Is(v.CivilDateP(&deliveryDate).Weekday(weekdays)).Valid()
+func (validator *ValidatorCivilDateP) Weekday(days []time.Weekday, template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && isDateWeekday(*(validator.context.Value().(*Date)), days)
+		},
+		ErrorKeyWeekday,
+		map[string]any{"title": validator.context.title, "days": localizedWeekdays(days)},
+		template...)
+
+	return validator
+}
+
+// Validate if the date pointer is pointing to a date present in a slice of
+// dates. For example:
+//
+//	date := v.Date{Year: 2024, Month: time.December, Day: 25}
+//	Is(v.CivilDateP(&date).InSlice([]v.Date{date})).Valid()
+func (validator *ValidatorCivilDateP) InSlice(slice []Date, template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) != nil && isDateInSlice(*(validator.context.Value().(*Date)), slice)
+		},
+		ErrorKeyInSlice, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the date pointer passes a custom function. For example:
+//
+//	Is(v.CivilDateP(&date).Passing(func(d *v.Date) bool { return d != nil && d.Day <= 28 })).Valid()
+func (validator *ValidatorCivilDateP) Passing(function func(v0 *Date) bool, template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(*Date))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the date pointer is nil. For example:
+//
+//	var date *v.Date
+//	Is(v.CivilDateP(date).Nil()).Valid()
+func (validator *ValidatorCivilDateP) Nil(template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) == nil
+		},
+		ErrorKeyNil, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the date pointer is nil or pointing to the zero date. For
+// example:
+//
+//	var date *v.Date
+//	Is(v.CivilDateP(date).NilOrZero()).Valid()
+func (validator *ValidatorCivilDateP) NilOrZero(template ...string) *ValidatorCivilDateP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*Date) == nil || validator.context.Value().(*Date).IsZero()
+		},
+		ErrorKeyNil, validator.context.Value(), template...)
+
+	return validator
+}
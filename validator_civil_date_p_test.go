@@ -0,0 +1,69 @@
+package valgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorCivilDatePNot(t *testing.T) {
+	date := Date{Year: 2024, Month: time.March, Day: 1}
+
+	v := Is(CivilDateP(&date).Not().LeapDay())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorCivilDatePRules(t *testing.T) {
+	leapDay := Date{Year: 2024, Month: time.February, Day: 29}
+	before := Date{Year: 2024, Month: time.February, Day: 28}
+	after := Date{Year: 2024, Month: time.March, Day: 1}
+
+	assert.True(t, Is(CivilDateP(&leapDay).
+		EqualTo(leapDay).
+		After(before).
+		AfterOrEqualTo(leapDay).
+		Before(after).
+		BeforeOrEqualTo(leapDay).
+		Between(before, after).
+		Valid().
+		LeapDay().
+		Weekday([]time.Weekday{time.Thursday}).
+		InSlice([]Date{leapDay}).
+		Passing(func(d *Date) bool { return d != nil })).Valid())
+
+	v := Is(CivilDateP(&leapDay, "start").Before(before))
+	assert.Equal(t,
+		"Start must be before \"2024-02-28\"",
+		v.Errors()["start"].Messages()[0])
+
+	zero := Date{}
+	assert.True(t, Is(CivilDateP(&zero).Zero()).Valid())
+	assert.True(t, Is(CivilDateP(&zero).NilOrZero()).Valid())
+	assert.False(t, Is(CivilDateP(&leapDay).NilOrZero()).Valid())
+	assert.False(t, Is(CivilDateP(&leapDay).Nil()).Valid())
+}
+
+func TestValidatorCivilDatePNil(t *testing.T) {
+	var date *Date
+	leapDay := Date{Year: 2024, Month: time.February, Day: 29}
+
+	assert.True(t, Is(CivilDateP(date).Nil()).Valid())
+	assert.True(t, Is(CivilDateP(date).NilOrZero()).Valid())
+
+	assert.False(t, Is(CivilDateP(date).EqualTo(leapDay)).Valid())
+	assert.False(t, Is(CivilDateP(date).After(leapDay)).Valid())
+	assert.False(t, Is(CivilDateP(date).AfterOrEqualTo(leapDay)).Valid())
+	assert.False(t, Is(CivilDateP(date).Before(leapDay)).Valid())
+	assert.False(t, Is(CivilDateP(date).BeforeOrEqualTo(leapDay)).Valid())
+	assert.False(t, Is(CivilDateP(date).Between(leapDay, leapDay)).Valid())
+	assert.False(t, Is(CivilDateP(date).Zero()).Valid())
+	assert.False(t, Is(CivilDateP(date).Valid()).Valid())
+	assert.False(t, Is(CivilDateP(date).LeapDay()).Valid())
+	assert.False(t, Is(CivilDateP(date).Weekday([]time.Weekday{time.Thursday})).Valid())
+	assert.False(t, Is(CivilDateP(date).InSlice([]Date{leapDay})).Valid())
+	assert.False(t, Is(CivilDateP(date).Passing(func(d *Date) bool { return d != nil })).Valid())
+
+	assert.True(t, Is(CivilDateP(date).Nil().Or().After(leapDay)).Valid())
+}
@@ -0,0 +1,126 @@
+package valgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorCivilDateNot(t *testing.T) {
+	date := Date{Year: 2024, Month: time.March, Day: 1}
+
+	v := Is(CivilDate(date).Not().LeapDay())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorCivilDateComparisons(t *testing.T) {
+	leapDay := Date{Year: 2024, Month: time.February, Day: 29}
+	before := Date{Year: 2024, Month: time.February, Day: 28}
+	after := Date{Year: 2024, Month: time.March, Day: 1}
+
+	assert.True(t, Is(CivilDate(leapDay).EqualTo(leapDay)).Valid())
+	assert.True(t, Is(CivilDate(leapDay).After(before)).Valid())
+	assert.True(t, Is(CivilDate(leapDay).AfterOrEqualTo(leapDay)).Valid())
+	assert.True(t, Is(CivilDate(leapDay).Before(after)).Valid())
+	assert.True(t, Is(CivilDate(leapDay).BeforeOrEqualTo(leapDay)).Valid())
+	assert.True(t, Is(CivilDate(leapDay).Between(before, after)).Valid())
+	assert.True(t, Is(CivilDate(leapDay).Between(leapDay, leapDay)).Valid())
+
+	assert.False(t, Is(CivilDate(leapDay).EqualTo(after)).Valid())
+	assert.False(t, Is(CivilDate(leapDay).After(leapDay)).Valid())
+	assert.False(t, Is(CivilDate(leapDay).AfterOrEqualTo(after)).Valid())
+	assert.False(t, Is(CivilDate(leapDay).Before(leapDay)).Valid())
+	assert.False(t, Is(CivilDate(leapDay).BeforeOrEqualTo(before)).Valid())
+	assert.False(t, Is(CivilDate(leapDay).Between(after, after)).Valid())
+}
+
+func TestValidatorCivilDateMessages(t *testing.T) {
+	date := Date{Year: 2024, Month: time.January, Day: 15}
+	leapDay := Date{Year: 2024, Month: time.February, Day: 29}
+
+	v := Is(CivilDate(date, "start").After(leapDay))
+	assert.Equal(t,
+		"Start must be after \"2024-02-29\"",
+		v.Errors()["start"].Messages()[0])
+
+	v = Is(CivilDate(date, "start").Between(leapDay, Date{Year: 2024, Month: time.December, Day: 31}))
+	assert.Equal(t,
+		"Start must be between \"2024-02-29\" and \"2024-12-31\"",
+		v.Errors()["start"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(CivilDate(date, "start").EqualTo(leapDay))
+	assert.Equal(t,
+		"Start debe ser igual a \"29/02/2024\"",
+		v.Errors()["start"].Messages()[0])
+
+	// Dates that don't exist are rendered as they are
+	v = Is(CivilDate(date, "start").After(Date{Year: 2023, Month: time.February, Day: 30}))
+	assert.True(t, v.Valid())
+	v = Is(CivilDate(date, "start").Before(Date{Year: 2023, Month: time.February, Day: 30}))
+	assert.Equal(t,
+		"Start must be before \"2023-02-30\"",
+		v.Errors()["start"].Messages()[0])
+}
+
+func TestValidatorCivilDateValidAndLeapDay(t *testing.T) {
+	leapDay := Date{Year: 2024, Month: time.February, Day: 29}
+	notLeapYear := Date{Year: 2023, Month: time.February, Day: 29}
+
+	assert.True(t, Is(CivilDate(leapDay).Valid()).Valid())
+	assert.True(t, Is(CivilDate(leapDay).LeapDay()).Valid())
+	assert.True(t, Is(CivilDate(notLeapYear).Not().Valid()).Valid())
+	assert.True(t, Is(CivilDate(Date{Year: 2024, Month: time.March, Day: 1}).Not().LeapDay()).Valid())
+
+	v := Is(CivilDate(notLeapYear, "birthday").Valid())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Birthday must be a valid date",
+		v.Errors()["birthday"].Messages()[0])
+
+	v = Is(CivilDate(Date{}, "birthday").Valid())
+	assert.False(t, v.Valid())
+
+	v = Is(CivilDate(leapDay, "renewal").Not().LeapDay())
+	assert.Equal(t,
+		"Renewal can't be February 29",
+		v.Errors()["renewal"].Messages()[0])
+}
+
+func TestValidatorCivilDateWeekday(t *testing.T) {
+	// 2024-02-29 is a Thursday
+	leapDay := Date{Year: 2024, Month: time.February, Day: 29}
+
+	assert.True(t, Is(CivilDate(leapDay).Weekday([]time.Weekday{time.Thursday})).Valid())
+
+	v := Is(CivilDate(leapDay).Weekday([]time.Weekday{time.Saturday, time.Sunday}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be on Saturday, Sunday",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorCivilDateZeroInSliceAndPassing(t *testing.T) {
+	date := Date{Year: 2024, Month: time.December, Day: 25}
+
+	assert.True(t, Is(CivilDate(Date{}).Zero()).Valid())
+	assert.False(t, Is(CivilDate(date).Zero()).Valid())
+
+	assert.True(t, Is(CivilDate(date).InSlice([]Date{date})).Valid())
+	assert.False(t, Is(CivilDate(date).InSlice([]Date{{Year: 2024, Month: time.December, Day: 26}})).Valid())
+
+	assert.True(t, Is(CivilDate(date).Passing(func(d Date) bool { return d.Day == 25 })).Valid())
+	assert.False(t, Is(CivilDate(date).Passing(func(d Date) bool { return d.Day == 26 })).Valid())
+}
+
+func TestValidatorCivilDateOrOperator(t *testing.T) {
+	start := Date{Year: 2024, Month: time.January, Day: 1}
+
+	assert.True(t, Is(CivilDate(Date{}).Zero().Or().After(start)).Valid())
+	assert.True(t, Is(CivilDate(Date{Year: 2024, Month: time.May, Day: 1}).Zero().Or().After(start)).Valid())
+	assert.False(t, Is(CivilDate(Date{Year: 2023, Month: time.May, Day: 1}).Zero().Or().After(start)).Valid())
+
+	assert.True(t, Is(CivilDate(Date{}).Zero().OrElse().Valid().After(start)).Valid())
+	assert.False(t, Is(CivilDate(Date{Year: 2024, Month: time.February, Day: 30}).Zero().OrElse().Valid().After(start)).Valid())
+}
@@ -0,0 +1,276 @@
+package valgo
+
+// Report if the time of day is within the inclusive range. When min is later
+// than max, the range wraps around midnight.
+func isTimeOfDayInRange(v TimeOfDay, min TimeOfDay, max TimeOfDay) bool {
+	if !min.After(max) {
+		return !v.Before(min) && !v.After(max)
+	}
+	return !v.Before(min) || !v.After(max)
+}
+
+func isTimeOfDayInSlice(v TimeOfDay, slice []TimeOfDay) bool {
+	for _, _v := range slice {
+		if v == _v {
+			return true
+		}
+	}
+	return false
+}
+
+// The `ValidatorCivilTime` structure provides a set of methods to perform
+// validation checks on [TimeOfDay] values, which are wall clock times without
+// a date or a time zone.
+type ValidatorCivilTime struct {
+	context *ValidatorContext
+}
+
+// The CivilTime function initiates a new `ValidatorCivilTime` instance to
+// validate a given time of day. The optional name and title parameters can be
+// used for enhanced error reporting. If a name is provided without a title, the
+// name is humanized to be used as the title.
+//
+// Times of day in the error messages use the [LayoutKeyTimeOfDay] layout of the
+// locale, or [LayoutKeyTimeOfDaySeconds] when they have seconds.
+//
+// For example:
+//
+//	opensAt := v.TimeOfDay{Hour: 9}
+//	v.CivilTime(opensAt, "opens_at", "Opening time")
+func CivilTime(value TimeOfDay, nameAndTitle ...string) *ValidatorCivilTime {
+	return &ValidatorCivilTime{context: NewContext(value, nameAndTitle...)}
+}
+
+// The Context method returns the current context of the validator, which can
+// be utilized to create custom validations by extending this validator.
+func (validator *ValidatorCivilTime) Context() *ValidatorContext {
+	return validator.context
+}
+
+// The Not method inverts the boolean value associated with the next validator
+// method. This can be used to negate the check performed by the next validation
+// method in the chain.
+//
+// For example:
+//
+//	// Will return false because Not() inverts the boolean value of the Zero() function
+//	Is(v.CivilTime(v.TimeOfDay{}).Not().Zero()).Valid()
+func (validator *ValidatorCivilTime) Not() *ValidatorCivilTime {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the time is Zero (Zero() OR After(opensAt)).
+//	isValid := v.Is(v.CivilTime(v.TimeOfDay{}).Zero().Or().After(opensAt)).Valid()
+func (validator *ValidatorCivilTime) Or() *ValidatorCivilTime {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the time is Zero, the chain succeeds and Valid/Between are not evaluated.
+//	// Otherwise, the time must be valid and between opensAt and closesAt.
+//	isValid := v.Is(v.CivilTime(t).Zero().OrElse().Valid().Between(opensAt, closesAt)).Valid()
+func (validator *ValidatorCivilTime) OrElse() *ValidatorCivilTime {
+	validator.context.OrElse()
+	return validator
+}
+
+// The EqualTo method validates if the time of day is the same as another time
+// of day.
+//
+// For example:
+//
+//	Is(v.CivilTime(v.TimeOfDay{Hour: 9}).EqualTo(v.TimeOfDay{Hour: 9})).Valid()
+func (validator *ValidatorCivilTime) EqualTo(value TimeOfDay, template ...string) *ValidatorCivilTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(TimeOfDay) == value
+		},
+		ErrorKeyEqualTo, localizedCivilTime(value), template...)
+
+	return validator
+}
+
+// The After method checks if the time of day is after a specified time of day.
+//
+// For example:
+//
+//	Is(v.CivilTime(v.TimeOfDay{Hour: 10}).After(v.TimeOfDay{Hour: 9})).Valid()
+func (validator *ValidatorCivilTime) After(value TimeOfDay, template ...string) *ValidatorCivilTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(TimeOfDay).After(value)
+		},
+		ErrorKeyAfter, localizedCivilTime(value), template...)
+
+	return validator
+}
+
+// The AfterOrEqualTo method checks if the time of day is either after or the
+// same as a specified time of day.
+//
+// For example:
+//
+//	Is(v.CivilTime(v.TimeOfDay{Hour: 9}).AfterOrEqualTo(v.TimeOfDay{Hour: 9})).Valid()
+func (validator *ValidatorCivilTime) AfterOrEqualTo(value TimeOfDay, template ...string) *ValidatorCivilTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return !validator.context.Value().(TimeOfDay).Before(value)
+		},
+		ErrorKeyAfterOrEqualTo, localizedCivilTime(value), template...)
+
+	return validator
+}
+
+// The Before method checks if the time of day is before a specified time of
+// day.
+//
+// For example:
+//
+//	Is(v.CivilTime(v.TimeOfDay{Hour: 9}).Before(v.TimeOfDay{Hour: 17})).Valid()
+func (validator *ValidatorCivilTime) Before(value TimeOfDay, template ...string) *ValidatorCivilTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(TimeOfDay).Before(value)
+		},
+		ErrorKeyBefore, localizedCivilTime(value), template...)
+
+	return validator
+}
+
+// The BeforeOrEqualTo method checks if the time of day is either before or the
+// same as a specified time of day.
+//
+// For example:
+//
+//	Is(v.CivilTime(v.TimeOfDay{Hour: 17}).BeforeOrEqualTo(v.TimeOfDay{Hour: 17})).Valid()
+func (validator *ValidatorCivilTime) BeforeOrEqualTo(value TimeOfDay, template ...string) *ValidatorCivilTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return !validator.context.Value().(TimeOfDay).After(value)
+		},
+		ErrorKeyBeforeOrEqualTo, localizedCivilTime(value), template...)
+
+	return validator
+}
+
+// The Between method verifies if the time of day falls within a given range,
+// inclusive. When min is later than max, the range wraps around midnight, so a
+// range from 22:00 to 02:00 accepts 23:30 and 01:00.
+//
+// For example:
+//
+//	Is(v.CivilTime(v.TimeOfDay{Hour: 23, Minute: 30}).Between(v.TimeOfDay{Hour: 22}, v.TimeOfDay{Hour: 2})).Valid()
+func (validator *ValidatorCivilTime) Between(min TimeOfDay, max TimeOfDay, template ...string) *ValidatorCivilTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return isTimeOfDayInRange(validator.context.Value().(TimeOfDay), min, max)
+		},
+		ErrorKeyBetween,
+		map[string]any{"title": validator.context.title, "min": localizedCivilTime(min), "max": localizedCivilTime(max)},
+		template...)
+
+	return validator
+}
+
+// The Zero method verifies if the time of day is the zero value, that is,
+// midnight.
+//
+// For example:
+//
+//	Is(v.CivilTime(v.TimeOfDay{}).Zero()).Valid()
+func (validator *ValidatorCivilTime) Zero(template ...string) *ValidatorCivilTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(TimeOfDay).IsZero()
+		},
+		ErrorKeyZero, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The Valid method verifies if every field of the time of day is within its
+// range. For example, 24:00 and 10:60 are not valid.
+//
+// For example:
+//
+//	Is(v.CivilTime(v.TimeOfDay{Hour: 24}).Valid()).Valid() // false
+func (validator *ValidatorCivilTime) Valid(template ...string) *ValidatorCivilTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(TimeOfDay).IsValid()
+		},
+		ErrorKeyValidTimeOfDay, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The InSlice method validates if the time of day is present in a specified
+// slice of times of day.
+//
+// For example:
+//
+//	slots := []v.TimeOfDay{{Hour: 9}, {Hour: 9, Minute: 30}}
+//	Is(v.CivilTime(v.TimeOfDay{Hour: 9}).InSlice(slots)).Valid()
+func (validator *ValidatorCivilTime) InSlice(slice []TimeOfDay, template ...string) *ValidatorCivilTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return isTimeOfDayInSlice(validator.context.Value().(TimeOfDay), slice)
+		},
+		ErrorKeyInSlice, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The Passing method allows for custom validation logic to be applied on the
+// time of day.
+//
+// For example:
+//
+//	Is(v.CivilTime(t).Passing(func(t v.TimeOfDay) bool { return t.Minute%15 == 0 })).Valid()
+func (validator *ValidatorCivilTime) Passing(function func(v0 TimeOfDay) bool, template ...string) *ValidatorCivilTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(TimeOfDay))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
@@ -0,0 +1,273 @@
+package valgo
+
+// The TimeOfDay pointer validator type that keeps its validator context.
+type ValidatorCivilTimeP struct {
+	context *ValidatorContext
+}
+
+// Receive a pointer to a [TimeOfDay] to validate.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A value_%N pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name phone_number will be
+// humanized as Phone Number.
+//
+// Example:
+//
+//	var opensAt *v.TimeOfDay
+//	v.Is(v.CivilTimeP(opensAt, "opens_at").Nil())
+func CivilTimeP(value *TimeOfDay, nameAndTitle ...string) *ValidatorCivilTimeP {
+	return &ValidatorCivilTimeP{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorCivilTimeP) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the logical value associated with the next validator function.
+// For example:
+//
+//	// It will return false because `Not()` inverts the boolean value associated with `Zero()`
+//	midnight := v.TimeOfDay{}
+//	Is(v.CivilTimeP(&midnight).Not().Zero()).Valid()
+func (validator *ValidatorCivilTimeP) Not() *ValidatorCivilTimeP {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the time is nil (Nil() OR After(opensAt)).
+//	var t *v.TimeOfDay
+//	isValid := v.Is(v.CivilTimeP(t).Nil().Or().After(opensAt)).Valid()
+func (validator *ValidatorCivilTimeP) Or() *ValidatorCivilTimeP {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the time is nil, the chain succeeds and Valid/Between are not evaluated.
+//	// Otherwise, the time must be valid and between opensAt and closesAt.
+//	isValid := v.Is(v.CivilTimeP(t).Nil().OrElse().Valid().Between(opensAt, closesAt)).Valid()
+func (validator *ValidatorCivilTimeP) OrElse() *ValidatorCivilTimeP {
+	validator.context.OrElse()
+	return validator
+}
+
+// Validate if the time of day pointer is pointing to the same time of day as
+// another. For example:
+//
+//	opensAt := v.TimeOfDay{Hour: 9}
+//	Is(v.CivilTimeP(&opensAt).EqualTo(v.TimeOfDay{Hour: 9})).Valid()
+func (validator *ValidatorCivilTimeP) EqualTo(value TimeOfDay, template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) != nil && *(validator.context.Value().(*TimeOfDay)) == value
+		},
+		ErrorKeyEqualTo, localizedCivilTime(value), template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer is pointing to a time of day after
+// another. For example:
+//
+//	closesAt := v.TimeOfDay{Hour: 17}
+//	Is(v.CivilTimeP(&closesAt).After(v.TimeOfDay{Hour: 9})).Valid()
+func (validator *ValidatorCivilTimeP) After(value TimeOfDay, template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) != nil && validator.context.Value().(*TimeOfDay).After(value)
+		},
+		ErrorKeyAfter, localizedCivilTime(value), template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer is pointing to a time of day after or
+// the same as another. For example:
+//
+//	opensAt := v.TimeOfDay{Hour: 9}
+//	Is(v.CivilTimeP(&opensAt).AfterOrEqualTo(v.TimeOfDay{Hour: 9})).Valid()
+func (validator *ValidatorCivilTimeP) AfterOrEqualTo(value TimeOfDay, template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) != nil && !validator.context.Value().(*TimeOfDay).Before(value)
+		},
+		ErrorKeyAfterOrEqualTo, localizedCivilTime(value), template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer is pointing to a time of day before
+// another. For example:
+//
+//	opensAt := v.TimeOfDay{Hour: 9}
+//	Is(v.CivilTimeP(&opensAt).Before(v.TimeOfDay{Hour: 17})).Valid()
+func (validator *ValidatorCivilTimeP) Before(value TimeOfDay, template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) != nil && validator.context.Value().(*TimeOfDay).Before(value)
+		},
+		ErrorKeyBefore, localizedCivilTime(value), template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer is pointing to a time of day before or
+// the same as another. For example:
+//
+//	closesAt := v.TimeOfDay{Hour: 17}
+//	Is(v.CivilTimeP(&closesAt).BeforeOrEqualTo(v.TimeOfDay{Hour: 17})).Valid()
+func (validator *ValidatorCivilTimeP) BeforeOrEqualTo(value TimeOfDay, template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) != nil && !validator.context.Value().(*TimeOfDay).After(value)
+		},
+		ErrorKeyBeforeOrEqualTo, localizedCivilTime(value), template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer is pointing to a time of day within a
+// range, inclusive. When min is later than max, the range wraps around
+// midnight. For example:
+//
+//	lastCall := v.TimeOfDay{Hour: 1}
+//	Is(v.CivilTimeP(&lastCall).Between(v.TimeOfDay{Hour: 22}, v.TimeOfDay{Hour: 2})).Valid()
+func (validator *ValidatorCivilTimeP) Between(min TimeOfDay, max TimeOfDay, template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) != nil && isTimeOfDayInRange(*(validator.context.Value().(*TimeOfDay)), min, max)
+		},
+		ErrorKeyBetween,
+		map[string]any{"title": validator.context.title, "min": localizedCivilTime(min), "max": localizedCivilTime(max)},
+		template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer is pointing to midnight, the zero time
+// of day. For example:
+//
+//	midnight := v.TimeOfDay{}
+//	Is(v.CivilTimeP(&midnight).Zero()).Valid()
+func (validator *ValidatorCivilTimeP) Zero(template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) != nil && validator.context.Value().(*TimeOfDay).IsZero()
+		},
+		ErrorKeyZero, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer is pointing to a time of day with every
+// field within its range. A nil pointer is not valid. For example:
+//
+//	t := v.TimeOfDay{Hour: 24}
+//	Is(v.CivilTimeP(&t).Valid()).Valid() // false
+func (validator *ValidatorCivilTimeP) Valid(template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) != nil && validator.context.Value().(*TimeOfDay).IsValid()
+		},
+		ErrorKeyValidTimeOfDay, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer is pointing to a time of day present in
+// a slice. For example:
+//
+//	slots := []v.TimeOfDay{{Hour: 9}, {Hour: 9, Minute: 30}}
+//	Is(v.CivilTimeP(&startsAt).InSlice(slots)).Valid()
+func (validator *ValidatorCivilTimeP) InSlice(slice []TimeOfDay, template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) != nil && isTimeOfDayInSlice(*(validator.context.Value().(*TimeOfDay)), slice)
+		},
+		ErrorKeyInSlice, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer passes a custom function. For example:
+//
+//	Is(v.CivilTimeP(&t).Passing(func(t *v.TimeOfDay) bool { return t != nil && t.Minute%15 == 0 })).Valid()
+func (validator *ValidatorCivilTimeP) Passing(function func(v0 *TimeOfDay) bool, template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(*TimeOfDay))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer is nil. For example:
+//
+//	var t *v.TimeOfDay
+//	Is(v.CivilTimeP(t).Nil()).Valid()
+func (validator *ValidatorCivilTimeP) Nil(template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) == nil
+		},
+		ErrorKeyNil, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the time of day pointer is nil or pointing to midnight, the zero
+// time of day. For example:
+//
+//	var t *v.TimeOfDay
+//	Is(v.CivilTimeP(t).NilOrZero()).Valid()
+func (validator *ValidatorCivilTimeP) NilOrZero(template ...string) *ValidatorCivilTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*TimeOfDay) == nil || validator.context.Value().(*TimeOfDay).IsZero()
+		},
+		ErrorKeyNil, validator.context.Value(), template...)
+
+	return validator
+}
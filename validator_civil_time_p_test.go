@@ -0,0 +1,66 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorCivilTimePNot(t *testing.T) {
+	value := TimeOfDay{Hour: 9}
+
+	v := Is(CivilTimeP(&value).Not().Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorCivilTimePRules(t *testing.T) {
+	opensAt := TimeOfDay{Hour: 9}
+	closesAt := TimeOfDay{Hour: 17}
+	value := TimeOfDay{Hour: 12}
+
+	assert.True(t, Is(CivilTimeP(&value).
+		EqualTo(TimeOfDay{Hour: 12}).
+		After(opensAt).
+		AfterOrEqualTo(value).
+		Before(closesAt).
+		BeforeOrEqualTo(value).
+		Between(opensAt, closesAt).
+		Valid().
+		InSlice([]TimeOfDay{value}).
+		Passing(func(t *TimeOfDay) bool { return t != nil })).Valid())
+
+	assert.False(t, Is(CivilTimeP(&value).Between(TimeOfDay{Hour: 22}, TimeOfDay{Hour: 2})).Valid())
+
+	v := Is(CivilTimeP(&value, "starts_at").Before(opensAt))
+	assert.Equal(t,
+		"Starts at must be before \"09:00\"",
+		v.Errors()["starts_at"].Messages()[0])
+
+	midnight := TimeOfDay{}
+	assert.True(t, Is(CivilTimeP(&midnight).Zero()).Valid())
+	assert.True(t, Is(CivilTimeP(&midnight).NilOrZero()).Valid())
+	assert.False(t, Is(CivilTimeP(&value).NilOrZero()).Valid())
+	assert.False(t, Is(CivilTimeP(&value).Nil()).Valid())
+}
+
+func TestValidatorCivilTimePNil(t *testing.T) {
+	var value *TimeOfDay
+	opensAt := TimeOfDay{Hour: 9}
+
+	assert.True(t, Is(CivilTimeP(value).Nil()).Valid())
+	assert.True(t, Is(CivilTimeP(value).NilOrZero()).Valid())
+
+	assert.False(t, Is(CivilTimeP(value).EqualTo(opensAt)).Valid())
+	assert.False(t, Is(CivilTimeP(value).After(opensAt)).Valid())
+	assert.False(t, Is(CivilTimeP(value).AfterOrEqualTo(opensAt)).Valid())
+	assert.False(t, Is(CivilTimeP(value).Before(opensAt)).Valid())
+	assert.False(t, Is(CivilTimeP(value).BeforeOrEqualTo(opensAt)).Valid())
+	assert.False(t, Is(CivilTimeP(value).Between(opensAt, opensAt)).Valid())
+	assert.False(t, Is(CivilTimeP(value).Zero()).Valid())
+	assert.False(t, Is(CivilTimeP(value).Valid()).Valid())
+	assert.False(t, Is(CivilTimeP(value).InSlice([]TimeOfDay{opensAt})).Valid())
+	assert.False(t, Is(CivilTimeP(value).Passing(func(t *TimeOfDay) bool { return t != nil })).Valid())
+
+	assert.True(t, Is(CivilTimeP(value).Nil().Or().After(opensAt)).Valid())
+}
@@ -0,0 +1,109 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorCivilTimeNot(t *testing.T) {
+	v := Is(CivilTime(TimeOfDay{Hour: 9}).Not().Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorCivilTimeComparisons(t *testing.T) {
+	opensAt := TimeOfDay{Hour: 9}
+	closesAt := TimeOfDay{Hour: 17, Minute: 30}
+	value := TimeOfDay{Hour: 12, Minute: 15}
+
+	assert.True(t, Is(CivilTime(value).EqualTo(TimeOfDay{Hour: 12, Minute: 15})).Valid())
+	assert.True(t, Is(CivilTime(value).After(opensAt)).Valid())
+	assert.True(t, Is(CivilTime(opensAt).AfterOrEqualTo(opensAt)).Valid())
+	assert.True(t, Is(CivilTime(value).Before(closesAt)).Valid())
+	assert.True(t, Is(CivilTime(closesAt).BeforeOrEqualTo(closesAt)).Valid())
+	assert.True(t, Is(CivilTime(value).Between(opensAt, closesAt)).Valid())
+	assert.True(t, Is(CivilTime(opensAt).Between(opensAt, closesAt)).Valid())
+	assert.True(t, Is(CivilTime(closesAt).Between(opensAt, closesAt)).Valid())
+
+	assert.False(t, Is(CivilTime(value).EqualTo(opensAt)).Valid())
+	assert.False(t, Is(CivilTime(opensAt).After(opensAt)).Valid())
+	assert.False(t, Is(CivilTime(opensAt).AfterOrEqualTo(value)).Valid())
+	assert.False(t, Is(CivilTime(closesAt).Before(closesAt)).Valid())
+	assert.False(t, Is(CivilTime(closesAt).BeforeOrEqualTo(value)).Valid())
+	assert.False(t, Is(CivilTime(TimeOfDay{Hour: 18}).Between(opensAt, closesAt)).Valid())
+}
+
+func TestValidatorCivilTimeBetweenWrapsAroundMidnight(t *testing.T) {
+	from := TimeOfDay{Hour: 22}
+	to := TimeOfDay{Hour: 2}
+
+	assert.True(t, Is(CivilTime(TimeOfDay{Hour: 23, Minute: 30}).Between(from, to)).Valid())
+	assert.True(t, Is(CivilTime(TimeOfDay{}).Between(from, to)).Valid())
+	assert.True(t, Is(CivilTime(TimeOfDay{Hour: 2}).Between(from, to)).Valid())
+	assert.False(t, Is(CivilTime(TimeOfDay{Hour: 2, Second: 1}).Between(from, to)).Valid())
+	assert.False(t, Is(CivilTime(TimeOfDay{Hour: 12}).Between(from, to)).Valid())
+}
+
+func TestValidatorCivilTimeMessages(t *testing.T) {
+	value := TimeOfDay{Hour: 8}
+
+	v := Is(CivilTime(value, "opens_at").Between(TimeOfDay{Hour: 9}, TimeOfDay{Hour: 17, Minute: 30}))
+	assert.Equal(t,
+		"Opens at must be between \"09:00\" and \"17:30\"",
+		v.Errors()["opens_at"].Messages()[0])
+
+	v = Is(CivilTime(value, "opens_at").After(TimeOfDay{Hour: 8, Second: 30}))
+	assert.Equal(t,
+		"Opens at must be after \"08:00:30\"",
+		v.Errors()["opens_at"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(CivilTime(value, "opens_at").EqualTo(TimeOfDay{Hour: 9}))
+	assert.Equal(t,
+		"Opens at debe ser igual a \"09:00\"",
+		v.Errors()["opens_at"].Messages()[0])
+
+	// Times of day out of range are rendered as they are
+	v = Is(CivilTime(value, "opens_at").After(TimeOfDay{Hour: 25}))
+	assert.Equal(t,
+		"Opens at must be after \"25:00:00\"",
+		v.Errors()["opens_at"].Messages()[0])
+}
+
+func TestValidatorCivilTimeValid(t *testing.T) {
+	assert.True(t, Is(CivilTime(TimeOfDay{Hour: 23, Minute: 59, Second: 59}).Valid()).Valid())
+
+	v := Is(CivilTime(TimeOfDay{Hour: 24}, "closes_at").Valid())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Closes at must be a valid time of day",
+		v.Errors()["closes_at"].Messages()[0])
+
+	assert.False(t, Is(CivilTime(TimeOfDay{Minute: 60}).Valid()).Valid())
+	assert.True(t, Is(CivilTime(TimeOfDay{Second: -1}).Not().Valid()).Valid())
+}
+
+func TestValidatorCivilTimeZeroInSliceAndPassing(t *testing.T) {
+	slots := []TimeOfDay{{Hour: 9}, {Hour: 9, Minute: 30}}
+
+	assert.True(t, Is(CivilTime(TimeOfDay{}).Zero()).Valid())
+	assert.False(t, Is(CivilTime(TimeOfDay{Nanosecond: 1}).Zero()).Valid())
+
+	assert.True(t, Is(CivilTime(TimeOfDay{Hour: 9, Minute: 30}).InSlice(slots)).Valid())
+	assert.False(t, Is(CivilTime(TimeOfDay{Hour: 10}).InSlice(slots)).Valid())
+
+	quarter := func(t TimeOfDay) bool { return t.Minute%15 == 0 }
+	assert.True(t, Is(CivilTime(TimeOfDay{Hour: 9, Minute: 45}).Passing(quarter)).Valid())
+	assert.False(t, Is(CivilTime(TimeOfDay{Hour: 9, Minute: 40}).Passing(quarter)).Valid())
+}
+
+func TestValidatorCivilTimeOrOperator(t *testing.T) {
+	opensAt := TimeOfDay{Hour: 9}
+	closesAt := TimeOfDay{Hour: 17}
+
+	assert.True(t, Is(CivilTime(TimeOfDay{}).Zero().Or().Between(opensAt, closesAt)).Valid())
+	assert.False(t, Is(CivilTime(TimeOfDay{Hour: 8}).Zero().Or().Between(opensAt, closesAt)).Valid())
+
+	assert.True(t, Is(CivilTime(TimeOfDay{}).Zero().OrElse().Valid().Between(opensAt, closesAt)).Valid())
+	assert.False(t, Is(CivilTime(TimeOfDay{Hour: 25}).Zero().OrElse().Valid().Between(opensAt, closesAt)).Valid())
+}
@@ -1,15 +1,30 @@
 package valgo
 
+import (
+	"slices"
+	"strconv"
+	"time"
+)
+
 type validatorFragment struct {
-	errorKey       string
-	template       []string
-	templateParams map[string]any
-	function       func() bool
-	boolOperation  bool
-	orOperation    orOperationType
-	isValid        bool
+	errorKey         string
+	template         []string
+	templateParams   map[string]any
+	function         func() bool
+	nestedFunction   nestedFunction
+	nestedValidation *Validation
+	boolOperation    bool
+	orOperation      orOperationType
+	isValid          bool
+	guard            func() bool
 }
 
+// A nested function validates the inner values of a collection (e.g. the
+// elements of a slice). It receives the name and title of the validator, the
+// short-circuit mode of the session and a new [Validation] session where the
+// errors of the inner values must be added using their full paths.
+type nestedFunction func(name string, title string, shortCircuit bool, validation *Validation) bool
+
 type orOperationType uint8
 
 const (
@@ -28,6 +43,9 @@ type ValidatorContext struct {
 	fallbackLocale *Locale
 	boolOperation  bool
 	orOperation    orOperationType
+	validation     *Validation
+	guard          func() bool
+	valueFunction  func() any
 }
 
 // Create a new [ValidatorContext] to be used by a custom validator.
@@ -137,6 +155,7 @@ func (ctx *ValidatorContext) AddWithParams(function func() bool, errorKey string
 		boolOperation:  ctx.boolOperation,
 		orOperation:    ctx.orOperation,
 		isValid:        true,
+		guard:          ctx.guard,
 	}
 	if len(template) > 0 {
 		fragment.template = template
@@ -148,6 +167,61 @@ func (ctx *ValidatorContext) AddWithParams(function func() bool, errorKey string
 	return ctx
 }
 
+// Add a function that also returns the error key used in the error message, so
+// a rule can report different problems of the value, such as a bad format or a
+// bad checksum, with different messages.
+func (ctx *ValidatorContext) addWithKeyFunction(function func() (bool, string), templateParams map[string]any, template ...string) *ValidatorContext {
+	ctx.AddWithParams(nil, "", templateParams, template...)
+
+	fragment := ctx.fragments[len(ctx.fragments)-1]
+	fragment.function = func() bool {
+		valid, errorKey := function()
+		fragment.errorKey = errorKey
+		return valid
+	}
+
+	return ctx
+}
+
+// Add a nested function to a validator. Unlike the functions added with
+// [Add()], the nested function doesn't produce an error message for the
+// validator itself; the errors are added to the paths of the inner values.
+//
+// A nested function can't be inverted, since its errors belong to the inner
+// values, so a pending Not() is discarded and doesn't apply to the next rule
+// either.
+func (ctx *ValidatorContext) addNested(function nestedFunction) *ValidatorContext {
+
+	fragment := &validatorFragment{
+		nestedFunction: function,
+		boolOperation:  true,
+		orOperation:    ctx.orOperation,
+		isValid:        true,
+		guard:          ctx.guard,
+	}
+	ctx.fragments = append(ctx.fragments, fragment)
+	ctx.boolOperation = true
+	ctx.orOperation = orOperationTypeNone
+
+	return ctx
+}
+
+// Return a context to continue the chain of rules with a value converted from
+// the value of this context, such as a time parsed from a string. The value
+// function is called when the rules are evaluated. The rules added to the new
+// context are skipped when the guard reports that the conversion failed, so
+// only the error of the conversion is reported.
+func (ctx *ValidatorContext) convert(value func() any, guard func() bool) *ValidatorContext {
+	converted := *ctx
+	// The source context can still receive rules, so the contexts can't share
+	// the array of fragments
+	converted.fragments = slices.Clone(ctx.fragments)
+	converted.value = nil
+	converted.valueFunction = value
+	converted.guard = guard
+	return &converted
+}
+
 func (ctx *ValidatorContext) validateIs(validation *Validation) *Validation {
 	return ctx.validate(validation, true)
 }
@@ -164,6 +238,10 @@ func (ctx *ValidatorContext) validate(validation *Validation, shortCircuit bool)
 	// valid := true
 	validation.currentIndex++
 
+	// Keep the session while the rules are evaluated, so they can use its clock
+	ctx.validation = validation
+	defer func() { ctx.validation = nil }()
+
 	// Apply fallback locales (if any) without mutating shared locale maps.
 	if ctx.fallbackLocale != nil && validation._locale != nil {
 		// Copy-on-write: only clone when at least one fallback key is missing.
@@ -210,10 +288,23 @@ func (ctx *ValidatorContext) validate(validation *Validation, shortCircuit bool)
 			break
 		}
 
+		// Rules that follow a failed conversion can't be evaluated, so they are
+		// skipped without an error
+		if fragment.guard != nil && !fragment.guard() {
+			fragment.isValid = true
+			continue
+		}
+
 		// Evaluating the validation function of the current fragment and updating the valid flag
 		// The valid flag will be true only if the fragment function returns a value matching the fragment's boolean operation
 		// and the valid flag was true before this evaluation
-		fragment.isValid = fragment.function() == fragment.boolOperation
+		if fragment.nestedFunction != nil {
+			name, title := ctx.resolveNameAndTitle(validation)
+			fragment.nestedValidation = validation.newNestedValidation()
+			fragment.isValid = fragment.nestedFunction(name, title, shortCircuit, fragment.nestedValidation)
+		} else {
+			fragment.isValid = fragment.function() == fragment.boolOperation
+		}
 
 		if !fragment.isValid {
 			if fragment.orOperation != orOperationTypeNone {
@@ -230,14 +321,94 @@ func (ctx *ValidatorContext) validate(validation *Validation, shortCircuit bool)
 		}
 	}
 
-	if len(invalidFragments) > 0 {
-		validation.invalidate(ctx.name, ctx.title, invalidFragments)
+	// The errors of nested fragments were already added to the paths of the
+	// inner values, so they are merged instead of being templated
+	templatedFragments := []*invalidFragment{}
+	for _, _invalidFragment := range invalidFragments {
+		fragments := []*validatorFragment{}
+		for _, fragment := range _invalidFragment.fragments {
+			if fragment.nestedFunction != nil {
+				validation.merge("", fragment.nestedValidation)
+			} else {
+				fragments = append(fragments, fragment)
+			}
+		}
+		if len(fragments) > 0 {
+			_invalidFragment.fragments = fragments
+			templatedFragments = append(templatedFragments, _invalidFragment)
+		}
+	}
+
+	if len(templatedFragments) > 0 {
+		validation.invalidate(ctx.name, ctx.title, templatedFragments)
 	}
 
 	return validation
 }
 
+// Validate a nested validator in a new [Validation] session and add its error
+// messages to the path, with the same format used by [Validation.InCell]. When
+// the nested validator has no name, the path and the title are used instead
+// while it's validated. The original context is validated, since the rules of
+// the nested validator read the session, such as its clock, from it.
+func validateNestedValidator(validator Validator, path string, title string, shortCircuit bool, validation *Validation) bool {
+	ctx := validator.Context()
+	if ctx.name == nil {
+		name, _title := ctx.name, ctx.title
+		defer func() { ctx.name, ctx.title = name, _title }()
+
+		ctx.name = &path
+		if ctx.title == nil {
+			ctx.title = &title
+		}
+	}
+
+	nestedValidation := ctx.validate(validation.newNestedValidation(), shortCircuit)
+	if nestedValidation.Valid() {
+		return true
+	}
+
+	for _, err := range nestedValidation.Errors() {
+		for _, message := range err.Messages() {
+			validation.AddErrorMessage(path, message)
+		}
+	}
+	return false
+}
+
+// Return the name and title used in the error messages of the validator,
+// applying the same defaults used when the validation is invalidated.
+func (ctx *ValidatorContext) resolveNameAndTitle(validation *Validation) (string, string) {
+	var name string
+	if ctx.name == nil {
+		name = "value_" + strconv.Itoa(validation.currentIndex-1)
+	} else {
+		name = *ctx.name
+	}
+
+	title := humanizeName(name)
+	if ctx.title != nil {
+		title = *ctx.title
+	}
+
+	return name, title
+}
+
 // Return the value being validated in a custom validator.
 func (ctx *ValidatorContext) Value() any {
+	if ctx.valueFunction != nil {
+		return ctx.valueFunction()
+	}
 	return ctx.value
 }
+
+// Return the current time according to the [Clock] of the [Validation]
+// session that is evaluating the rules, or the system time when it's called
+// outside of an evaluation. Custom rules relative to the current time should
+// use it instead of time.Now(), so they can be tested with a frozen clock.
+func (ctx *ValidatorContext) Now() time.Time {
+	if ctx.validation != nil {
+		return ctx.validation.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,441 @@
+package valgo
+
+import (
+	"strings"
+)
+
+// A decimal number split in its sign, integer digits, and fraction digits,
+// exactly as written. For example, "-012.50" is parsed as negative "012" and
+// "50".
+type decimalNumber struct {
+	negative bool
+	integer  string
+	fraction string
+}
+
+func isDecimalDigits(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse a decimal number in the form `[+-]digits[.digits]`. Exponents,
+// whitespace, thousands separators, and missing digits around the decimal point,
+// such as ".5" or "5.", are not accepted.
+func parseDecimal(s string) (decimalNumber, bool) {
+	d := decimalNumber{}
+
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		d.negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	integer, fraction, hasPoint := strings.Cut(s, ".")
+	if !isDecimalDigits(integer) || (hasPoint && !isDecimalDigits(fraction)) {
+		return decimalNumber{}, false
+	}
+
+	d.integer = integer
+	d.fraction = fraction
+
+	return d, true
+}
+
+// The number of digits after the decimal point, including trailing zeros.
+func (d decimalNumber) scale() int {
+	return len(d.fraction)
+}
+
+// The total number of digits, excluding the leading zeros of the integer part,
+// as in the SQL `NUMERIC(precision, scale)` type.
+func (d decimalNumber) precision() int {
+	return len(strings.TrimLeft(d.integer, "0")) + len(d.fraction)
+}
+
+// The sign of the number: -1, 0, or +1. "-0.00" is zero.
+func (d decimalNumber) sign() int {
+	if strings.Trim(d.integer, "0") == "" && strings.Trim(d.fraction, "0") == "" {
+		return 0
+	}
+	if d.negative {
+		return -1
+	}
+	return 1
+}
+
+// Compare two decimal numbers exactly, without converting them to floats.
+// Returns -1 if d < o, 0 if d == o, and +1 if d > o.
+func (d decimalNumber) cmp(o decimalNumber) int {
+	ds, os := d.sign(), o.sign()
+	if ds != os {
+		if ds < os {
+			return -1
+		}
+		return 1
+	}
+	if ds == 0 {
+		return 0
+	}
+
+	c := compareDecimalMagnitude(d, o)
+	if ds < 0 {
+		return -c
+	}
+	return c
+}
+
+func compareDecimalMagnitude(d decimalNumber, o decimalNumber) int {
+	di := strings.TrimLeft(d.integer, "0")
+	oi := strings.TrimLeft(o.integer, "0")
+
+	if len(di) != len(oi) {
+		if len(di) < len(oi) {
+			return -1
+		}
+		return 1
+	}
+	if c := strings.Compare(di, oi); c != 0 {
+		return c
+	}
+
+	df := strings.TrimRight(d.fraction, "0")
+	of := strings.TrimRight(o.fraction, "0")
+
+	// With trailing zeros removed, the fraction digits compare lexicographically
+	return strings.Compare(df, of)
+}
+
+func isDecimalValid[T ~string](v T) bool {
+	_, ok := parseDecimal(string(v))
+	return ok
+}
+
+func isDecimalMaxPrecision[T ~string](v T, precision int) bool {
+	d, ok := parseDecimal(string(v))
+	return ok && d.precision() <= precision
+}
+
+func isDecimalMaxScale[T ~string](v T, scale int) bool {
+	d, ok := parseDecimal(string(v))
+	return ok && d.scale() <= scale
+}
+
+func isDecimalScale[T ~string](v T, scale int) bool {
+	d, ok := parseDecimal(string(v))
+	return ok && d.scale() == scale
+}
+
+func isDecimalBetween[T ~string](v T, min string, max string) bool {
+	d, ok := parseDecimal(string(v))
+	if !ok {
+		return false
+	}
+	_min, ok := parseDecimal(min)
+	if !ok {
+		return false
+	}
+	_max, ok := parseDecimal(max)
+	if !ok {
+		return false
+	}
+	return d.cmp(_min) >= 0 && d.cmp(_max) <= 0
+}
+
+func isDecimalPositive[T ~string](v T) bool {
+	d, ok := parseDecimal(string(v))
+	return ok && d.sign() > 0
+}
+
+func isDecimalNonNegative[T ~string](v T) bool {
+	d, ok := parseDecimal(string(v))
+	return ok && d.sign() >= 0
+}
+
+func isDecimalCurrencyMinorUnits[T ~string](v T, code string) bool {
+	units, ok := currencyMinorUnits[strings.ToUpper(code)]
+	if !ok {
+		return false
+	}
+	d, ok := parseDecimal(string(v))
+	return ok && d.scale() <= units
+}
+
+// Add the rule of a currency code that isn't in ISO 4217. The rule always fails
+// with its own message, so the unknown code is reported instead of rejecting
+// every amount silently. A pending Not() is discarded, since it would accept
+// every amount.
+func addDecimalUnknownCurrency(ctx *ValidatorContext, code string, template []string) {
+	ctx.boolOperation = true
+	ctx.AddWithParams(
+		func() bool {
+			return false
+		},
+		ErrorKeyUnknownCurrency,
+		map[string]any{"title": ctx.title, "currency": code, "value": ctx.Value()},
+		template...)
+}
+
+// The `ValidatorDecimal` provides functions for setting validation rules for
+// decimal numbers represented as strings, such as money and other fixed-point
+// amounts like "1234.50".
+//
+// The values are compared exactly, digit by digit, without converting them to
+// floats. A value that isn't a valid decimal number doesn't satisfy any rule.
+type ValidatorDecimal[T ~string] struct {
+	context *ValidatorContext
+}
+
+// Receive a decimal string value to validate.
+//
+// The value can also be a custom string type such as type Amount string;. A
+// valid decimal has an optional sign, integer digits, and optionally a decimal
+// point followed by fraction digits; for example: "1234.50", "-0.5", or "+10".
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A `value_%N` pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name phone_number will be
+// humanized as Phone Number.
+func Decimal[T ~string](value T, nameAndTitle ...string) *ValidatorDecimal[T] {
+	return &ValidatorDecimal[T]{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorDecimal[T]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the boolean value associated with the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Positive() function
+//	Is(v.Decimal("10.00").Not().Positive()).Valid()
+func (validator *ValidatorDecimal[T]) Not() *ValidatorDecimal[T] {
+	validator.context.Not()
+
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the amount has two decimal places (Scale(0) OR Scale(2)).
+//	isValid := v.Is(v.Decimal("10.50").Scale(0).Or().Scale(2)).Valid()
+func (validator *ValidatorDecimal[T]) Or() *ValidatorDecimal[T] {
+	validator.context.Or()
+
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the amount has no decimal places, the chain succeeds and the rest is not evaluated.
+//	// Otherwise, the amount must be positive with at most two decimal places.
+//	isValid := v.Is(v.Decimal(amount).Scale(0).OrElse().Positive().MaxScale(2)).Valid()
+func (validator *ValidatorDecimal[T]) OrElse() *ValidatorDecimal[T] {
+	validator.context.OrElse()
+
+	return validator
+}
+
+// Validate if the value is a valid decimal number.
+// For example:
+//
+//	Is(v.Decimal("1234.50").Valid()) // Will be true
+//	Is(v.Decimal("1.2e3").Valid()) // Will be false
+func (validator *ValidatorDecimal[T]) Valid(template ...string) *ValidatorDecimal[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDecimalValid(validator.context.Value().(T))
+		},
+		ErrorKeyDecimal, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate the maximum precision of a decimal number. The precision is the
+// total number of digits, excluding the leading zeros of the integer part, as
+// in the SQL `NUMERIC(precision, scale)` type.
+// For example:
+//
+//	Is(v.Decimal("1234.50").MaxPrecision(6)) // Will be true
+//	Is(v.Decimal("0001234.50").MaxPrecision(6)) // Will be true
+func (validator *ValidatorDecimal[T]) MaxPrecision(precision int, template ...string) *ValidatorDecimal[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isDecimalMaxPrecision(validator.context.Value().(T), precision)
+		},
+		ErrorKeyMaxPrecision,
+		map[string]any{"title": validator.context.title, "precision": precision, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the maximum scale of a decimal number. The scale is the number of
+// digits after the decimal point, including trailing zeros.
+// For example:
+//
+//	Is(v.Decimal("1234.5").MaxScale(2)) // Will be true
+//	Is(v.Decimal("1234.500").MaxScale(2)) // Will be false
+func (validator *ValidatorDecimal[T]) MaxScale(scale int, template ...string) *ValidatorDecimal[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isDecimalMaxScale(validator.context.Value().(T), scale)
+		},
+		ErrorKeyMaxScale,
+		map[string]any{"title": validator.context.title, "scale": scale, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the exact scale of a decimal number. The scale is the number of
+// digits after the decimal point, including trailing zeros.
+// For example:
+//
+//	Is(v.Decimal("1234.50").Scale(2)) // Will be true
+//	Is(v.Decimal("1234.5").Scale(2)) // Will be false
+func (validator *ValidatorDecimal[T]) Scale(scale int, template ...string) *ValidatorDecimal[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isDecimalScale(validator.context.Value().(T), scale)
+		},
+		ErrorKeyScale,
+		map[string]any{"title": validator.context.title, "scale": scale, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a decimal number is within a range (inclusive). The bounds are
+// decimal strings too, and the rule fails if any of them is not a valid
+// decimal number.
+// For example:
+//
+//	Is(v.Decimal("10.50").Between("0.01", "999999.99"))
+func (validator *ValidatorDecimal[T]) Between(min string, max string, template ...string) *ValidatorDecimal[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isDecimalBetween(validator.context.Value().(T), min, max)
+		},
+		ErrorKeyBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a decimal number is positive (greater than zero).
+// For example:
+//
+//	Is(v.Decimal("0.01").Positive()) // Will be true
+//	Is(v.Decimal("-0.00").Positive()) // Will be false
+func (validator *ValidatorDecimal[T]) Positive(template ...string) *ValidatorDecimal[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDecimalPositive(validator.context.Value().(T))
+		},
+		ErrorKeyPositive, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a decimal number is zero or positive.
+// For example:
+//
+//	Is(v.Decimal("0.00").NonNegative()) // Will be true
+//	Is(v.Decimal("-0.01").NonNegative()) // Will be false
+func (validator *ValidatorDecimal[T]) NonNegative(template ...string) *ValidatorDecimal[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDecimalNonNegative(validator.context.Value().(T))
+		},
+		ErrorKeyNonNegative, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a decimal number doesn't have more decimal places than the minor
+// units of the currency, according to ISO 4217. The currency code is case
+// insensitive. If the code is unknown, the rule fails with the message of
+// [ErrorKeyUnknownCurrency], even after a Not().
+// For example:
+//
+//	Is(v.Decimal("10.50").CurrencyMinorUnits("USD")) // Will be true
+//	Is(v.Decimal("10.50").CurrencyMinorUnits("JPY")) // Will be false
+//	Is(v.Decimal("10.125").CurrencyMinorUnits("KWD")) // Will be true
+func (validator *ValidatorDecimal[T]) CurrencyMinorUnits(code string, template ...string) *ValidatorDecimal[T] {
+	units, ok := currencyMinorUnits[strings.ToUpper(code)]
+	if !ok {
+		addDecimalUnknownCurrency(validator.context, code, template)
+		return validator
+	}
+	params := map[string]any{"title": validator.context.title, "currency": code, "units": units, "value": validator.context.Value()}
+
+	validator.context.AddWithParams(
+		func() bool {
+			return isDecimalCurrencyMinorUnits(validator.context.Value().(T), code)
+		},
+		ErrorKeyCurrencyMinorUnits,
+		params,
+		template...)
+
+	return validator
+}
+
+// Validate if a decimal number passes a custom function.
+// For example:
+//
+//	Is(v.Decimal(amount).Passing(func(v string) bool {
+//		return v != "0"
+//	}))
+func (validator *ValidatorDecimal[T]) Passing(function func(v0 T) bool, template ...string) *ValidatorDecimal[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(T))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
@@ -0,0 +1,242 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal(t *testing.T) {
+
+	for _, s := range []string{"0", "-0", "+0", "1234.50", "-0.5", "0001.000", "99999999999999999999999999.99"} {
+		_, ok := parseDecimal(s)
+		assert.True(t, ok, s)
+	}
+
+	for _, s := range []string{"", "-", "+", ".5", "5.", "1.2.3", "1e3", " 1", "1 ", "1,000.00", "--1", "0x10", "١٢"} {
+		_, ok := parseDecimal(s)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestDecimalNumberCmp(t *testing.T) {
+
+	cmp := func(a, b string) int {
+		_a, _ := parseDecimal(a)
+		_b, _ := parseDecimal(b)
+		return _a.cmp(_b)
+	}
+
+	assert.Equal(t, 0, cmp("1.50", "1.5"))
+	assert.Equal(t, 0, cmp("-0.00", "0"))
+	assert.Equal(t, 0, cmp("007", "7.000"))
+	assert.Equal(t, -1, cmp("9.99", "10"))
+	assert.Equal(t, 1, cmp("10.01", "10.009"))
+	assert.Equal(t, -1, cmp("-10", "-9.99"))
+	assert.Equal(t, 1, cmp("0.1", "-100"))
+	assert.Equal(t, 1, cmp("100000000000000000000000000000.01", "100000000000000000000000000000"))
+}
+
+func TestValidatorDecimalNot(t *testing.T) {
+
+	v := Is(Decimal("-10.00").Not().Positive())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorDecimalValid(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Decimal("1234.50").Valid())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Decimal("1.2e3", "amount").Valid())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Amount must be a decimal number",
+		v.Errors()["amount"].Messages()[0])
+
+	type Amount string
+	v = Is(Decimal(Amount("10")).Valid())
+	assert.True(t, v.Valid())
+}
+
+func TestValidatorDecimalMaxPrecision(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Decimal("1234.50").MaxPrecision(6))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Decimal("-0001234.50").MaxPrecision(6))
+	assert.True(t, v.Valid())
+
+	v = Is(Decimal("0.05").MaxPrecision(2))
+	assert.True(t, v.Valid())
+
+	v = Is(Decimal("12345.50").MaxPrecision(6))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must not have more than \"6\" digits",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDecimalScale(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Decimal("1234.5").MaxScale(2))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Decimal("1234.500").MaxScale(2))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must not have more than \"2\" decimal places",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Decimal("1234.50").Scale(2))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Decimal("1234").Scale(0))
+	assert.True(t, v.Valid())
+
+	v = Is(Decimal("1234.5").Scale(2))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must have exactly \"2\" decimal places",
+		v.Errors()["value_0"].Messages()[0])
+
+	// Invalid decimals don't have a scale
+	v = Is(Decimal("12.3x").MaxScale(2))
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorDecimalBetween(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Decimal("10.50").Between("0.01", "999999.99"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Decimal("0.010").Between("0.01", "999999.99"))
+	assert.True(t, v.Valid())
+
+	v = Is(Decimal("-5").Between("-10", "-5.00"))
+	assert.True(t, v.Valid())
+
+	// Values that lose precision as float64 are still compared exactly
+	v = Is(Decimal("9007199254740993").Between("0", "9007199254740992"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be between \"0\" and \"9007199254740992\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Decimal("0.009").Between("0.01", "999999.99"))
+	assert.False(t, v.Valid())
+
+	// Invalid bounds never match
+	v = Is(Decimal("1").Between("0", "ten"))
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorDecimalPositiveAndNonNegative(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Decimal("0.01").Positive())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Decimal("-0.00").Positive())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be positive",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Decimal("-0.00").NonNegative())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Decimal("-0.01", "balance").NonNegative())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Balance can't be negative",
+		v.Errors()["balance"].Messages()[0])
+}
+
+func TestValidatorDecimalCurrencyMinorUnits(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Decimal("10.50").CurrencyMinorUnits("USD"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Decimal("10.5").CurrencyMinorUnits("eur"))
+	assert.True(t, v.Valid())
+
+	v = Is(Decimal("1000").CurrencyMinorUnits("JPY"))
+	assert.True(t, v.Valid())
+
+	v = Is(Decimal("10.125").CurrencyMinorUnits("KWD"))
+	assert.True(t, v.Valid())
+
+	v = Is(Decimal("10.50", "price").CurrencyMinorUnits("JPY"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Price is not a valid amount for the currency \"JPY\"",
+		v.Errors()["price"].Messages()[0])
+
+	v = Is(Decimal("10.50", "price").CurrencyMinorUnits("XXX"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Price can't be checked against the unknown currency \"XXX\"",
+		v.Errors()["price"].Messages()[0])
+
+	// An unknown currency is reported even after a Not()
+	v = Is(Decimal("10.50", "price").Not().CurrencyMinorUnits("XXX"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Price can't be checked against the unknown currency \"XXX\"",
+		v.Errors()["price"].Messages()[0])
+
+	v = Is(Decimal("10.505").CurrencyMinorUnits("USD", "{{title}} allows {{units}} decimals in {{currency}}"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 allows 2 decimals in USD",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDecimalPassing(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Decimal("10").Passing(func(v string) bool { return v != "0" }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Decimal("0").Passing(func(v string) bool { return v != "0" }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDecimalLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs}).Is(Decimal("1.234", "importe").MaxScale(2))
+	assert.Equal(t,
+		"Importe no debe tener más de \"2\" decimales",
+		v.Errors()["importe"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeHu}).Is(Decimal("1.5", "ar").Scale(2))
+	assert.Equal(t,
+		"Ar pontosan \"2\" tizedesjegyet kell tartalmazzon",
+		v.Errors()["ar"].Messages()[0])
+}
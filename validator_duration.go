@@ -0,0 +1,370 @@
+package valgo
+
+import (
+	"time"
+)
+
+func isDurationEqualTo(v0 time.Duration, v1 time.Duration) bool {
+	return v0 == v1
+}
+
+func isDurationGreaterThan(v0 time.Duration, v1 time.Duration) bool {
+	return v0 > v1
+}
+
+func isDurationGreaterOrEqualTo(v0 time.Duration, v1 time.Duration) bool {
+	return v0 >= v1
+}
+
+func isDurationLessThan(v0 time.Duration, v1 time.Duration) bool {
+	return v0 < v1
+}
+
+func isDurationLessOrEqualTo(v0 time.Duration, v1 time.Duration) bool {
+	return v0 <= v1
+}
+
+func isDurationBetween(v time.Duration, min time.Duration, max time.Duration) bool {
+	return v >= min && v <= max
+}
+
+func isDurationZero(v time.Duration) bool {
+	return v == 0
+}
+
+func isDurationPositive(v time.Duration) bool {
+	return v > 0
+}
+
+func isDurationNegative(v time.Duration) bool {
+	return v < 0
+}
+
+func isDurationMultipleOf(v time.Duration, d time.Duration) bool {
+	// A zero duration has no multiples other than itself
+	if d == 0 {
+		return v == 0
+	}
+	return v%d == 0
+}
+
+func isDurationTruncated(v time.Duration, unit time.Duration) bool {
+	return v.Truncate(unit) == v
+}
+
+func isDurationInSlice(v time.Duration, slice []time.Duration) bool {
+	for _, _v := range slice {
+		if v == _v {
+			return true
+		}
+	}
+	return false
+}
+
+// The `ValidatorDuration` structure provides a set of methods to perform
+// validation checks on time.Duration values. Unlike validating a duration with
+// the [Int64] validator, the values in the error messages are displayed in the
+// duration format, for example "5m0s" instead of "300000000000".
+type ValidatorDuration struct {
+	context *ValidatorContext
+}
+
+// The Duration function initiates a new `ValidatorDuration` instance to
+// validate a given duration value. The optional name and title parameters can
+// be used for enhanced error reporting. If a name is provided without a title,
+// the name is humanized to be used as the title.
+//
+// For example:
+//
+//	timeout := 30 * time.Second
+//	v.Duration(timeout, "timeout", "Request timeout")
+func Duration(value time.Duration, nameAndTitle ...string) *ValidatorDuration {
+	return &ValidatorDuration{context: NewContext(value, nameAndTitle...)}
+}
+
+// The Context method returns the current context of the validator, which can
+// be utilized to create custom validations by extending this validator.
+func (validator *ValidatorDuration) Context() *ValidatorContext {
+	return validator.context
+}
+
+// The Not method inverts the boolean value associated with the next validator
+// method. This can be used to negate the check performed by the next validation
+// method in the chain.
+//
+// For example:
+//
+//	// Will return false because Not() inverts the boolean value of the Zero() function
+//	Is(v.Duration(time.Second).Not().Zero()).Valid()
+func (validator *ValidatorDuration) Not() *ValidatorDuration {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the duration is Zero (Zero() OR GreaterThan(time.Second)).
+//	d := time.Duration(0)
+//	isValid := v.Is(v.Duration(d).Zero().Or().GreaterThan(time.Second)).Valid()
+func (validator *ValidatorDuration) Or() *ValidatorDuration {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the duration is Zero, the chain succeeds and Between/Truncated are not evaluated.
+//	// Otherwise, the duration must be Between(1s, 1m) AND Truncated(time.Second).
+//	d := time.Duration(0)
+//	isValid := v.Is(v.Duration(d).Zero().OrElse().Between(time.Second, time.Minute).Truncated(time.Second)).Valid()
+func (validator *ValidatorDuration) OrElse() *ValidatorDuration {
+	validator.context.OrElse()
+	return validator
+}
+
+// The EqualTo method validates if the duration value is equal to another given
+// duration value.
+//
+// For example:
+//
+//	Is(v.Duration(time.Minute).EqualTo(60 * time.Second)).Valid()
+func (validator *ValidatorDuration) EqualTo(value time.Duration, template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationEqualTo(validator.context.Value().(time.Duration), value)
+		},
+		ErrorKeyEqualTo, value, template...)
+
+	return validator
+}
+
+// The GreaterThan method checks if the duration value is greater than a
+// specified duration.
+//
+// For example:
+//
+//	Is(v.Duration(time.Minute).GreaterThan(30 * time.Second)).Valid()
+func (validator *ValidatorDuration) GreaterThan(value time.Duration, template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationGreaterThan(validator.context.Value().(time.Duration), value)
+		},
+		ErrorKeyGreaterThan, value, template...)
+
+	return validator
+}
+
+// The GreaterOrEqualTo method checks if the duration value is greater than or
+// equal to a specified duration.
+//
+// For example:
+//
+//	Is(v.Duration(time.Minute).GreaterOrEqualTo(time.Minute)).Valid()
+func (validator *ValidatorDuration) GreaterOrEqualTo(value time.Duration, template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationGreaterOrEqualTo(validator.context.Value().(time.Duration), value)
+		},
+		ErrorKeyGreaterOrEqualTo, value, template...)
+
+	return validator
+}
+
+// The LessThan method checks if the duration value is less than a specified
+// duration.
+//
+// For example:
+//
+//	Is(v.Duration(30 * time.Second).LessThan(time.Minute)).Valid()
+func (validator *ValidatorDuration) LessThan(value time.Duration, template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationLessThan(validator.context.Value().(time.Duration), value)
+		},
+		ErrorKeyLessThan, value, template...)
+
+	return validator
+}
+
+// The LessOrEqualTo method checks if the duration value is less than or equal
+// to a specified duration.
+//
+// For example:
+//
+//	Is(v.Duration(time.Minute).LessOrEqualTo(time.Minute)).Valid()
+func (validator *ValidatorDuration) LessOrEqualTo(value time.Duration, template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationLessOrEqualTo(validator.context.Value().(time.Duration), value)
+		},
+		ErrorKeyLessOrEqualTo, value, template...)
+
+	return validator
+}
+
+// The Between method verifies if the duration value falls within a given range,
+// inclusive.
+//
+// For example:
+//
+//	Is(v.Duration(time.Minute).Between(time.Second, time.Hour)).Valid()
+func (validator *ValidatorDuration) Between(min time.Duration, max time.Duration, template ...string) *ValidatorDuration {
+	validator.context.AddWithParams(
+		func() bool {
+			return isDurationBetween(validator.context.Value().(time.Duration), min, max)
+		},
+		ErrorKeyBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max},
+		template...)
+
+	return validator
+}
+
+// The Zero method verifies if the duration value is zero.
+//
+// For example:
+//
+//	Is(v.Duration(0).Zero()).Valid()
+func (validator *ValidatorDuration) Zero(template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationZero(validator.context.Value().(time.Duration))
+		},
+		ErrorKeyZero, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The Positive method verifies if the duration value is greater than zero.
+//
+// For example:
+//
+//	Is(v.Duration(time.Second).Positive()).Valid()
+func (validator *ValidatorDuration) Positive(template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationPositive(validator.context.Value().(time.Duration))
+		},
+		ErrorKeyPositive, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The Negative method verifies if the duration value is less than zero.
+//
+// For example:
+//
+//	Is(v.Duration(-time.Second).Negative()).Valid()
+func (validator *ValidatorDuration) Negative(template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationNegative(validator.context.Value().(time.Duration))
+		},
+		ErrorKeyNegative, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The MultipleOf method verifies if the duration value is a multiple of the
+// specified duration. Only zero is a multiple of a zero duration.
+//
+// For example:
+//
+//	// Backoff steps of 250ms
+//	Is(v.Duration(750 * time.Millisecond).MultipleOf(250 * time.Millisecond)).Valid()
+func (validator *ValidatorDuration) MultipleOf(value time.Duration, template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationMultipleOf(validator.context.Value().(time.Duration), value)
+		},
+		ErrorKeyMultipleOf, value, template...)
+
+	return validator
+}
+
+// The Truncated method verifies if the duration value has no precision finer
+// than the specified unit, so truncating the duration to the unit doesn't
+// modify it. A unit less than or equal to zero is always valid, as in
+// [time.Duration.Truncate].
+//
+// For example:
+//
+//	Is(v.Duration(90 * time.Second).Truncated(time.Second)).Valid() // Will be true
+//	Is(v.Duration(1500 * time.Millisecond).Truncated(time.Second)).Valid() // Will be false
+func (validator *ValidatorDuration) Truncated(unit time.Duration, template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationTruncated(validator.context.Value().(time.Duration), unit)
+		},
+		ErrorKeyTruncated, unit, template...)
+
+	return validator
+}
+
+// The Passing method allows for custom validation logic by accepting a function
+// that returns a boolean indicating whether the validation passed or failed.
+//
+// For example:
+//
+//	Is(v.Duration(time.Minute).Passing(func(d time.Duration) bool {
+//	    return d.Seconds() <= 60
+//	})).Valid()
+func (validator *ValidatorDuration) Passing(function func(v0 time.Duration) bool, template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(time.Duration))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The InSlice method validates if the duration value is found within a provided
+// slice of duration values.
+//
+// For example:
+//
+//	ttls := []time.Duration{time.Minute, time.Hour}
+//	Is(v.Duration(time.Hour).InSlice(ttls)).Valid()
+func (validator *ValidatorDuration) InSlice(slice []time.Duration, template ...string) *ValidatorDuration {
+	validator.context.AddWithValue(
+		func() bool {
+			return isDurationInSlice(validator.context.Value().(time.Duration), slice)
+		},
+		ErrorKeyInSlice, validator.context.Value(), template...)
+
+	return validator
+}
@@ -0,0 +1,359 @@
+package valgo
+
+import (
+	"time"
+)
+
+// ValidatorDurationP is a type that facilitates validation for duration pointer
+// variables. It retains a context that records details about the validation
+// process.
+type ValidatorDurationP struct {
+	context *ValidatorContext
+}
+
+// DurationP initializes a new ValidatorDurationP instance with the provided
+// duration pointer and optional name and title arguments for detailed error
+// messages.
+//
+// Usage example:
+//
+//	var timeout *time.Duration
+//	v.DurationP(timeout, "timeout", "Request timeout")
+func DurationP(value *time.Duration, nameAndTitle ...string) *ValidatorDurationP {
+	return &ValidatorDurationP{context: NewContext(value, nameAndTitle...)}
+}
+
+// The Context method returns the current context of the validator, which can
+// be utilized to create custom validations by extending this validator.
+func (validator *ValidatorDurationP) Context() *ValidatorContext {
+	return validator.context
+}
+
+// The Not method inverts the boolean value associated with the next validator
+// method. This can be used to negate the check performed by the next validation
+// method in the chain.
+//
+// For example:
+//
+//	// Will return false because Not() inverts the boolean value of the Zero() function
+//	d := time.Second
+//	Is(v.DurationP(&d).Not().Zero()).Valid()
+func (validator *ValidatorDurationP) Not() *ValidatorDurationP {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the duration is Zero (Zero() OR GreaterThan(time.Second)).
+//	d := time.Duration(0)
+//	isValid := v.Is(v.DurationP(&d).Zero().Or().GreaterThan(time.Second)).Valid()
+func (validator *ValidatorDurationP) Or() *ValidatorDurationP {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the duration is Zero, the chain succeeds and Between/Truncated are not evaluated.
+//	// Otherwise, the duration must be Between(1s, 1m) AND Truncated(time.Second).
+//	d := time.Duration(0)
+//	isValid := v.Is(v.DurationP(&d).Zero().OrElse().Between(time.Second, time.Minute).Truncated(time.Second)).Valid()
+func (validator *ValidatorDurationP) OrElse() *ValidatorDurationP {
+	validator.context.OrElse()
+	return validator
+}
+
+// The EqualTo method validates if the duration value is equal to another given
+// duration value.
+//
+// For example:
+//
+//	d := time.Minute
+//	Is(v.DurationP(&d).EqualTo(60 * time.Second)).Valid()
+func (validator *ValidatorDurationP) EqualTo(value time.Duration, template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationEqualTo(*(validator.context.Value().(*time.Duration)), value)
+		},
+		ErrorKeyEqualTo, value, template...)
+
+	return validator
+}
+
+// The GreaterThan method checks if the duration value is greater than a
+// specified duration.
+//
+// For example:
+//
+//	d := time.Minute
+//	Is(v.DurationP(&d).GreaterThan(30 * time.Second)).Valid()
+func (validator *ValidatorDurationP) GreaterThan(value time.Duration, template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationGreaterThan(*(validator.context.Value().(*time.Duration)), value)
+		},
+		ErrorKeyGreaterThan, value, template...)
+
+	return validator
+}
+
+// The GreaterOrEqualTo method checks if the duration value is greater than or
+// equal to a specified duration.
+//
+// For example:
+//
+//	d := time.Minute
+//	Is(v.DurationP(&d).GreaterOrEqualTo(time.Minute)).Valid()
+func (validator *ValidatorDurationP) GreaterOrEqualTo(value time.Duration, template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationGreaterOrEqualTo(*(validator.context.Value().(*time.Duration)), value)
+		},
+		ErrorKeyGreaterOrEqualTo, value, template...)
+
+	return validator
+}
+
+// The LessThan method checks if the duration value is less than a specified
+// duration.
+//
+// For example:
+//
+//	d := 30 * time.Second
+//	Is(v.DurationP(&d).LessThan(time.Minute)).Valid()
+func (validator *ValidatorDurationP) LessThan(value time.Duration, template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationLessThan(*(validator.context.Value().(*time.Duration)), value)
+		},
+		ErrorKeyLessThan, value, template...)
+
+	return validator
+}
+
+// The LessOrEqualTo method checks if the duration value is less than or equal
+// to a specified duration.
+//
+// For example:
+//
+//	d := time.Minute
+//	Is(v.DurationP(&d).LessOrEqualTo(time.Minute)).Valid()
+func (validator *ValidatorDurationP) LessOrEqualTo(value time.Duration, template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationLessOrEqualTo(*(validator.context.Value().(*time.Duration)), value)
+		},
+		ErrorKeyLessOrEqualTo, value, template...)
+
+	return validator
+}
+
+// The Between method verifies if the duration value falls within a given range,
+// inclusive.
+//
+// For example:
+//
+//	d := time.Minute
+//	Is(v.DurationP(&d).Between(time.Second, time.Hour)).Valid()
+func (validator *ValidatorDurationP) Between(min time.Duration, max time.Duration, template ...string) *ValidatorDurationP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationBetween(*(validator.context.Value().(*time.Duration)), min, max)
+		},
+		ErrorKeyBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max},
+		template...)
+
+	return validator
+}
+
+// The Zero method verifies if the duration value is zero.
+//
+// For example:
+//
+//	d := time.Duration(0)
+//	Is(v.DurationP(&d).Zero()).Valid()
+func (validator *ValidatorDurationP) Zero(template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationZero(*(validator.context.Value().(*time.Duration)))
+		},
+		ErrorKeyZero, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The Positive method verifies if the duration value is greater than zero.
+//
+// For example:
+//
+//	d := time.Second
+//	Is(v.DurationP(&d).Positive()).Valid()
+func (validator *ValidatorDurationP) Positive(template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationPositive(*(validator.context.Value().(*time.Duration)))
+		},
+		ErrorKeyPositive, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The Negative method verifies if the duration value is less than zero.
+//
+// For example:
+//
+//	d := -time.Second
+//	Is(v.DurationP(&d).Negative()).Valid()
+func (validator *ValidatorDurationP) Negative(template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationNegative(*(validator.context.Value().(*time.Duration)))
+		},
+		ErrorKeyNegative, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The MultipleOf method verifies if the duration value is a multiple of the
+// specified duration. Only zero is a multiple of a zero duration.
+//
+// For example:
+//
+//	// Backoff steps of 250ms
+//	d := 750 * time.Millisecond
+//	Is(v.DurationP(&d).MultipleOf(250 * time.Millisecond)).Valid()
+func (validator *ValidatorDurationP) MultipleOf(value time.Duration, template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationMultipleOf(*(validator.context.Value().(*time.Duration)), value)
+		},
+		ErrorKeyMultipleOf, value, template...)
+
+	return validator
+}
+
+// The Truncated method verifies if the duration value has no precision finer
+// than the specified unit, so truncating the duration to the unit doesn't
+// modify it. A unit less than or equal to zero is always valid, as in
+// [time.Duration.Truncate].
+//
+// For example:
+//
+//	d := 90 * time.Second
+//	Is(v.DurationP(&d).Truncated(time.Second)).Valid() // Will be true
+//	d = 1500 * time.Millisecond
+//	Is(v.DurationP(&d).Truncated(time.Second)).Valid() // Will be false
+func (validator *ValidatorDurationP) Truncated(unit time.Duration, template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationTruncated(*(validator.context.Value().(*time.Duration)), unit)
+		},
+		ErrorKeyTruncated, unit, template...)
+
+	return validator
+}
+
+// The Passing method allows for custom validation logic by accepting a function
+// that returns a boolean indicating whether the validation passed or failed.
+//
+// For example:
+//
+//	d := time.Minute
+//	Is(v.DurationP(&d).Passing(func(d0 *time.Duration) bool {
+//	    return d0 != nil && d0.Seconds() <= 60
+//	})).Valid()
+func (validator *ValidatorDurationP) Passing(function func(v0 *time.Duration) bool, template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(*time.Duration))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The InSlice method validates if the duration value is found within a provided
+// slice of duration values.
+//
+// For example:
+//
+//	ttls := []time.Duration{time.Minute, time.Hour}
+//	d := time.Hour
+//	Is(v.DurationP(&d).InSlice(ttls)).Valid()
+func (validator *ValidatorDurationP) InSlice(slice []time.Duration, template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) != nil && isDurationInSlice(*(validator.context.Value().(*time.Duration)), slice)
+		},
+		ErrorKeyInSlice, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The Nil method validates that the duration pointer is nil.
+//
+// For example:
+//
+//	var d *time.Duration
+//	Is(v.DurationP(d).Nil()).Valid() // Will return true as d is nil.
+func (validator *ValidatorDurationP) Nil(template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) == nil
+		},
+		ErrorKeyNil, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The ZeroOrNil method validates that the duration pointer is either nil or
+// pointing to a zero duration.
+//
+// For example:
+//
+//	var d *time.Duration
+//	Is(v.DurationP(d).ZeroOrNil()).Valid() // Will return true as d is nil.
+func (validator *ValidatorDurationP) ZeroOrNil(template ...string) *ValidatorDurationP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Duration) == nil || isDurationZero(*(validator.context.Value().(*time.Duration)))
+		},
+		ErrorKeyZero, validator.context.Value(), template...)
+
+	return validator
+}
@@ -0,0 +1,115 @@
+package valgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorDurationPNot(t *testing.T) {
+
+	d := time.Second
+
+	v := Is(DurationP(&d).Not().Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorDurationPComparisons(t *testing.T) {
+
+	var v *Validation
+
+	d := 10 * time.Minute
+
+	v = Is(DurationP(&d).
+		EqualTo(10*time.Minute).
+		GreaterThan(5*time.Minute).
+		GreaterOrEqualTo(10*time.Minute).
+		LessThan(time.Hour).
+		LessOrEqualTo(10*time.Minute).
+		Between(time.Minute, time.Hour).
+		Positive().
+		MultipleOf(time.Minute).
+		Truncated(time.Second).
+		InSlice([]time.Duration{10 * time.Minute}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(DurationP(&d, "timeout").GreaterThan(time.Hour))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Timeout must be greater than \"1h0m0s\"",
+		v.Errors()["timeout"].Messages()[0])
+}
+
+func TestValidatorDurationPNilValue(t *testing.T) {
+
+	var v *Validation
+
+	var d *time.Duration
+
+	v = Is(DurationP(d).GreaterThan(time.Second))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be greater than \"1s\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(DurationP(d).Negative())
+	assert.False(t, v.Valid())
+
+	v = Is(DurationP(d).MultipleOf(time.Second))
+	assert.False(t, v.Valid())
+
+	v = Is(DurationP(d).Truncated(time.Second))
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorDurationPNil(t *testing.T) {
+
+	var v *Validation
+
+	var d *time.Duration
+	v = Is(DurationP(d).Nil())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	_d := time.Second
+	v = Is(DurationP(&_d).Nil())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be nil",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationPZeroOrNil(t *testing.T) {
+
+	var v *Validation
+
+	var d *time.Duration
+	v = Is(DurationP(d).ZeroOrNil())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	_d := time.Duration(0)
+	v = Is(DurationP(&_d).ZeroOrNil())
+	assert.True(t, v.Valid())
+
+	_d = time.Second
+	v = Is(DurationP(&_d).ZeroOrNil())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be zero",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationPPassing(t *testing.T) {
+
+	var v *Validation
+
+	d := time.Minute
+
+	v = Is(DurationP(&d).Passing(func(d0 *time.Duration) bool { return d0 != nil && d0.Seconds() == 60 }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
@@ -0,0 +1,232 @@
+package valgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorDurationNot(t *testing.T) {
+
+	v := Is(Duration(time.Second).Not().Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorDurationEqualTo(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(time.Minute).EqualTo(60 * time.Second))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(time.Minute).EqualTo(5 * time.Minute))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be equal to \"5m0s\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationGreaterThan(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(10 * time.Minute).GreaterThan(5 * time.Minute))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(5*time.Minute, "timeout").GreaterThan(5 * time.Minute))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Timeout must be greater than \"5m0s\"",
+		v.Errors()["timeout"].Messages()[0])
+}
+
+func TestValidatorDurationGreaterOrEqualTo(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(5 * time.Minute).GreaterOrEqualTo(5 * time.Minute))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(time.Minute).GreaterOrEqualTo(90 * time.Second))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be greater than or equal to \"1m30s\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationLessThan(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(time.Second).LessThan(time.Minute))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(time.Hour).LessThan(time.Minute))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be less than \"1m0s\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationLessOrEqualTo(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(time.Minute).LessOrEqualTo(time.Minute))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(time.Hour).LessOrEqualTo(250 * time.Millisecond))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be less than or equal to \"250ms\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationBetween(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(time.Minute).Between(time.Second, time.Hour))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(time.Second).Between(time.Second, time.Hour))
+	assert.True(t, v.Valid())
+
+	v = Is(Duration(2*time.Hour).Between(time.Second, time.Hour))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be between \"1s\" and \"1h0m0s\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationZero(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(0).Zero())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(time.Second).Zero())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be zero",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationPositiveAndNegative(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(time.Second).Positive())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(0).Positive())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be positive",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Duration(-time.Second).Negative())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(time.Second).Negative())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be negative",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationMultipleOf(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(750 * time.Millisecond).MultipleOf(250 * time.Millisecond))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(0).MultipleOf(0))
+	assert.True(t, v.Valid())
+
+	v = Is(Duration(time.Second).MultipleOf(0))
+	assert.False(t, v.Valid())
+
+	v = Is(Duration(800*time.Millisecond, "backoff").MultipleOf(250 * time.Millisecond))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Backoff must be a multiple of \"250ms\"",
+		v.Errors()["backoff"].Messages()[0])
+}
+
+func TestValidatorDurationTruncated(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(90 * time.Second).Truncated(time.Second))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(1500 * time.Millisecond).Truncated(0))
+	assert.True(t, v.Valid())
+
+	v = Is(Duration(1500*time.Millisecond, "ttl", "TTL").Truncated(time.Second))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"TTL can't be more precise than \"1s\"",
+		v.Errors()["ttl"].Messages()[0])
+}
+
+func TestValidatorDurationPassing(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Duration(time.Minute).Passing(func(d time.Duration) bool { return d.Seconds() == 60 }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(time.Minute).Passing(func(d time.Duration) bool { return d.Seconds() == 30 }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationInSlice(t *testing.T) {
+
+	var v *Validation
+
+	ttls := []time.Duration{time.Minute, time.Hour}
+
+	v = Is(Duration(time.Hour).InSlice(ttls))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Duration(time.Second).InSlice(ttls))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorDurationLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs}).Is(Duration(time.Second, "timeout").GreaterThan(5 * time.Minute))
+	assert.Equal(t,
+		"Timeout debe ser mayor que \"5m0s\"",
+		v.Errors()["timeout"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeDe}).Is(Duration(time.Second, "timeout").MultipleOf(time.Minute))
+	assert.Equal(t,
+		"Timeout muss ein Vielfaches von \"1m0s\" sein",
+		v.Errors()["timeout"].Messages()[0])
+}
@@ -327,3 +327,75 @@ func (validator *ValidatorFloat[T]) Finite(template ...string) *ValidatorFloat[T
 
 	return validator
 }
+
+// Validate if a numeric value is a multiple of another, tolerating the rounding
+// error of floating point numbers, so 0.3 is a multiple of 0.1. Zero is only a
+// multiple of itself.
+//
+// For example:
+//
+//	Is(v.Float64(0.3).MultipleOf(0.1))
+func (validator *ValidatorFloat[T]) MultipleOf(value T, template ...string) *ValidatorFloat[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isFloatMultipleOf(float64(validator.context.Value().(T)), float64(value), numberBitSize[T]())
+		},
+		ErrorKeyMultipleOf, value, template...)
+
+	return validator
+}
+
+// Validate if a numeric value has at most a number of decimal places in its
+// shortest decimal representation. NaN and infinite values are not valid.
+//
+// For example:
+//
+//	Is(v.Float64(19.99).DecimalPlaces(2))
+func (validator *ValidatorFloat[T]) DecimalPlaces(max int, template ...string) *ValidatorFloat[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isFloatDecimalPlaces(float64(validator.context.Value().(T)), max, numberBitSize[T]())
+		},
+		ErrorKeyMaxScale,
+		map[string]any{"title": validator.context.title, "scale": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is equal to another within a tolerance, that is,
+// if the absolute difference between them is less than or equal to epsilon.
+//
+// For example:
+//
+//	Is(v.Float64(0.30000000000000004).EqualWithin(0.3, 1e-9))
+func (validator *ValidatorFloat[T]) EqualWithin(value T, epsilon T, template ...string) *ValidatorFloat[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isFloatEqualWithin(float64(validator.context.Value().(T)), float64(value), float64(epsilon))
+		},
+		ErrorKeyEqualWithin,
+		map[string]any{"title": validator.context.title, "value": value, "epsilon": epsilon},
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value can be converted to another numeric type without
+// overflowing or losing a fractional part. Floating point types accept any
+// value within their range, even when it is rounded.
+//
+// For example:
+//
+//	Is(v.Float64(42).FitsIn(v.NumberTypeOf[int32]()))
+func (validator *ValidatorFloat[T]) FitsIn(t NumberType, template ...string) *ValidatorFloat[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isNumberFitsIn(validator.context.Value().(T), t)
+		},
+		ErrorKeyFitsIn,
+		map[string]any{"title": validator.context.title, "type": t.String(), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
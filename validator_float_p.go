@@ -370,3 +370,80 @@ func (validator *ValidatorFloatP[T]) Finite(template ...string) *ValidatorFloatP
 
 	return validator
 }
+
+// Validate if a numeric pointer value is a multiple of another, tolerating the
+// rounding error of floating point numbers, so 0.3 is a multiple of 0.1. Zero
+// is only a multiple of itself.
+//
+// For example:
+//
+//	n := float64(0.3)
+//	Is(v.Float64P(&n).MultipleOf(0.1))
+func (validator *ValidatorFloatP[T]) MultipleOf(value T, template ...string) *ValidatorFloatP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isFloatMultipleOf(float64(*(validator.context.Value().(*T))), float64(value), numberBitSize[T]())
+		},
+		ErrorKeyMultipleOf, value, template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value has at most a number of decimal places in
+// its shortest decimal representation. NaN and infinite values are not valid.
+//
+// For example:
+//
+//	n := float64(19.99)
+//	Is(v.Float64P(&n).DecimalPlaces(2))
+func (validator *ValidatorFloatP[T]) DecimalPlaces(max int, template ...string) *ValidatorFloatP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isFloatDecimalPlaces(float64(*(validator.context.Value().(*T))), max, numberBitSize[T]())
+		},
+		ErrorKeyMaxScale,
+		map[string]any{"title": validator.context.title, "scale": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is equal to another within a tolerance,
+// that is, if the absolute difference between them is less than or equal to
+// epsilon.
+//
+// For example:
+//
+//	n := float64(0.30000000000000004)
+//	Is(v.Float64P(&n).EqualWithin(0.3, 1e-9))
+func (validator *ValidatorFloatP[T]) EqualWithin(value T, epsilon T, template ...string) *ValidatorFloatP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isFloatEqualWithin(float64(*(validator.context.Value().(*T))), float64(value), float64(epsilon))
+		},
+		ErrorKeyEqualWithin,
+		map[string]any{"title": validator.context.title, "value": value, "epsilon": epsilon},
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value can be converted to another numeric type
+// without overflowing or losing a fractional part. Floating point types accept
+// any value within their range, even when it is rounded.
+//
+// For example:
+//
+//	n := float64(42)
+//	Is(v.Float64P(&n).FitsIn(v.NumberTypeOf[int32]()))
+func (validator *ValidatorFloatP[T]) FitsIn(t NumberType, template ...string) *ValidatorFloatP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isNumberFitsIn(*(validator.context.Value().(*T)), t)
+		},
+		ErrorKeyFitsIn,
+		map[string]any{"title": validator.context.title, "type": t.String(), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
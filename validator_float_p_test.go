@@ -940,3 +940,26 @@ func TestValidatorFloat64PFiniteInvalid(t *testing.T) {
 		"Value 0 must be finite",
 		v.Errors()["value_0"].Messages()[0])
 }
+
+func TestValidatorFloatPPrecisionAndFitsIn(t *testing.T) {
+
+	var v *Validation
+
+	price := 19.95
+	v = Is(Float64P(&price).MultipleOf(0.05).DecimalPlaces(2).EqualWithin(20, 0.1).FitsIn(NumberTypeOf[float64]()))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Float64P(&price, "price").DecimalPlaces(1))
+	assert.Equal(t,
+		"Price must not have more than \"1\" decimal places",
+		v.Errors()["price"].Messages()[0])
+
+	var nilFloat *float64
+	for _, validator := range []*ValidatorFloatP[float64]{
+		Float64P(nilFloat).MultipleOf(1), Float64P(nilFloat).DecimalPlaces(2),
+		Float64P(nilFloat).EqualWithin(0, math.Inf(1)), Float64P(nilFloat).FitsIn(NumberTypeOf[float64]()),
+	} {
+		assert.False(t, Is(validator).Valid())
+	}
+}
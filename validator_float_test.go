@@ -761,3 +761,102 @@ func TestValidatorFloat64FiniteInvalid(t *testing.T) {
 		"Value 0 must be finite",
 		v.Errors()["value_0"].Messages()[0])
 }
+
+func TestValidatorFloatMultipleOf(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Float64(float64(0.3)).MultipleOf(0.1)).
+		Is(Float64(float64(0.7)).MultipleOf(0.1)).
+		Is(Float64(float64(-2.5)).MultipleOf(0.5)).
+		Is(Float32(float32(0.3)).MultipleOf(0.1)).
+		Is(Float64(float64(1e15)).MultipleOf(0.25)).
+		Is(Float64(float64(0)).MultipleOf(0))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, f := range []float64{0.35, 1e-12, math.NaN(), math.Inf(1)} {
+		assert.False(t, Is(Float64(float64(f)).MultipleOf(0.1)).Valid(), f)
+	}
+	assert.False(t, Is(Float64(float64(1)).MultipleOf(0)).Valid())
+
+	v = Is(Float64(float64(0.35), "step").MultipleOf(0.1))
+	assert.Equal(t,
+		"Step must be a multiple of \"0.1\"",
+		v.Errors()["step"].Messages()[0])
+}
+
+func TestValidatorFloatDecimalPlaces(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Float64(float64(19.99)).DecimalPlaces(2)).
+		Is(Float64(float64(20)).DecimalPlaces(0)).
+		Is(Float64(float64(-0.5)).DecimalPlaces(1)).
+		Is(Float32(float32(0.1)).DecimalPlaces(1))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	// Not a constant expression, so it is computed with float64 rounding
+	tenth := 0.1
+	assert.False(t, Is(Float64(tenth+0.2).DecimalPlaces(2)).Valid())
+	assert.False(t, Is(Float64(float64(1e-20)).DecimalPlaces(19)).Valid())
+	assert.False(t, Is(Float64(math.NaN()).DecimalPlaces(2)).Valid())
+	assert.False(t, Is(Float64(float64(1)).DecimalPlaces(-1)).Valid())
+
+	v = Is(Float64(float64(19.999), "price").DecimalPlaces(2))
+	assert.Equal(t,
+		"Price must not have more than \"2\" decimal places",
+		v.Errors()["price"].Messages()[0])
+}
+
+func TestValidatorFloatEqualWithin(t *testing.T) {
+
+	var v *Validation
+
+	tenth := 0.1
+	v = Is(Float64(tenth+0.2).EqualWithin(0.3, 1e-9)).
+		Is(Float64(float64(-1.5)).EqualWithin(-1, 0.5)).
+		Is(Float32(float32(2.0001)).EqualWithin(2, 0.001))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.False(t, Is(Float64(math.NaN()).EqualWithin(0, math.Inf(1))).Valid())
+	assert.False(t, Is(Float64(float64(1)).EqualWithin(1, -1)).Valid())
+
+	v = Is(Float64(float64(0.31), "ratio").EqualWithin(0.3, 0.001))
+	assert.Equal(t,
+		"Ratio must be equal to \"0.3\" within \"0.001\"",
+		v.Errors()["ratio"].Messages()[0])
+
+	v = Is(Float64(float64(0.3), "ratio").Not().EqualWithin(0.3, 0.001))
+	assert.Equal(t,
+		"Ratio can't be equal to \"0.3\" within \"0.001\"",
+		v.Errors()["ratio"].Messages()[0])
+}
+
+func TestValidatorFloatFitsIn(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Float64(float64(42)).FitsIn(NumberTypeOf[int32]())).
+		Is(Float64(float64(-128)).FitsIn(NumberTypeOf[int8]())).
+		Is(Float64(float64(0.5)).FitsIn(NumberTypeOf[float32]())).
+		Is(Float64(math.Inf(-1)).FitsIn(NumberTypeOf[float32]())).
+		Is(Float64(math.NaN()).FitsIn(NumberTypeOf[float64]()))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, f := range []float64{42.5, 2147483648, math.NaN(), math.Inf(1)} {
+		assert.False(t, Is(Float64(float64(f)).FitsIn(NumberTypeOf[int32]())).Valid(), f)
+	}
+	assert.False(t, Is(Float64(float64(1e39)).FitsIn(NumberTypeOf[float32]())).Valid())
+	assert.False(t, Is(Float64(float64(0.1)).FitsIn(NumberTypeOf[float32]())).Valid())
+	assert.False(t, Is(Float64(float64(1e-50)).FitsIn(NumberTypeOf[float32]())).Valid())
+	assert.False(t, Is(Float64(float64(0)).FitsIn(NumberType{})).Valid())
+
+	v = Is(Float64(float64(42.5), "quantity").FitsIn(NumberTypeOf[int]()))
+	assert.Equal(t,
+		"Quantity must fit in the \"int\" type",
+		v.Errors()["quantity"].Messages()[0])
+}
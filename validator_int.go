@@ -336,3 +336,83 @@ func (validator *ValidatorInt[T]) InSlice(slice []T, template ...string) *Valida
 
 	return validator
 }
+
+// Validate if a numeric value is a multiple of another. Zero is only a multiple
+// of itself.
+//
+// For example:
+//
+//	Is(v.Int(12).MultipleOf(4))
+func (validator *ValidatorInt[T]) MultipleOf(value T, template ...string) *ValidatorInt[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isIntegerMultipleOf(validator.context.Value().(T), value)
+		},
+		ErrorKeyMultipleOf, value, template...)
+
+	return validator
+}
+
+// Validate if a numeric value is an even number.
+//
+// For example:
+//
+//	Is(v.Int(4).Even())
+func (validator *ValidatorInt[T]) Even(template ...string) *ValidatorInt[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isIntegerEven(validator.context.Value().(T))
+		},
+		ErrorKeyEven, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric value is an odd number.
+//
+// For example:
+//
+//	Is(v.Int(5).Odd())
+func (validator *ValidatorInt[T]) Odd(template ...string) *ValidatorInt[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isIntegerOdd(validator.context.Value().(T))
+		},
+		ErrorKeyOdd, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric value is a power of two, such as 1, 2, 4 or 1024.
+//
+// For example:
+//
+//	Is(v.Int(64).PowerOfTwo())
+func (validator *ValidatorInt[T]) PowerOfTwo(template ...string) *ValidatorInt[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isIntegerPowerOfTwo(validator.context.Value().(T))
+		},
+		ErrorKeyPowerOfTwo, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric value can be converted to another numeric type without
+// overflowing or losing a fractional part. It is useful when narrowing values,
+// for example to store an int64 from an API in an int32 column.
+//
+// For example:
+//
+//	Is(v.Int(42).FitsIn(v.NumberTypeOf[int32]()))
+func (validator *ValidatorInt[T]) FitsIn(t NumberType, template ...string) *ValidatorInt[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isNumberFitsIn(validator.context.Value().(T), t)
+		},
+		ErrorKeyFitsIn,
+		map[string]any{"title": validator.context.title, "type": t.String(), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
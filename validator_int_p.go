@@ -376,3 +376,89 @@ func (validator *ValidatorIntP[T]) Nil(template ...string) *ValidatorIntP[T] {
 
 	return validator
 }
+
+// Validate if a numeric pointer value is a multiple of another. Zero is only a
+// multiple of itself.
+//
+// For example:
+//
+//	n := int(12)
+//	Is(v.IntP(&n).MultipleOf(4))
+func (validator *ValidatorIntP[T]) MultipleOf(value T, template ...string) *ValidatorIntP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isIntegerMultipleOf(*(validator.context.Value().(*T)), value)
+		},
+		ErrorKeyMultipleOf, value, template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is an even number.
+//
+// For example:
+//
+//	n := int(4)
+//	Is(v.IntP(&n).Even())
+func (validator *ValidatorIntP[T]) Even(template ...string) *ValidatorIntP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isIntegerEven(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyEven, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is an odd number.
+//
+// For example:
+//
+//	n := int(5)
+//	Is(v.IntP(&n).Odd())
+func (validator *ValidatorIntP[T]) Odd(template ...string) *ValidatorIntP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isIntegerOdd(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyOdd, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is a power of two, such as 1, 2, 4 or
+// 1024.
+//
+// For example:
+//
+//	n := int(64)
+//	Is(v.IntP(&n).PowerOfTwo())
+func (validator *ValidatorIntP[T]) PowerOfTwo(template ...string) *ValidatorIntP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isIntegerPowerOfTwo(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyPowerOfTwo, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value can be converted to another numeric type
+// without overflowing or losing a fractional part. It is useful when narrowing
+// values, for example to store an int64 from an API in an int32 column.
+//
+// For example:
+//
+//	n := int(42)
+//	Is(v.IntP(&n).FitsIn(v.NumberTypeOf[int32]()))
+func (validator *ValidatorIntP[T]) FitsIn(t NumberType, template ...string) *ValidatorIntP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isNumberFitsIn(*(validator.context.Value().(*T)), t)
+		},
+		ErrorKeyFitsIn,
+		map[string]any{"title": validator.context.title, "type": t.String(), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
@@ -911,3 +911,27 @@ func TestValidatorIntPOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorIntPDivisibilityAndFitsIn(t *testing.T) {
+
+	var v *Validation
+
+	n := 64
+	v = Is(IntP(&n).MultipleOf(8).Even().PowerOfTwo().FitsIn(NumberTypeOf[int8]()))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	n = 63
+	v = Is(IntP(&n, "size").Odd().Not().PowerOfTwo()).Is(IntP(&n, "size").Even())
+	assert.Equal(t,
+		"Size must be an even number",
+		v.Errors()["size"].Messages()[0])
+
+	var nilInt *int
+	for _, validator := range []*ValidatorIntP[int]{
+		IntP(nilInt).MultipleOf(1), IntP(nilInt).Even(), IntP(nilInt).Odd(),
+		IntP(nilInt).PowerOfTwo(), IntP(nilInt).FitsIn(NumberTypeOf[int64]()),
+	} {
+		assert.False(t, Is(validator).Valid())
+	}
+}
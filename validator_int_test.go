@@ -1,6 +1,7 @@
 package valgo
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -687,3 +688,86 @@ func TestValidatorIntOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorIntMultipleOfEvenOddAndPowerOfTwo(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Int(12).MultipleOf(4).MultipleOf(-3).Even()).
+		Is(Int(-7).Odd()).
+		Is(Int(0).MultipleOf(0).Even()).
+		Is(Int64(int64(1 << 40)).PowerOfTwo()).
+		Is(Int8(int8(-128)).MultipleOf(-1))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.False(t, Is(Int(12).MultipleOf(0)).Valid())
+	assert.False(t, Is(Int(-8).PowerOfTwo()).Valid())
+	assert.False(t, Is(Int(0).PowerOfTwo()).Valid())
+	assert.False(t, Is(Int(6).PowerOfTwo()).Valid())
+	assert.False(t, Is(Int(-3).Even()).Valid())
+
+	v = Is(Int(10, "batch_size").MultipleOf(4))
+	assert.Equal(t,
+		"Batch size must be a multiple of \"4\"",
+		v.Errors()["batch_size"].Messages()[0])
+
+	v = Is(Int(3, "seats").Even())
+	assert.Equal(t,
+		"Seats must be an even number",
+		v.Errors()["seats"].Messages()[0])
+
+	v = Is(Int(4, "seats").Odd())
+	assert.Equal(t,
+		"Seats must be an odd number",
+		v.Errors()["seats"].Messages()[0])
+
+	v = Is(Int(48, "buffer").PowerOfTwo())
+	assert.Equal(t,
+		"Buffer must be a power of two",
+		v.Errors()["buffer"].Messages()[0])
+
+	v = Is(Int(4, "seats").Not().Even())
+	assert.Equal(t,
+		"Seats can't be an even number",
+		v.Errors()["seats"].Messages()[0])
+}
+
+func TestValidatorIntFitsIn(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Int64(int64(math.MaxInt32)).FitsIn(NumberTypeOf[int32]())).
+		Is(Int64(int64(math.MinInt32)).FitsIn(NumberTypeOf[int32]())).
+		Is(Int64(int64(255)).FitsIn(NumberTypeOf[uint8]())).
+		Is(Int64(int64(math.MaxInt64)).FitsIn(NumberTypeOf[uint64]())).
+		Is(Int64(int64(16_777_216)).FitsIn(NumberTypeOf[float32]())).
+		Is(Int64(int64(1 << 62)).FitsIn(NumberTypeOf[float32]())).
+		Is(Int64(int64(9_007_199_254_740_992)).FitsIn(NumberTypeOf[float64]()))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	// Floating point types only hold integers exactly up to their precision
+	assert.False(t, Is(Int64(int64(16_777_217)).FitsIn(NumberTypeOf[float32]())).Valid())
+	assert.False(t, Is(Int64(int64(9_007_199_254_740_993)).FitsIn(NumberTypeOf[float64]())).Valid())
+	assert.False(t, Is(Int64(int64(math.MaxInt64)).FitsIn(NumberTypeOf[float32]())).Valid())
+
+	// The zero value of NumberType is not a type
+	assert.False(t, Is(Int64(int64(0)).FitsIn(NumberType{})).Valid())
+
+	assert.False(t, Is(Int64(int64(math.MaxInt32+1)).FitsIn(NumberTypeOf[int32]())).Valid())
+	assert.False(t, Is(Int64(int64(math.MinInt32-1)).FitsIn(NumberTypeOf[int32]())).Valid())
+	assert.False(t, Is(Int(-1).FitsIn(NumberTypeOf[uint]())).Valid())
+	assert.False(t, Is(Int(256).FitsIn(NumberTypeOf[byte]())).Valid())
+
+	v = Is(Int64(int64(3_000_000_000), "id").FitsIn(NumberTypeOf[int32]()))
+	assert.Equal(t,
+		"Id must fit in the \"int32\" type",
+		v.Errors()["id"].Messages()[0])
+
+	type Priority int16
+	v = Is(Int(40_000, "priority").FitsIn(NumberTypeOf[Priority]()))
+	assert.Equal(t,
+		"Priority must fit in the \"int16\" type",
+		v.Errors()["priority"].Messages()[0])
+}
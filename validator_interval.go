@@ -0,0 +1,408 @@
+package valgo
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// A TimeInterval is a period of time from a start to an end, such as a booking
+// or a slot of a schedule. The start is included and the end is excluded, so
+// an interval that ends when another starts doesn't overlap with it.
+type TimeInterval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the time elapsed from the start to the end of the interval.
+func (i TimeInterval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+// Overlaps reports whether the interval shares any instant with other.
+func (i TimeInterval) Overlaps(other TimeInterval) bool {
+	return i.Start.Before(other.End) && other.Start.Before(i.End)
+}
+
+// Report if the wall clock time of v, in its own location, is a multiple of d
+// since midnight. Durations of a day or longer only align midnight.
+func isTimeAlignedTo(v time.Time, d time.Duration) bool {
+	return d > 0 && timeOfDay(v)%d == 0
+}
+
+func isIntervalWithin(v TimeInterval, outer TimeInterval) bool {
+	return !v.Start.Before(outer.Start) && !v.End.After(outer.End)
+}
+
+// Add the error of an interval of a list to its indexed path, with the same
+// format used by [ValidatorSlice.Each]. The other param names the path of the
+// interval that caused the error.
+func invalidateIntervalsItem(path string, other string, title string, errorKey string, template []string, validation *Validation) {
+	validation.invalidate(&path, &title, []*invalidFragment{{
+		fragments: []*validatorFragment{{
+			errorKey:       errorKey,
+			template:       template,
+			templateParams: map[string]any{"other": other},
+			boolOperation:  true,
+		}},
+	}})
+}
+
+func validateIntervalsNonOverlapping(v []TimeInterval, name string, title string, validation *Validation, template []string) bool {
+	indexes := make([]int, len(v))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return v[indexes[a]].Start.Before(v[indexes[b]].Start)
+	})
+
+	valid := true
+	// The interval that ends last so far, which is the one the next interval
+	// would overlap with
+	last := -1
+	for _, i := range indexes {
+		if last >= 0 && v[i].Overlaps(v[last]) {
+			valid = false
+			invalidateIntervalsItem(fmt.Sprintf("%s[%v]", name, i), fmt.Sprintf("%s[%v]", name, last), title, ErrorKeyNonOverlapping, template, validation)
+		}
+		if last < 0 || v[i].End.After(v[last].End) {
+			last = i
+		}
+	}
+	return valid
+}
+
+func validateIntervalsContiguous(v []TimeInterval, name string, title string, validation *Validation, template []string) bool {
+	valid := true
+	for i := 1; i < len(v); i++ {
+		if !v[i].Start.Equal(v[i-1].End) {
+			valid = false
+			invalidateIntervalsItem(fmt.Sprintf("%s[%v]", name, i), fmt.Sprintf("%s[%v]", name, i-1), title, ErrorKeyContiguous, template, validation)
+		}
+	}
+	return valid
+}
+
+func validateIntervalsSorted(v []TimeInterval, name string, title string, validation *Validation, template []string) bool {
+	valid := true
+	for i := 1; i < len(v); i++ {
+		if v[i].Start.Before(v[i-1].Start) {
+			valid = false
+			invalidateIntervalsItem(fmt.Sprintf("%s[%v]", name, i), fmt.Sprintf("%s[%v]", name, i-1), title, ErrorKeySorted, template, validation)
+		}
+	}
+	return valid
+}
+
+// The `ValidatorInterval` structure provides a set of methods to perform
+// validation checks on a [TimeInterval], such as a start and end pair of a
+// booking.
+type ValidatorInterval struct {
+	context *ValidatorContext
+}
+
+// The Interval function initiates a new `ValidatorInterval` instance to
+// validate the interval from start to end. The optional name and title
+// parameters can be used for enhanced error reporting. If a name is provided
+// without a title, the name is humanized to be used as the title.
+//
+// For example:
+//
+//	v.Is(v.Interval(checkIn, checkOut, "stay").Ordered().MaxSpan(14 * 24 * time.Hour))
+func Interval(start time.Time, end time.Time, nameAndTitle ...string) *ValidatorInterval {
+	return &ValidatorInterval{context: NewContext(TimeInterval{Start: start, End: end}, nameAndTitle...)}
+}
+
+// The Context method returns the current context of the validator, which can
+// be utilized to create custom validations by extending this validator.
+func (validator *ValidatorInterval) Context() *ValidatorContext {
+	return validator.context
+}
+
+// The Not method inverts the boolean value associated with the next validator
+// method. This can be used to negate the check performed by the next validation
+// method in the chain.
+//
+// For example:
+//
+//	// Will return false because Not() inverts the boolean value of the MaxSpan() function
+//	Is(v.Interval(start, start.Add(time.Hour)).Not().MaxSpan(2 * time.Hour)).Valid()
+func (validator *ValidatorInterval) Not() *ValidatorInterval {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes when the interval is at most an hour or aligned to whole hours.
+//	isValid := v.Is(v.Interval(start, end).MaxSpan(time.Hour).Or().AlignedTo(time.Hour)).Valid()
+func (validator *ValidatorInterval) Or() *ValidatorInterval {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the interval is within the season, the chain succeeds. Otherwise, it
+//	// must be at most a day.
+//	isValid := v.Is(v.Interval(start, end).Within(season).OrElse().MaxSpan(24 * time.Hour)).Valid()
+func (validator *ValidatorInterval) OrElse() *ValidatorInterval {
+	validator.context.OrElse()
+	return validator
+}
+
+// The Ordered method verifies if the interval starts before it ends. An empty
+// interval, which ends when it starts, is not valid.
+//
+// For example:
+//
+//	Is(v.Interval(checkIn, checkOut).Ordered()).Valid()
+func (validator *ValidatorInterval) Ordered(template ...string) *ValidatorInterval {
+	validator.context.AddWithValue(
+		func() bool {
+			value := validator.context.Value().(TimeInterval)
+			return value.Start.Before(value.End)
+		},
+		ErrorKeyStartBeforeEnd, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The MaxSpan method verifies if the interval lasts at most the duration.
+// Intervals that end before they start always pass, so combine it with
+// Ordered.
+//
+// For example:
+//
+//	Is(v.Interval(checkIn, checkOut).Ordered().MaxSpan(14 * 24 * time.Hour)).Valid()
+func (validator *ValidatorInterval) MaxSpan(d time.Duration, template ...string) *ValidatorInterval {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(TimeInterval).Duration() <= d
+		},
+		ErrorKeyMaxSpan,
+		map[string]any{"title": validator.context.title, "span": d},
+		template...)
+
+	return validator
+}
+
+// The MinSpan method verifies if the interval lasts at least the duration.
+//
+// For example:
+//
+//	Is(v.Interval(startsAt, endsAt).MinSpan(30 * time.Minute)).Valid()
+func (validator *ValidatorInterval) MinSpan(d time.Duration, template ...string) *ValidatorInterval {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(TimeInterval).Duration() >= d
+		},
+		ErrorKeyMinSpan,
+		map[string]any{"title": validator.context.title, "span": d},
+		template...)
+
+	return validator
+}
+
+// The AlignedTo method verifies if both the start and the end of the interval
+// are on a multiple of the duration since midnight, read on the wall clock of
+// their location. For example, aligned to 15 minutes, 10:45 is valid and 10:50
+// is not. Durations of a day or longer only align midnight.
+//
+// For example:
+//
+//	Is(v.Interval(startsAt, endsAt).AlignedTo(15 * time.Minute)).Valid()
+func (validator *ValidatorInterval) AlignedTo(d time.Duration, template ...string) *ValidatorInterval {
+	validator.context.AddWithParams(
+		func() bool {
+			value := validator.context.Value().(TimeInterval)
+			return isTimeAlignedTo(value.Start, d) && isTimeAlignedTo(value.End, d)
+		},
+		ErrorKeyAlignedTo,
+		map[string]any{"title": validator.context.title, "duration": d},
+		template...)
+
+	return validator
+}
+
+// The Within method verifies if the interval is inside an outer interval,
+// including its bounds.
+//
+// For example:
+//
+//	season := v.TimeInterval{Start: seasonStart, End: seasonEnd}
+//	Is(v.Interval(checkIn, checkOut).Within(season)).Valid()
+func (validator *ValidatorInterval) Within(outer TimeInterval, template ...string) *ValidatorInterval {
+	validator.context.AddWithParams(
+		func() bool {
+			return isIntervalWithin(validator.context.Value().(TimeInterval), outer)
+		},
+		ErrorKeyBetween,
+		map[string]any{"title": validator.context.title, "min": outer.Start, "max": outer.End},
+		template...)
+
+	return validator
+}
+
+// The Passing method allows for custom validation logic to be applied on the
+// interval.
+//
+// For example:
+//
+//	Is(v.Interval(checkIn, checkOut).Passing(func(i v.TimeInterval) bool {
+//		return i.Start.Weekday() == time.Saturday
+//	})).Valid()
+func (validator *ValidatorInterval) Passing(function func(v0 TimeInterval) bool, template ...string) *ValidatorInterval {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(TimeInterval))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The `ValidatorIntervals` provides functions for setting validation rules for
+// a list of intervals, such as the slots of a schedule. The errors of the rules
+// are added to the indexed paths of the intervals that break them, so the
+// errors of the third interval of the list "slots" are added to the path
+// "slots[2]".
+type ValidatorIntervals struct {
+	context *ValidatorContext
+}
+
+// Receive a list of intervals to validate.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A value_%N pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well.
+func Intervals(value []TimeInterval, nameAndTitle ...string) *ValidatorIntervals {
+	return &ValidatorIntervals{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorIntervals) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Or introduces a logical OR boundary in the current validator chain, like in
+// [ValidatorInterval.Or].
+func (validator *ValidatorIntervals) Or() *ValidatorIntervals {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain, like in [ValidatorInterval.OrElse].
+func (validator *ValidatorIntervals) OrElse() *ValidatorIntervals {
+	validator.context.OrElse()
+	return validator
+}
+
+// Validate that no interval of the list overlaps with another. Intervals that
+// only touch, because one ends when the other starts, don't overlap. The error
+// is added to the path of the interval that starts later, and the {{other}}
+// param is the path of the interval it overlaps with. A Not() before this rule
+// is ignored, as in the other rules that add errors to the intervals.
+//
+// For example:
+//
+//	val := Is(v.Intervals(slots, "slots").NonOverlapping())
+//	val.Errors()["slots[1]"] // Slots can't overlap with slots[0]
+func (validator *ValidatorIntervals) NonOverlapping(template ...string) *ValidatorIntervals {
+	validator.context.addNested(
+		func(name string, title string, shortCircuit bool, validation *Validation) bool {
+			return validateIntervalsNonOverlapping(validator.context.Value().([]TimeInterval), name, title, validation, template)
+		})
+
+	return validator
+}
+
+// Validate that each interval of the list starts exactly when the previous one
+// ends, so the list has no gaps and no overlaps. A Not() before this rule is
+// ignored.
+//
+// For example:
+//
+//	val := Is(v.Intervals(shifts, "shifts").Contiguous())
+//	val.Errors()["shifts[2]"] // Shifts must start when shifts[1] ends
+func (validator *ValidatorIntervals) Contiguous(template ...string) *ValidatorIntervals {
+	validator.context.addNested(
+		func(name string, title string, shortCircuit bool, validation *Validation) bool {
+			return validateIntervalsContiguous(validator.context.Value().([]TimeInterval), name, title, validation, template)
+		})
+
+	return validator
+}
+
+// Validate that the intervals of the list are sorted by their start. Intervals
+// with the same start can be in any order. A Not() before this rule is
+// ignored.
+//
+// For example:
+//
+//	val := Is(v.Intervals(slots, "slots").Sorted())
+//	val.Errors()["slots[1]"] // Slots can't start before slots[0]
+func (validator *ValidatorIntervals) Sorted(template ...string) *ValidatorIntervals {
+	validator.context.addNested(
+		func(name string, title string, shortCircuit bool, validation *Validation) bool {
+			return validateIntervalsSorted(validator.context.Value().([]TimeInterval), name, title, validation, template)
+		})
+
+	return validator
+}
+
+// Validate each interval of the list with the validator returned by the
+// function, like in [ValidatorSlice.Each]. Returning nil skips the interval,
+// and a Not() before Each is ignored.
+//
+// For example:
+//
+//	Is(v.Intervals(slots, "slots").Each(func(slot v.TimeInterval, i int) v.Validator {
+//		return v.Interval(slot.Start, slot.End).Ordered().AlignedTo(15 * time.Minute)
+//	}))
+func (validator *ValidatorIntervals) Each(function func(item TimeInterval, index int) Validator) *ValidatorIntervals {
+	validator.context.addNested(
+		func(name string, title string, shortCircuit bool, validation *Validation) bool {
+			return validateSliceEach(validator.context.Value().([]TimeInterval), function, name, title, shortCircuit, validation)
+		})
+
+	return validator
+}
@@ -0,0 +1,228 @@
+package valgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorIntervalNot(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	v := Is(Interval(start, start.Add(3*time.Hour)).Not().MaxSpan(2 * time.Hour))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorIntervalOrdered(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	assert.True(t, Is(Interval(start, start.Add(time.Minute)).Ordered()).Valid())
+	assert.False(t, Is(Interval(start, start).Ordered()).Valid())
+
+	v := Is(Interval(start, start.Add(-time.Hour), "stay").Ordered())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Stay must start before it ends",
+		v.Errors()["stay"].Messages()[0])
+}
+
+func TestValidatorIntervalSpan(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	twoWeeks := 14 * 24 * time.Hour
+
+	assert.True(t, Is(Interval(start, start.Add(twoWeeks)).MaxSpan(twoWeeks)).Valid())
+	assert.True(t, Is(Interval(start, start.Add(30*time.Minute)).MinSpan(30*time.Minute)).Valid())
+	assert.False(t, Is(Interval(start, start.Add(29*time.Minute)).MinSpan(30*time.Minute)).Valid())
+
+	v := Is(Interval(start, start.Add(twoWeeks+time.Hour), "stay").MaxSpan(twoWeeks))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Stay can't be longer than 336h0m0s",
+		v.Errors()["stay"].Messages()[0])
+
+	v = Is(Interval(start, start.Add(10*time.Minute), "meeting").MinSpan(15 * time.Minute))
+	assert.Equal(t,
+		"Meeting can't be shorter than 15m0s",
+		v.Errors()["meeting"].Messages()[0])
+}
+
+func TestValidatorIntervalAlignedTo(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 45, 0, 0, time.UTC)
+
+	assert.True(t, Is(Interval(start, start.Add(30*time.Minute)).AlignedTo(15*time.Minute)).Valid())
+	assert.False(t, Is(Interval(start, start.Add(20*time.Minute)).AlignedTo(15*time.Minute)).Valid())
+	assert.False(t, Is(Interval(start.Add(time.Second), start.Add(15*time.Minute)).AlignedTo(15*time.Minute)).Valid())
+	assert.False(t, Is(Interval(start, start).AlignedTo(0)).Valid())
+
+	// The alignment uses the wall clock of the location
+	kathmandu := time.FixedZone("NPT", 5*3600+45*60)
+	local := time.Date(2024, 1, 15, 10, 45, 0, 0, kathmandu)
+	assert.True(t, Is(Interval(local, local.Add(time.Hour)).AlignedTo(15*time.Minute)).Valid())
+
+	midnight := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	assert.True(t, Is(Interval(midnight, midnight.AddDate(0, 0, 2)).AlignedTo(24*time.Hour)).Valid())
+	assert.False(t, Is(Interval(midnight, midnight.Add(12*time.Hour)).AlignedTo(24*time.Hour)).Valid())
+
+	v := Is(Interval(start, start.Add(20*time.Minute), "slot").AlignedTo(15 * time.Minute))
+	assert.Equal(t,
+		"Slot must be aligned to 15m0s",
+		v.Errors()["slot"].Messages()[0])
+}
+
+func TestValidatorIntervalWithin(t *testing.T) {
+	seasonStart := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	season := TimeInterval{Start: seasonStart, End: seasonStart.AddDate(0, 3, 0)}
+
+	assert.True(t, Is(Interval(season.Start, season.End).Within(season)).Valid())
+	assert.True(t, Is(Interval(seasonStart.AddDate(0, 0, 5), seasonStart.AddDate(0, 0, 10)).Within(season)).Valid())
+	assert.False(t, Is(Interval(seasonStart.AddDate(0, 0, -1), seasonStart.AddDate(0, 0, 10)).Within(season)).Valid())
+	assert.False(t, Is(Interval(seasonStart, season.End.Add(time.Second)).Within(season)).Valid())
+
+	v := Is(Interval(seasonStart.AddDate(0, 0, -1), seasonStart, "stay").Within(season))
+	assert.Equal(t,
+		"Stay must be between \""+season.Start.String()+"\" and \""+season.End.String()+"\"",
+		v.Errors()["stay"].Messages()[0])
+}
+
+func TestValidatorIntervalPassingAndOr(t *testing.T) {
+	start := time.Date(2024, 1, 13, 10, 0, 0, 0, time.UTC) // Saturday
+	saturday := func(i TimeInterval) bool { return i.Start.Weekday() == time.Saturday }
+
+	assert.True(t, Is(Interval(start, start.Add(time.Hour)).Passing(saturday)).Valid())
+	assert.False(t, Is(Interval(start.AddDate(0, 0, 1), start.Add(time.Hour)).Passing(saturday)).Valid())
+
+	assert.True(t, Is(Interval(start, start.Add(3*time.Hour)).MaxSpan(time.Hour).Or().AlignedTo(time.Hour)).Valid())
+	assert.False(t, Is(Interval(start, start.Add(90*time.Minute)).MaxSpan(time.Hour).Or().AlignedTo(time.Hour)).Valid())
+}
+
+func TestTimeInterval(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	interval := TimeInterval{Start: start, End: start.Add(time.Hour)}
+
+	assert.Equal(t, time.Hour, interval.Duration())
+	assert.True(t, interval.Overlaps(TimeInterval{Start: start.Add(30 * time.Minute), End: start.Add(2 * time.Hour)}))
+	assert.True(t, interval.Overlaps(TimeInterval{Start: start.Add(10 * time.Minute), End: start.Add(20 * time.Minute)}))
+	assert.False(t, interval.Overlaps(TimeInterval{Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)}))
+	assert.False(t, interval.Overlaps(TimeInterval{Start: start.Add(-time.Hour), End: start}))
+}
+
+func testIntervalSlots(start time.Time, offsets ...time.Duration) []TimeInterval {
+	slots := []TimeInterval{}
+	for i := 0; i+1 < len(offsets); i += 2 {
+		slots = append(slots, TimeInterval{Start: start.Add(offsets[i]), End: start.Add(offsets[i+1])})
+	}
+	return slots
+}
+
+func TestValidatorIntervalsNonOverlapping(t *testing.T) {
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	h := time.Hour
+
+	assert.True(t, Is(Intervals(testIntervalSlots(start, 0, h, h, 2*h, 3*h, 4*h)).NonOverlapping()).Valid())
+	assert.True(t, Is(Intervals(testIntervalSlots(start, 3*h, 4*h, 0, h)).NonOverlapping()).Valid())
+	assert.True(t, Is(Intervals(nil).NonOverlapping()).Valid())
+
+	// slots[2] starts inside slots[0], which is listed first but ends last
+	v := Is(Intervals(testIntervalSlots(start, 0, 4*h, 5*h, 6*h, h, 2*h), "slots").NonOverlapping())
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Equal(t,
+		[]string{"Slots can't overlap with slots[0]"},
+		v.Errors()["slots[2]"].Messages())
+
+	// Each interval is reported once, against the interval that ends last
+	v = Is(Intervals(testIntervalSlots(start, 0, 3*h, h, 2*h, 2*h+30*time.Minute, 4*h), "slots").NonOverlapping())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t, "Slots can't overlap with slots[0]", v.Errors()["slots[1]"].Messages()[0])
+	assert.Equal(t, "Slots can't overlap with slots[0]", v.Errors()["slots[2]"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(Intervals(testIntervalSlots(start, 0, 2*h, h, 3*h), "slots").NonOverlapping())
+	assert.Equal(t,
+		"Slots no puede superponerse con slots[0]",
+		v.Errors()["slots[1]"].Messages()[0])
+
+	v = Is(Intervals(testIntervalSlots(start, 0, 2*h, h, 3*h), "slots").NonOverlapping("{{title}} {{name}} overlaps {{other}}"))
+	assert.Equal(t,
+		"Slots slots[1] overlaps slots[0]",
+		v.Errors()["slots[1]"].Messages()[0])
+}
+
+func TestValidatorIntervalsContiguous(t *testing.T) {
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	h := time.Hour
+
+	assert.True(t, Is(Intervals(testIntervalSlots(start, 0, h, h, 2*h, 2*h, 5*h)).Contiguous()).Valid())
+	assert.True(t, Is(Intervals(testIntervalSlots(start, 0, h)).Contiguous()).Valid())
+
+	v := Is(Intervals(testIntervalSlots(start, 0, h, h, 2*h, 3*h, 4*h, 3*h+30*time.Minute, 5*h), "shifts").Contiguous())
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t,
+		"Shifts must start when shifts[1] ends",
+		v.Errors()["shifts[2]"].Messages()[0])
+	assert.Equal(t,
+		"Shifts must start when shifts[2] ends",
+		v.Errors()["shifts[3]"].Messages()[0])
+}
+
+func TestValidatorIntervalsSorted(t *testing.T) {
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	h := time.Hour
+
+	assert.True(t, Is(Intervals(testIntervalSlots(start, 0, h, 0, 2*h, h, 2*h)).Sorted()).Valid())
+
+	v := Is(Intervals(testIntervalSlots(start, 2*h, 3*h, 0, h), "slots").Sorted())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Slots can't start before slots[0]",
+		v.Errors()["slots[1]"].Messages()[0])
+
+	v = Is(Intervals(testIntervalSlots(start, 2*h, 3*h, 0, h)).Sorted())
+	assert.Equal(t,
+		"Value 0 can't start before value_0[0]",
+		v.Errors()["value_0[1]"].Messages()[0])
+}
+
+func TestValidatorIntervalsEach(t *testing.T) {
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	slots := []TimeInterval{
+		{Start: start, End: start.Add(time.Hour)},
+		{Start: start.Add(2 * time.Hour), End: start.Add(time.Hour)},
+		{Start: start.Add(3 * time.Hour), End: start.Add(3*time.Hour + 20*time.Minute)},
+	}
+
+	v := Check(Intervals(slots, "slots").Each(func(slot TimeInterval, i int) Validator {
+		return Interval(slot.Start, slot.End).Ordered().AlignedTo(15 * time.Minute)
+	}).Sorted())
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t,
+		[]string{"Slots must start before it ends"},
+		v.Errors()["slots[1]"].Messages())
+	assert.Equal(t,
+		[]string{"Slots must be aligned to 15m0s"},
+		v.Errors()["slots[2]"].Messages())
+}
+
+func TestValidatorIntervalsShortCircuit(t *testing.T) {
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	h := time.Hour
+	slots := testIntervalSlots(start, 2*h, 4*h, 0, 3*h)
+
+	v := Is(Intervals(slots, "slots").Sorted().NonOverlapping())
+	assert.Equal(t,
+		[]string{"Slots can't start before slots[0]"},
+		v.Errors()["slots[1]"].Messages())
+
+	v = Check(Intervals(slots, "slots").Sorted().NonOverlapping())
+	assert.Equal(t,
+		[]string{"Slots can't start before slots[0]"},
+		v.Errors()["slots[1]"].Messages())
+	assert.Equal(t,
+		[]string{"Slots can't overlap with slots[1]"},
+		v.Errors()["slots[0]"].Messages())
+
+	assert.True(t, Is(Intervals(nil).Sorted().Or().NonOverlapping()).Valid())
+}
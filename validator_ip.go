@@ -0,0 +1,354 @@
+package valgo
+
+import (
+	"net/netip"
+	"reflect"
+	"strings"
+)
+
+// Custom generic type covering the IP address types accepted by [ValidatorIP]:
+// a [netip.Addr], or a string type holding the textual form of the address.
+type TypeIP interface {
+	netip.Addr | ~string
+}
+
+// Convert the value to a [netip.Addr]. Returns false if the value is not a
+// valid IP address.
+func ipAddrOf[T TypeIP](v T) (netip.Addr, bool) {
+	if addr, ok := any(v).(netip.Addr); ok {
+		return addr, addr.IsValid()
+	}
+	// The value is a string type, but the generic type can't be converted to a
+	// string directly because netip.Addr is part of the type set.
+	addr, err := netip.ParseAddr(reflect.ValueOf(v).String())
+	return addr, err == nil
+}
+
+// Special-purpose ranges that are not globally reachable, besides the private,
+// loopback, link-local, multicast, and unspecified ones that [netip.Addr]
+// reports. The IP Global rule and the URL NotInternal rule both exclude them.
+var specialPurposePrefixes = []netip.Prefix{
+	// "This network", where many systems connect 0.x.x.x to the local host
+	netip.MustParsePrefix("0.0.0.0/8"),
+	// Shared address space of carrier-grade NAT
+	netip.MustParsePrefix("100.64.0.0/10"),
+	// IETF protocol assignments
+	netip.MustParsePrefix("192.0.0.0/24"),
+	// Documentation
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	// Benchmarking
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("2001:2::/48"),
+	// Reserved for future use, and the limited broadcast address
+	netip.MustParsePrefix("240.0.0.0/4"),
+	// Discard-only
+	netip.MustParsePrefix("100::/64"),
+}
+
+// Shared IP address checks, also used by the [ValidatorAddrPort]. IPv4-mapped
+// IPv6 addresses, such as "::ffff:10.0.0.1", are checked as IPv4 addresses.
+
+func isAddrIPv4(addr netip.Addr) bool {
+	return addr.Unmap().Is4()
+}
+func isAddrIPv6(addr netip.Addr) bool {
+	return addr.Is6() && !addr.Is4In6()
+}
+func isAddrPrivate(addr netip.Addr) bool {
+	return addr.Unmap().IsPrivate()
+}
+func isAddrLoopback(addr netip.Addr) bool {
+	return addr.Unmap().IsLoopback()
+}
+func isAddrGlobal(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !isAddrInPrefixes(addr, specialPurposePrefixes)
+}
+func isAddrMulticast(addr netip.Addr) bool {
+	return addr.Unmap().IsMulticast()
+}
+func isAddrInPrefixes(addr netip.Addr, prefixes []netip.Prefix) bool {
+	// Prefixes never contain addresses with a zone
+	addr = addr.WithZone("")
+	for _, p := range prefixes {
+		if p.Contains(addr) || p.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinPrefixes(prefixes []netip.Prefix) string {
+	s := make([]string, len(prefixes))
+	for i, p := range prefixes {
+		s[i] = p.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+// The `ValidatorIP` provides functions for setting validation rules for an IP
+// address, either a [netip.Addr] value or a string with the textual form of the
+// address, such as "192.168.0.1" or "2001:db8::1".
+//
+// A string that is not a valid IP address, or the zero [netip.Addr], doesn't
+// satisfy any rule.
+type ValidatorIP[T TypeIP] struct {
+	context *ValidatorContext
+}
+
+// Receive an IP address to validate.
+//
+// The value can be a [netip.Addr], a string, or a custom string type such as
+// type Host string;.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A `value_%N` pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name phone_number will be
+// humanized as Phone Number.
+func IP[T TypeIP](value T, nameAndTitle ...string) *ValidatorIP[T] {
+	return &ValidatorIP[T]{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorIP[T]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the boolean value associated with the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Loopback() function
+//	Is(v.IP("127.0.0.1").Not().Loopback()).Valid()
+func (validator *ValidatorIP[T]) Not() *ValidatorIP[T] {
+	validator.context.Not()
+
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the address is private (Loopback() OR Private()).
+//	isValid := v.Is(v.IP("10.0.0.1").Loopback().Or().Private()).Valid()
+func (validator *ValidatorIP[T]) Or() *ValidatorIP[T] {
+	validator.context.Or()
+
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the address is a loopback address, the chain succeeds and the rest is not evaluated.
+//	// Otherwise, the address must be a global IPv4 address.
+//	isValid := v.Is(v.IP(addr).Loopback().OrElse().Global().IPv4()).Valid()
+func (validator *ValidatorIP[T]) OrElse() *ValidatorIP[T] {
+	validator.context.OrElse()
+
+	return validator
+}
+
+// Validate if the value is a valid IP address.
+// For example:
+//
+//	Is(v.IP("192.168.0.1").Valid()) // Will be true
+//	Is(v.IP("192.168.0.256").Valid()) // Will be false
+func (validator *ValidatorIP[T]) Valid(template ...string) *ValidatorIP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			_, ok := ipAddrOf(validator.context.Value().(T))
+			return ok
+		},
+		ErrorKeyIP, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if an IP address is an IPv4 address. IPv4-mapped IPv6 addresses,
+// such as "::ffff:10.0.0.1", are considered IPv4 addresses.
+// For example:
+//
+//	Is(v.IP("10.0.0.1").IPv4())
+func (validator *ValidatorIP[T]) IPv4(template ...string) *ValidatorIP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addr, ok := ipAddrOf(validator.context.Value().(T))
+			return ok && isAddrIPv4(addr)
+		},
+		ErrorKeyIPv4, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if an IP address is an IPv6 address, excluding the IPv4-mapped IPv6
+// addresses.
+// For example:
+//
+//	Is(v.IP("2001:db8::1").IPv6())
+func (validator *ValidatorIP[T]) IPv6(template ...string) *ValidatorIP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addr, ok := ipAddrOf(validator.context.Value().(T))
+			return ok && isAddrIPv6(addr)
+		},
+		ErrorKeyIPv6, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if an IP address is a private address, according to RFC 1918 for
+// IPv4 and RFC 4193 for IPv6.
+// For example:
+//
+//	Is(v.IP("192.168.0.1").Private())
+func (validator *ValidatorIP[T]) Private(template ...string) *ValidatorIP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addr, ok := ipAddrOf(validator.context.Value().(T))
+			return ok && isAddrPrivate(addr)
+		},
+		ErrorKeyPrivate, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if an IP address is a loopback address.
+// For example:
+//
+//	Is(v.IP("::1").Loopback())
+func (validator *ValidatorIP[T]) Loopback(template ...string) *ValidatorIP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addr, ok := ipAddrOf(validator.context.Value().(T))
+			return ok && isAddrLoopback(addr)
+		},
+		ErrorKeyLoopback, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if an IP address is a global unicast address that is not private.
+// Loopback, link-local, multicast, unspecified, and private addresses are not
+// global, and neither are special-purpose addresses such as the carrier-grade
+// NAT range 100.64.0.0/10, 0.0.0.0/8, or the documentation ranges.
+// For example:
+//
+//	Is(v.IP("8.8.8.8").Global()) // Will be true
+//	Is(v.IP("10.0.0.1").Global()) // Will be false
+func (validator *ValidatorIP[T]) Global(template ...string) *ValidatorIP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addr, ok := ipAddrOf(validator.context.Value().(T))
+			return ok && isAddrGlobal(addr)
+		},
+		ErrorKeyGlobal, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if an IP address is a multicast address.
+// For example:
+//
+//	Is(v.IP("224.0.0.1").Multicast())
+func (validator *ValidatorIP[T]) Multicast(template ...string) *ValidatorIP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			addr, ok := ipAddrOf(validator.context.Value().(T))
+			return ok && isAddrMulticast(addr)
+		},
+		ErrorKeyMulticast, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if an IP address belongs to a network prefix.
+// For example:
+//
+//	Is(v.IP("10.1.2.3").InPrefix(netip.MustParsePrefix("10.0.0.0/8")))
+func (validator *ValidatorIP[T]) InPrefix(prefix netip.Prefix, template ...string) *ValidatorIP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			addr, ok := ipAddrOf(validator.context.Value().(T))
+			return ok && isAddrInPrefixes(addr, []netip.Prefix{prefix})
+		},
+		ErrorKeyInPrefix,
+		map[string]any{"title": validator.context.title, "prefix": prefix.String(), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if an IP address doesn't belong to any of the network prefixes.
+// This is useful to reject reserved or internal networks.
+// For example:
+//
+//	reserved := []netip.Prefix{
+//		netip.MustParsePrefix("169.254.0.0/16"),
+//		netip.MustParsePrefix("100.64.0.0/10"),
+//	}
+//	Is(v.IP("8.8.8.8").NotInPrefixes(reserved))
+func (validator *ValidatorIP[T]) NotInPrefixes(prefixes []netip.Prefix, template ...string) *ValidatorIP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			addr, ok := ipAddrOf(validator.context.Value().(T))
+			return ok && !isAddrInPrefixes(addr, prefixes)
+		},
+		ErrorKeyOutsidePrefixes,
+		map[string]any{"title": validator.context.title, "prefixes": joinPrefixes(prefixes), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if an IP address passes a custom function.
+// For example:
+//
+//	Is(v.IP(addr).Passing(func(addr netip.Addr) bool {
+//		return addr.Zone() == ""
+//	}))
+func (validator *ValidatorIP[T]) Passing(function func(v0 T) bool, template ...string) *ValidatorIP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(T))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
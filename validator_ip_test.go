@@ -0,0 +1,192 @@
+package valgo
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorIPNot(t *testing.T) {
+
+	v := Is(IP("10.0.0.1").Not().Loopback())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorIPValid(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(IP("192.168.0.1").Valid())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(IP("fe80::1%eth0").Valid())
+	assert.True(t, v.Valid())
+
+	v = Is(IP(netip.MustParseAddr("2001:db8::1")).Valid())
+	assert.True(t, v.Valid())
+
+	type Host string
+	v = Is(IP(Host("10.0.0.1")).Valid())
+	assert.True(t, v.Valid())
+
+	v = Is(IP("192.168.0.256", "server_ip", "Server IP").Valid())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Server IP must be a valid IP address",
+		v.Errors()["server_ip"].Messages()[0])
+
+	v = Is(IP(netip.Addr{}).Valid())
+	assert.False(t, v.Valid())
+
+	v = Is(IP("localhost").Loopback())
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorIPVersion(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(IP("10.0.0.1").IPv4())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(IP("::ffff:10.0.0.1").IPv4())
+	assert.True(t, v.Valid())
+
+	v = Is(IP("2001:db8::1").IPv4())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be IPv4",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(IP("2001:db8::1").IPv6())
+	assert.True(t, v.Valid())
+
+	v = Is(IP("::ffff:10.0.0.1").IPv6())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be IPv6",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorIPScopes(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"10.0.0.1", "172.16.5.4", "192.168.1.1", "fd00::1", "::ffff:192.168.1.1"} {
+		assert.True(t, Is(IP(s).Private()).Valid(), s)
+	}
+	v = Is(IP("8.8.8.8").Private())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be private",
+		v.Errors()["value_0"].Messages()[0])
+
+	assert.True(t, Is(IP("127.0.0.1").Loopback()).Valid())
+	assert.True(t, Is(IP("::1").Loopback()).Valid())
+	v = Is(IP("10.0.0.1").Loopback())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be loopback",
+		v.Errors()["value_0"].Messages()[0])
+
+	assert.True(t, Is(IP("8.8.8.8").Global()).Valid())
+	assert.True(t, Is(IP("2001:4860:4860::8888").Global()).Valid())
+	assert.True(t, Is(IP("100.128.0.1").Global()).Valid())
+	for _, s := range []string{
+		"10.0.0.1", "127.0.0.1", "169.254.0.1", "0.0.0.0", "224.0.0.1", "fe80::1",
+		"0.1.2.3", "100.64.0.1", "192.0.2.1", "198.18.0.1", "203.0.113.5", "255.255.255.255", "2001:db8::1", "::ffff:100.64.0.1",
+	} {
+		assert.False(t, Is(IP(s).Global()).Valid(), s)
+	}
+	v = Is(IP("10.0.0.1").Global())
+	assert.Equal(t,
+		"Value 0 must be a public address",
+		v.Errors()["value_0"].Messages()[0])
+
+	assert.True(t, Is(IP("224.0.0.1").Multicast()).Valid())
+	assert.True(t, Is(IP("ff02::1").Multicast()).Valid())
+	v = Is(IP("10.0.0.1").Multicast())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be multicast",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorIPInPrefix(t *testing.T) {
+
+	var v *Validation
+
+	prefix := netip.MustParsePrefix("10.0.0.0/8")
+
+	v = Is(IP("10.1.2.3").InPrefix(prefix))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(IP("::ffff:10.1.2.3").InPrefix(prefix))
+	assert.True(t, v.Valid())
+
+	v = Is(IP("11.0.0.1", "gateway").InPrefix(prefix))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Gateway must be within \"10.0.0.0/8\"",
+		v.Errors()["gateway"].Messages()[0])
+}
+
+func TestValidatorIPNotInPrefixes(t *testing.T) {
+
+	var v *Validation
+
+	reserved := []netip.Prefix{
+		netip.MustParsePrefix("169.254.0.0/16"),
+		netip.MustParsePrefix("100.64.0.0/10"),
+	}
+
+	v = Is(IP("8.8.8.8").NotInPrefixes(reserved))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(IP("169.254.169.254").NotInPrefixes(reserved))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 can't be within \"169.254.0.0/16, 100.64.0.0/10\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(IP("169.254.169.254").Not().NotInPrefixes(reserved))
+	assert.True(t, v.Valid())
+
+	v = Is(IP("8.8.8.8").Not().NotInPrefixes(reserved))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be within \"169.254.0.0/16, 100.64.0.0/10\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(IP("not-an-ip").NotInPrefixes(reserved))
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorIPPassing(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(IP(netip.MustParseAddr("fe80::1")).Passing(func(addr netip.Addr) bool { return addr.Zone() == "" }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(IP(netip.MustParseAddr("fe80::1%eth0")).Passing(func(addr netip.Addr) bool { return addr.Zone() == "" }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorIPLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeDe}).Is(IP("abc", "ip", "IP").Valid())
+	assert.Equal(t,
+		"IP muss eine gültige IP-Adresse sein",
+		v.Errors()["ip"].Messages()[0])
+}
@@ -0,0 +1,362 @@
+package valgo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Characters with a special meaning in the error paths are percent-encoded, so
+// the key "a.b" is added to the path "labels.a%2Eb" instead of "labels.a.b".
+var mapKeyPathReplacer = strings.NewReplacer(
+	"%", "%25",
+	".", "%2E",
+	"[", "%5B",
+	"]", "%5D",
+)
+
+// Return the error path used by the [ValidatorMap] for a key of the map named
+// name. The key is formatted with the `%v` verb and the characters with a
+// special meaning in the error paths (".", "[", "]" and "%") are
+// percent-encoded, so the path can be used safely with [Validation.PathValid].
+// For example:
+//
+//	MapKeyPath("labels", "env")     // labels.env
+//	MapKeyPath("labels", "app.io")  // labels.app%2Eio
+func MapKeyPath(name string, key any) string {
+	return concatString(name+".", mapKeyPathReplacer.Replace(fmt.Sprintf("%v", key)))
+}
+
+func isMapEmpty[K comparable, V any](v map[K]V) bool {
+	return len(v) == 0
+}
+func isMapLength[K comparable, V any](v map[K]V, length int) bool {
+	return len(v) == length
+}
+func isMapMinLength[K comparable, V any](v map[K]V, length int) bool {
+	return len(v) >= length
+}
+func isMapMaxLength[K comparable, V any](v map[K]V, length int) bool {
+	return len(v) <= length
+}
+func isMapLengthBetween[K comparable, V any](v map[K]V, min int, max int) bool {
+	return len(v) >= min && len(v) <= max
+}
+func isMapHasKeys[K comparable, V any](v map[K]V, keys []K) bool {
+	for _, key := range keys {
+		if _, exists := v[key]; !exists {
+			return false
+		}
+	}
+	return true
+}
+func isMapOnlyKeys[K comparable, V any](v map[K]V, keys []K) bool {
+	allowed := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		allowed[key] = struct{}{}
+	}
+	for key := range v {
+		if _, exists := allowed[key]; !exists {
+			return false
+		}
+	}
+	return true
+}
+
+// Join the keys to be displayed in the error messages.
+func joinMapKeys[K comparable](keys []K) string {
+	strs := make([]string, len(keys))
+	for i, key := range keys {
+		strs[i] = fmt.Sprintf("%v", key)
+	}
+	return strings.Join(strs, ", ")
+}
+
+// The `ValidatorMap` provides functions for setting validation rules for a map
+// value type. The rules can validate the map as a collection, for example its
+// size or its keys, and each one of its entries using the
+// [ValidatorMap.EachKey] and [ValidatorMap.EachValue] functions.
+type ValidatorMap[K comparable, V any] struct {
+	context *ValidatorContext
+}
+
+// Receive a map value to validate.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A value_%N` pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name pod_labels will be
+// humanized as Pod Labels.
+func Map[K comparable, V any](value map[K]V, nameAndTitle ...string) *ValidatorMap[K, V] {
+	return &ValidatorMap[K, V]{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorMap[K, V]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the boolean value associated with the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Empty() function
+//	Is(v.Map(map[string]int{}).Not().Empty()).Valid()
+func (validator *ValidatorMap[K, V]) Not() *ValidatorMap[K, V] {
+	validator.context.Not()
+
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the map is empty (Empty() OR HasKeys(...)).
+//	labels := map[string]string{}
+//	isValid := v.Is(v.Map(labels).Empty().Or().HasKeys([]string{"app"})).Valid()
+func (validator *ValidatorMap[K, V]) Or() *ValidatorMap[K, V] {
+	validator.context.Or()
+
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the map is empty, the chain succeeds and HasKeys/MaxLength are not evaluated.
+//	// Otherwise, the map must have HasKeys(...) AND MaxLength(10).
+//	labels := map[string]string{}
+//	isValid := v.Is(v.Map(labels).Empty().OrElse().HasKeys([]string{"app"}).MaxLength(10)).Valid()
+func (validator *ValidatorMap[K, V]) OrElse() *ValidatorMap[K, V] {
+	validator.context.OrElse()
+
+	return validator
+}
+
+// Validate if a map is empty. A nil map is also considered empty.
+// For example:
+//
+//	Is(v.Map(map[string]int{}).Empty()) // Will be true
+//	Is(v.Map(map[string]int{"a": 1}).Empty()) // Will be false
+func (validator *ValidatorMap[K, V]) Empty(template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isMapEmpty(validator.context.Value().(map[K]V))
+		},
+		ErrorKeyEmpty, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate the number of entries of a map.
+// For example:
+//
+//	labels := map[string]string{"app": "api", "env": "prod"}
+//	Is(v.Map(labels).Length(2))
+func (validator *ValidatorMap[K, V]) Length(length int, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isMapLength(validator.context.Value().(map[K]V), length)
+		},
+		ErrorKeyLength,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the minimum number of entries of a map.
+// For example:
+//
+//	labels := map[string]string{"app": "api", "env": "prod"}
+//	Is(v.Map(labels).MinLength(1))
+func (validator *ValidatorMap[K, V]) MinLength(length int, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isMapMinLength(validator.context.Value().(map[K]V), length)
+		},
+		ErrorKeyMinLength,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the maximum number of entries of a map.
+// For example:
+//
+//	labels := map[string]string{"app": "api", "env": "prod"}
+//	Is(v.Map(labels).MaxLength(10))
+func (validator *ValidatorMap[K, V]) MaxLength(length int, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isMapMaxLength(validator.context.Value().(map[K]V), length)
+		},
+		ErrorKeyMaxLength,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the number of entries of a map is within a range (inclusive).
+// For example:
+//
+//	labels := map[string]string{"app": "api", "env": "prod"}
+//	Is(v.Map(labels).LengthBetween(1, 10))
+func (validator *ValidatorMap[K, V]) LengthBetween(min int, max int, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isMapLengthBetween(validator.context.Value().(map[K]V), min, max)
+		},
+		ErrorKeyLengthBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a map has all the given keys.
+// For example:
+//
+//	labels := map[string]string{"app": "api", "env": "prod"}
+//	Is(v.Map(labels).HasKeys([]string{"app", "env"}))
+func (validator *ValidatorMap[K, V]) HasKeys(keys []K, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isMapHasKeys(validator.context.Value().(map[K]V), keys)
+		},
+		ErrorKeyHasKeys,
+		map[string]any{"title": validator.context.title, "keys": joinMapKeys(keys), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if all the keys of a map are in the given keys.
+// For example:
+//
+//	labels := map[string]string{"app": "api", "env": "prod"}
+//	Is(v.Map(labels).OnlyKeys([]string{"app", "env", "team"}))
+func (validator *ValidatorMap[K, V]) OnlyKeys(keys []K, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isMapOnlyKeys(validator.context.Value().(map[K]V), keys)
+		},
+		ErrorKeyOnlyKeys,
+		map[string]any{"title": validator.context.title, "keys": joinMapKeys(keys), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a map passes a custom function.
+// For example:
+//
+//	quotas := map[string]int{"cpu": 4}
+//	Is(v.Map(quotas).Passing(func(v map[string]int) bool {
+//		return v["cpu"] <= 8
+//	}))
+func (validator *ValidatorMap[K, V]) Passing(function func(v0 map[K]V) bool, template ...string) *ValidatorMap[K, V] {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(map[K]V))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate each key of a map with the validator returned by the function. The
+// errors are added to the path of the entry, see [MapKeyPath]. If the key
+// validator has no name, the title of the map is used in its error messages.
+// Returning nil skips the key. A Not() before EachKey is ignored.
+//
+// For example:
+//
+//	labels := map[string]string{"App": "api"}
+//	val := Is(v.Map(labels, "labels").EachKey(func(key string) v.Validator {
+//		return v.String(key).MatchingTo(lowercase)
+//	}))
+//	val.Errors()["labels.App"]
+func (validator *ValidatorMap[K, V]) EachKey(function func(key K) Validator) *ValidatorMap[K, V] {
+	validator.context.addNested(
+		func(name string, title string, shortCircuit bool, validation *Validation) bool {
+			valid := true
+			for key := range validator.context.Value().(map[K]V) {
+				keyValidator := function(key)
+				if keyValidator == nil {
+					continue
+				}
+				if !validateNestedValidator(keyValidator, MapKeyPath(name, key), title, shortCircuit, validation) {
+					valid = false
+				}
+			}
+			return valid
+		})
+
+	return validator
+}
+
+// Validate each value of a map with the validator returned by the function.
+// The errors are added to the path of the entry, see [MapKeyPath]. If the
+// value validator has no name, the title of the map is used in its error
+// messages. Returning nil skips the value. A Not() before EachValue is
+// ignored.
+//
+// For example:
+//
+//	quotas := map[string]int{"cpu": 4, "memory": -1}
+//	val := Is(v.Map(quotas, "quotas").EachValue(func(key string, value int) v.Validator {
+//		return v.Int(value).Positive()
+//	}))
+//	val.Errors()["quotas.memory"] // Quotas must be positive
+func (validator *ValidatorMap[K, V]) EachValue(function func(key K, value V) Validator) *ValidatorMap[K, V] {
+	validator.context.addNested(
+		func(name string, title string, shortCircuit bool, validation *Validation) bool {
+			valid := true
+			for key, value := range validator.context.Value().(map[K]V) {
+				valueValidator := function(key, value)
+				if valueValidator == nil {
+					continue
+				}
+				if !validateNestedValidator(valueValidator, MapKeyPath(name, key), title, shortCircuit, validation) {
+					valid = false
+				}
+			}
+			return valid
+		})
+
+	return validator
+}
@@ -0,0 +1,244 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapKeyPath(t *testing.T) {
+
+	assert.Equal(t, "labels.env", MapKeyPath("labels", "env"))
+	assert.Equal(t, "labels.app%2Ekubernetes%2Eio", MapKeyPath("labels", "app.kubernetes.io"))
+	assert.Equal(t, "labels.a%5B0%5D", MapKeyPath("labels", "a[0]"))
+	assert.Equal(t, "labels.100%25", MapKeyPath("labels", "100%"))
+	assert.Equal(t, "quotas.1", MapKeyPath("quotas", 1))
+}
+
+func TestValidatorMapNot(t *testing.T) {
+
+	v := Is(Map(map[string]int{"a": 1}).Not().Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorMapEmpty(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Map(map[string]int{}).Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	var nilMap map[string]int
+	v = Is(Map(nilMap).Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(map[string]int{"a": 1}, "quotas").Empty())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Quotas must be empty",
+		v.Errors()["quotas"].Messages()[0])
+}
+
+func TestValidatorMapLength(t *testing.T) {
+
+	var v *Validation
+
+	quotas := map[string]int{"cpu": 1, "memory": 2}
+
+	v = Is(Map(quotas).Length(2).MinLength(1).MaxLength(2).LengthBetween(2, 3))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(quotas).Length(3))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must have a length equal to \"3\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Map(quotas).MinLength(3))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must not have a length shorter than \"3\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Map(quotas).MaxLength(1))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must not have a length longer than \"1\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Map(quotas).LengthBetween(3, 4))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must have a length between \"3\" and \"4\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorMapHasKeys(t *testing.T) {
+
+	var v *Validation
+
+	labels := map[string]string{"app": "api", "env": "prod"}
+
+	v = Is(Map(labels).HasKeys([]string{"app", "env"}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(labels, "labels").HasKeys([]string{"app", "team"}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Labels must have the keys \"app, team\"",
+		v.Errors()["labels"].Messages()[0])
+}
+
+func TestValidatorMapOnlyKeys(t *testing.T) {
+
+	var v *Validation
+
+	labels := map[string]string{"app": "api", "env": "prod"}
+
+	v = Is(Map(labels).OnlyKeys([]string{"app", "env", "team"}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(labels, "labels").OnlyKeys([]string{"app"}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Labels can only have the keys \"app\"",
+		v.Errors()["labels"].Messages()[0])
+}
+
+func TestValidatorMapPassing(t *testing.T) {
+
+	var v *Validation
+
+	quotas := map[string]int{"cpu": 4}
+
+	v = Is(Map(quotas).Passing(func(m map[string]int) bool { return m["cpu"] <= 8 }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(quotas).Passing(func(m map[string]int) bool { return m["cpu"] <= 2 }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorMapEachKey(t *testing.T) {
+
+	var v *Validation
+
+	labels := map[string]string{"app": "api", "": "prod"}
+	eachKey := func(key string) Validator {
+		return String(key).Not().Blank()
+	}
+
+	v = Is(Map(map[string]string{"app": "api"}, "labels").EachKey(eachKey))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(labels, "labels").EachKey(eachKey))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Equal(t,
+		"Labels can't be blank",
+		v.Errors()["labels."].Messages()[0])
+}
+
+func TestValidatorMapEachValue(t *testing.T) {
+
+	var v *Validation
+
+	quotas := map[string]int{"cpu": 4, "memory": -1, "disk": 0}
+	eachValue := func(key string, value int) Validator {
+		return Int(value).Positive()
+	}
+
+	v = Is(Map(map[string]int{"cpu": 4}, "quotas").EachValue(eachValue))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Map(quotas, "quotas").EachValue(eachValue))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t,
+		"Quotas must be positive",
+		v.Errors()["quotas.memory"].Messages()[0])
+	assert.Equal(t,
+		"Quotas must be positive",
+		v.Errors()["quotas.disk"].Messages()[0])
+
+	assert.False(t, v.PathValid("quotas"))
+	assert.False(t, v.PathValid("quotas.memory"))
+	assert.True(t, v.PathValid("quotas.cpu"))
+	// A Not() before EachValue is ignored
+	v = Is(Map(quotas, "quotas").Not().EachValue(eachValue))
+	assert.Len(t, v.Errors(), 2)
+}
+
+func TestValidatorMapEachValueWithEscapedKeys(t *testing.T) {
+
+	labels := map[string]string{
+		"app.kubernetes.io/name": "",
+		"items[0]":               "",
+		"app":                    "",
+	}
+
+	v := Is(Map(labels, "labels").EachValue(func(key string, value string) Validator {
+		return String(value).Not().Blank()
+	}))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 3)
+
+	assert.Contains(t, v.Errors(), MapKeyPath("labels", "app.kubernetes.io/name"))
+	assert.Contains(t, v.Errors(), MapKeyPath("labels", "items[0]"))
+	assert.Contains(t, v.Errors(), "labels.app")
+
+	assert.False(t, v.PathValid(MapKeyPath("labels", "app.kubernetes.io/name")))
+	assert.False(t, v.PathValid(MapKeyPath("labels", "items[0]")))
+	assert.False(t, v.PathValid("labels"))
+
+	// The escaped keys don't produce unrelated namespaces
+	assert.True(t, v.PathValid("labels.app%2Ekubernetes"))
+	assert.True(t, v.PathValid("labels.items"))
+}
+
+func TestValidatorMapEachKeyAndValueSamePath(t *testing.T) {
+
+	labels := map[string]string{"App": ""}
+
+	v := Check(Map(labels, "labels").
+		EachKey(func(key string) Validator {
+			return String(key).GreaterOrEqualTo("a")
+		}).
+		EachValue(func(key string, value string) Validator {
+			return String(value).Not().Blank()
+		}))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Equal(t,
+		[]string{"Labels must be greater than or equal to \"a\"", "Labels can't be blank"},
+		v.Errors()["labels.App"].Messages())
+}
+
+func TestValidatorMapEachValueNested(t *testing.T) {
+
+	type Limit struct {
+		Min int
+		Max int
+	}
+
+	limits := map[string]Limit{"cpu": {Min: 4, Max: 2}}
+
+	v := Is(Map(limits, "limits").EachValue(func(key string, value Limit) Validator {
+		return Number(value.Max, "max").GreaterOrEqualTo(value.Min)
+	}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Max must be greater than or equal to \"4\"",
+		v.Errors()["limits.cpu"].Messages()[0])
+}
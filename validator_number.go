@@ -1,5 +1,13 @@
 package valgo
 
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
 //go:generate go run generator/main.go
 
 // Custom generic type covering all numeric types. This type is used as the
@@ -50,6 +58,236 @@ func isNumberInSlice[T TypeNumber](v T, slice []T) bool {
 	return false
 }
 
+// Integer types supported by the divisibility rules of ValidatorInt and
+// ValidatorUint.
+type typeInteger interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// A numeric Go type used by the FitsIn rules to check if a value can be
+// converted to it without overflowing or losing precision. Use [NumberTypeOf]
+// to get one; the zero value doesn't hold any value.
+type NumberType struct {
+	kind reflect.Kind
+	bits int
+}
+
+// Return the [NumberType] of T. For example:
+//
+//	Is(v.Int64(id).FitsIn(v.NumberTypeOf[int32]()))
+func NumberTypeOf[T TypeNumber]() NumberType {
+	t := reflect.TypeFor[T]()
+	return NumberType{kind: t.Kind(), bits: t.Bits()}
+}
+
+// Return the name of the underlying Go type, such as "int32".
+func (t NumberType) String() string {
+	return t.kind.String()
+}
+
+func isIntegerMultipleOf[T typeInteger](v T, n T) bool {
+	// Zero has no multiples other than itself
+	if n == 0 {
+		return v == 0
+	}
+	return v%n == 0
+}
+
+func isIntegerEven[T typeInteger](v T) bool {
+	return v%2 == 0
+}
+
+func isIntegerOdd[T typeInteger](v T) bool {
+	return v%2 != 0
+}
+
+func isIntegerPowerOfTwo[T typeInteger](v T) bool {
+	return v > 0 && v&(v-1) == 0
+}
+
+// Report if the quotient of v by n is an integer, tolerating the rounding
+// error of the floating point representation, so 0.3 is a multiple of 0.1.
+func isFloatMultipleOf(v float64, n float64, bitSize int) bool {
+	if n == 0 {
+		return v == 0
+	}
+
+	q := v / n
+	if math.IsNaN(q) || math.IsInf(q, 0) {
+		return false
+	}
+
+	epsilon := 0x1p-52
+	if bitSize == 32 {
+		epsilon = 0x1p-23
+	}
+
+	return math.Abs(q-math.Round(q)) <= 4*epsilon*math.Max(1, math.Abs(q))
+}
+
+// Report if the shortest decimal representation of v has at most max decimal
+// places.
+func isFloatDecimalPlaces(v float64, max int, bitSize int) bool {
+	if math.IsNaN(v) || math.IsInf(v, 0) || max < 0 {
+		return false
+	}
+
+	s := strconv.FormatFloat(v, 'f', -1, bitSize)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s)-i-1 <= max
+	}
+	return true
+}
+
+func isFloatEqualWithin(v float64, value float64, epsilon float64) bool {
+	return math.Abs(v-value) <= epsilon
+}
+
+func isFloatEven(v float64) bool {
+	return v == math.Trunc(v) && math.Mod(v, 2) == 0
+}
+
+func isFloatOdd(v float64) bool {
+	return v == math.Trunc(v) && math.Abs(math.Mod(v, 2)) == 1
+}
+
+func isFloatPowerOfTwo(v float64) bool {
+	frac, exp := math.Frexp(v)
+	return frac == 0.5 && exp >= 1
+}
+
+// Return the kind of T, so the rules of ValidatorNumber can pick the integer
+// or floating point arithmetic.
+func numberKind[T TypeNumber]() reflect.Kind {
+	return reflect.TypeFor[T]().Kind()
+}
+
+// Return the size of T in bits.
+func numberBitSize[T TypeNumber]() int {
+	return reflect.TypeFor[T]().Bits()
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isSignedKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Int64
+}
+
+func isNumberMultipleOf[T TypeNumber](v T, n T) bool {
+	switch kind := numberKind[T](); {
+	case isFloatKind(kind):
+		return isFloatMultipleOf(float64(v), float64(n), numberBitSize[T]())
+	case isSignedKind(kind):
+		return isIntegerMultipleOf(int64(v), int64(n))
+	default:
+		return isIntegerMultipleOf(uint64(v), uint64(n))
+	}
+}
+
+func isNumberEven[T TypeNumber](v T) bool {
+	switch kind := numberKind[T](); {
+	case isFloatKind(kind):
+		return isFloatEven(float64(v))
+	case isSignedKind(kind):
+		return isIntegerEven(int64(v))
+	default:
+		return isIntegerEven(uint64(v))
+	}
+}
+
+func isNumberOdd[T TypeNumber](v T) bool {
+	switch kind := numberKind[T](); {
+	case isFloatKind(kind):
+		return isFloatOdd(float64(v))
+	case isSignedKind(kind):
+		return isIntegerOdd(int64(v))
+	default:
+		return isIntegerOdd(uint64(v))
+	}
+}
+
+func isNumberPowerOfTwo[T TypeNumber](v T) bool {
+	switch kind := numberKind[T](); {
+	case isFloatKind(kind):
+		return isFloatPowerOfTwo(float64(v))
+	case isSignedKind(kind):
+		return isIntegerPowerOfTwo(int64(v))
+	default:
+		return isIntegerPowerOfTwo(uint64(v))
+	}
+}
+
+func isNumberDecimalPlaces[T TypeNumber](v T, max int) bool {
+	if isFloatKind(numberKind[T]()) {
+		return isFloatDecimalPlaces(float64(v), max, numberBitSize[T]())
+	}
+	return max >= 0
+}
+
+func isNumberEqualWithin[T TypeNumber](v T, value T, epsilon T) bool {
+	switch kind := numberKind[T](); {
+	case isFloatKind(kind):
+		return isFloatEqualWithin(float64(v), float64(value), float64(epsilon))
+	case epsilon < 0:
+		return false
+	case v >= value:
+		// The difference is computed in uint64 to avoid overflowing the
+		// signed types; the wrap-around leaves the right result.
+		return uint64(v)-uint64(value) <= uint64(epsilon)
+	default:
+		return uint64(value)-uint64(v) <= uint64(epsilon)
+	}
+}
+
+// Report if a numeric value can be converted to the type t without overflowing
+// or losing a fractional part. Values only fit in floating point types when
+// they are represented exactly, and NaN and infinities fit in any of them.
+func isNumberFitsIn(value any, t NumberType) bool {
+	if t.kind == reflect.Invalid {
+		return false
+	}
+
+	rv := reflect.ValueOf(value)
+
+	var f *big.Float
+	switch {
+	case rv.CanInt():
+		f = new(big.Float).SetInt64(rv.Int())
+	case rv.CanUint():
+		f = new(big.Float).SetUint64(rv.Uint())
+	case rv.CanFloat():
+		x := rv.Float()
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return isFloatKind(t.kind)
+		}
+		f = new(big.Float).SetFloat64(x)
+	default:
+		return false
+	}
+
+	switch {
+	case t.kind == reflect.Float64:
+		_, accuracy := f.Float64()
+		return accuracy == big.Exact
+	case t.kind == reflect.Float32:
+		_, accuracy := f.Float32()
+		return accuracy == big.Exact
+	case !f.IsInt():
+		return false
+	}
+
+	i, _ := f.Int(nil)
+	if isSignedKind(t.kind) {
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(t.bits-1))
+		return i.Cmp(new(big.Int).Neg(limit)) >= 0 && i.Cmp(limit) < 0
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.bits))
+	return i.Sign() >= 0 && i.Cmp(limit) < 0
+}
+
 // The [ValidatorNumber] provides functions for setting validation rules for a
 // [TypeNumber] value type, or a custom type based on a [TypeNumber].
 //
@@ -291,3 +529,120 @@ func (validator *ValidatorNumber[T]) InSlice(slice []T, template ...string) *Val
 
 	return validator
 }
+
+// Validate if a numeric value is a multiple of another, tolerating the rounding
+// error of floating point numbers, so 0.3 is a multiple of 0.1. Zero is only a
+// multiple of itself.
+//
+// For example:
+//
+//	Is(v.Number(12).MultipleOf(4))
+func (validator *ValidatorNumber[T]) MultipleOf(value T, template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isNumberMultipleOf(validator.context.Value().(T), value)
+		},
+		ErrorKeyMultipleOf, value, template...)
+
+	return validator
+}
+
+// Validate if a numeric value is an even number.
+//
+// For example:
+//
+//	Is(v.Number(4).Even())
+func (validator *ValidatorNumber[T]) Even(template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isNumberEven(validator.context.Value().(T))
+		},
+		ErrorKeyEven, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric value is an odd number.
+//
+// For example:
+//
+//	Is(v.Number(5).Odd())
+func (validator *ValidatorNumber[T]) Odd(template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isNumberOdd(validator.context.Value().(T))
+		},
+		ErrorKeyOdd, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric value is a power of two, such as 1, 2, 4 or 1024.
+//
+// For example:
+//
+//	Is(v.Number(64).PowerOfTwo())
+func (validator *ValidatorNumber[T]) PowerOfTwo(template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isNumberPowerOfTwo(validator.context.Value().(T))
+		},
+		ErrorKeyPowerOfTwo, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric value has at most a number of decimal places. Integer
+// values have no decimal places.
+//
+// For example:
+//
+//	Is(v.Number(19.99).DecimalPlaces(2))
+func (validator *ValidatorNumber[T]) DecimalPlaces(max int, template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isNumberDecimalPlaces(validator.context.Value().(T), max)
+		},
+		ErrorKeyMaxScale,
+		map[string]any{"title": validator.context.title, "scale": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value is equal to another within a tolerance, that is,
+// if the absolute difference between them is less than or equal to epsilon.
+//
+// For example:
+//
+//	Is(v.Number(0.30000000000000004).EqualWithin(0.3, 1e-9))
+func (validator *ValidatorNumber[T]) EqualWithin(value T, epsilon T, template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isNumberEqualWithin(validator.context.Value().(T), value, epsilon)
+		},
+		ErrorKeyEqualWithin,
+		map[string]any{"title": validator.context.title, "value": value, "epsilon": epsilon},
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric value can be converted to another numeric type without
+// overflowing or losing a fractional part. It is useful when narrowing values,
+// for example to store an int64 from an API in an int32 column.
+//
+// For example:
+//
+//	Is(v.Number(42).FitsIn(v.NumberTypeOf[int32]()))
+func (validator *ValidatorNumber[T]) FitsIn(t NumberType, template ...string) *ValidatorNumber[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isNumberFitsIn(validator.context.Value().(T), t)
+		},
+		ErrorKeyFitsIn,
+		map[string]any{"title": validator.context.title, "type": t.String(), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
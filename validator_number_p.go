@@ -273,3 +273,129 @@ func (validator *ValidatorNumberP[T]) InSlice(slice []T, template ...string) *Va
 
 	return validator
 }
+
+// Validate if a numeric pointer value is a multiple of another, tolerating the
+// rounding error of floating point numbers, so 0.3 is a multiple of 0.1. Zero
+// is only a multiple of itself.
+//
+// For example:
+//
+//	n := float64(12)
+//	Is(v.NumberP(&n).MultipleOf(4))
+func (validator *ValidatorNumberP[T]) MultipleOf(value T, template ...string) *ValidatorNumberP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isNumberMultipleOf(*(validator.context.Value().(*T)), value)
+		},
+		ErrorKeyMultipleOf, value, template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is an even number.
+//
+// For example:
+//
+//	n := float64(4)
+//	Is(v.NumberP(&n).Even())
+func (validator *ValidatorNumberP[T]) Even(template ...string) *ValidatorNumberP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isNumberEven(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyEven, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is an odd number.
+//
+// For example:
+//
+//	n := float64(5)
+//	Is(v.NumberP(&n).Odd())
+func (validator *ValidatorNumberP[T]) Odd(template ...string) *ValidatorNumberP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isNumberOdd(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyOdd, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is a power of two, such as 1, 2, 4 or
+// 1024.
+//
+// For example:
+//
+//	n := float64(64)
+//	Is(v.NumberP(&n).PowerOfTwo())
+func (validator *ValidatorNumberP[T]) PowerOfTwo(template ...string) *ValidatorNumberP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isNumberPowerOfTwo(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyPowerOfTwo, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value has at most a number of decimal places.
+// Integer values have no decimal places.
+//
+// For example:
+//
+//	n := float64(19.99)
+//	Is(v.NumberP(&n).DecimalPlaces(2))
+func (validator *ValidatorNumberP[T]) DecimalPlaces(max int, template ...string) *ValidatorNumberP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isNumberDecimalPlaces(*(validator.context.Value().(*T)), max)
+		},
+		ErrorKeyMaxScale,
+		map[string]any{"title": validator.context.title, "scale": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is equal to another within a tolerance,
+// that is, if the absolute difference between them is less than or equal to
+// epsilon.
+//
+// For example:
+//
+//	n := float64(0.30000000000000004)
+//	Is(v.NumberP(&n).EqualWithin(0.3, 1e-9))
+func (validator *ValidatorNumberP[T]) EqualWithin(value T, epsilon T, template ...string) *ValidatorNumberP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isNumberEqualWithin(*(validator.context.Value().(*T)), value, epsilon)
+		},
+		ErrorKeyEqualWithin,
+		map[string]any{"title": validator.context.title, "value": value, "epsilon": epsilon},
+		template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value can be converted to another numeric type
+// without overflowing or losing a fractional part. It is useful when narrowing
+// values, for example to store an int64 from an API in an int32 column.
+//
+// For example:
+//
+//	n := float64(42)
+//	Is(v.NumberP(&n).FitsIn(v.NumberTypeOf[int32]()))
+func (validator *ValidatorNumberP[T]) FitsIn(t NumberType, template ...string) *ValidatorNumberP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isNumberFitsIn(*(validator.context.Value().(*T)), t)
+		},
+		ErrorKeyFitsIn,
+		map[string]any{"title": validator.context.title, "type": t.String(), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
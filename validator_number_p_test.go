@@ -852,3 +852,29 @@ func TestValidatorNumberPOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorNumberPDivisibilityAndPrecision(t *testing.T) {
+
+	var v *Validation
+
+	n := 32
+	price := 9.99
+	v = Is(NumberP(&n).MultipleOf(4).Even().PowerOfTwo().FitsIn(NumberTypeOf[uint8]())).
+		Is(NumberP(&price).DecimalPlaces(2).EqualWithin(10, 0.01))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(NumberP(&n, "seats").Odd())
+	assert.Equal(t,
+		"Seats must be an odd number",
+		v.Errors()["seats"].Messages()[0])
+
+	var nilNumber *int
+	for _, validator := range []*ValidatorNumberP[int]{
+		NumberP(nilNumber).MultipleOf(1), NumberP(nilNumber).Even(), NumberP(nilNumber).Odd(),
+		NumberP(nilNumber).PowerOfTwo(), NumberP(nilNumber).DecimalPlaces(2),
+		NumberP(nilNumber).EqualWithin(0, 1), NumberP(nilNumber).FitsIn(NumberTypeOf[int]()),
+	} {
+		assert.False(t, Is(validator).Valid())
+	}
+}
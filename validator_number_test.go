@@ -629,3 +629,75 @@ func TestValidatorNumberOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorNumberDivisibility(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Number(12).MultipleOf(4).Even()).
+		Is(Number(uint8(7)).Odd()).
+		Is(Number(1024).PowerOfTwo()).
+		Is(Number(0.3).MultipleOf(0.1)).
+		Is(Number(4.0).Even().PowerOfTwo()).
+		Is(Number(-3.0).Odd())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.False(t, Is(Number(4.5).Even()).Valid())
+	assert.False(t, Is(Number(4.5).Odd()).Valid())
+	assert.False(t, Is(Number(0.5).PowerOfTwo()).Valid())
+	assert.False(t, Is(Number(-4).PowerOfTwo()).Valid())
+	assert.False(t, Is(Number(uint(7)).MultipleOf(2)).Valid())
+
+	v = Is(Number(9, "seats").Even())
+	assert.Equal(t,
+		"Seats must be an even number",
+		v.Errors()["seats"].Messages()[0])
+}
+
+func TestValidatorNumberDecimalPlacesAndEqualWithin(t *testing.T) {
+
+	var v *Validation
+
+	tenth := 0.1
+	v = Is(Number(19.99).DecimalPlaces(2)).
+		Is(Number(1999).DecimalPlaces(0)).
+		Is(Number(tenth+0.2).EqualWithin(0.3, 1e-9)).
+		Is(Number(10).EqualWithin(12, 2)).
+		Is(Number(uint(12)).EqualWithin(10, 2)).
+		Is(Number(int8(127)).Not().EqualWithin(-128, 127)).
+		Is(Number(int8(-1)).EqualWithin(126, 127))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.False(t, Is(Number(19.999).DecimalPlaces(2)).Valid())
+	assert.False(t, Is(Number(10).EqualWithin(13, 2)).Valid())
+	assert.False(t, Is(Number(10).EqualWithin(10, -1)).Valid())
+
+	v = Is(Number(10, "retries").EqualWithin(13, 2))
+	assert.Equal(t,
+		"Retries must be equal to \"13\" within \"2\"",
+		v.Errors()["retries"].Messages()[0])
+}
+
+func TestValidatorNumberFitsIn(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Number(int64(42)).FitsIn(NumberTypeOf[int8]())).
+		Is(Number(42.0).FitsIn(NumberTypeOf[uint8]()))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.False(t, Is(Number(int64(-1)).FitsIn(NumberTypeOf[uint64]())).Valid())
+	assert.False(t, Is(Number(0.5).FitsIn(NumberTypeOf[int64]())).Valid())
+	assert.False(t, Is(Number(uint64(1<<64-1)).FitsIn(NumberTypeOf[float64]())).Valid())
+	assert.False(t, Is(Number(0).FitsIn(NumberType{})).Valid())
+
+	assert.Equal(t, "uint8", NumberTypeOf[byte]().String())
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(Number(int64(3_000_000_000), "id", "Id").FitsIn(NumberTypeOf[int32]()))
+	assert.Equal(t,
+		"Id debe caber en el tipo \"int32\"",
+		v.Errors()["id"].Messages()[0])
+}
@@ -0,0 +1,327 @@
+package valgo
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+)
+
+// A closed range of ordered values, used by the `Within` and `OneOfRanges`
+// rules of the Ordered validators. Both Min and Max are inclusive.
+type OrderedRange[T cmp.Ordered] struct {
+	Min T
+	Max T
+}
+
+// Report if a value is inside the range.
+func (r OrderedRange[T]) Contains(value T) bool {
+	return value >= r.Min && value <= r.Max
+}
+
+// Return the range formatted as "[min, max]".
+func (r OrderedRange[T]) String() string {
+	return fmt.Sprintf("[%v, %v]", r.Min, r.Max)
+}
+
+// The Ordered validator type that keeps its validator context.
+type ValidatorOrdered[T cmp.Ordered] struct {
+	context *ValidatorContext
+}
+
+// Receive a value of any ordered type to validate.
+//
+// The value can be any type supporting the `<` operator: integers, floats,
+// strings, or a custom type based on them such as `type Priority uint8`.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A value_%N pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name phone_number will be
+// humanized as Phone Number.
+//
+// Example:
+//
+//	type Priority uint8
+//	v.Is(v.Ordered(Priority(3), "priority").Between(1, 5))
+func Ordered[T cmp.Ordered](value T, nameAndTitle ...string) *ValidatorOrdered[T] {
+	return &ValidatorOrdered[T]{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorOrdered[T]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the logical value associated with the next validator function.
+// For example:
+//
+//	// It will return false because `Not()` inverts the boolean value associated with `GreaterThan()`
+//	v.Is(v.Ordered(10).Not().GreaterThan(5)).Valid()
+func (validator *ValidatorOrdered[T]) Not() *ValidatorOrdered[T] {
+	validator.context.Not()
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the priority is zero (Zero() OR Between(1, 5)).
+//	isValid := v.Is(v.Ordered(Priority(0)).Zero().Or().Between(1, 5)).Valid()
+func (validator *ValidatorOrdered[T]) Or() *ValidatorOrdered[T] {
+	validator.context.Or()
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the priority is zero, the chain succeeds and the rest is not evaluated.
+//	// Otherwise, the priority must be between 1 and 5.
+//	isValid := v.Is(v.Ordered(priority).Zero().OrElse().GreaterOrEqualTo(1).LessOrEqualTo(5)).Valid()
+func (validator *ValidatorOrdered[T]) OrElse() *ValidatorOrdered[T] {
+	validator.context.OrElse()
+	return validator
+}
+
+// Validate if an ordered value is equal to another.
+// For example:
+//
+//	Is(v.Ordered(Priority(2)).EqualTo(2))
+func (validator *ValidatorOrdered[T]) EqualTo(value T, template ...string) *ValidatorOrdered[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(T) == value
+		},
+		ErrorKeyEqualTo, value, template...)
+
+	return validator
+}
+
+// Validate if an ordered value is greater than another.
+// For example:
+//
+//	Is(v.Ordered(Priority(3)).GreaterThan(2))
+func (validator *ValidatorOrdered[T]) GreaterThan(value T, template ...string) *ValidatorOrdered[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(T) > value
+		},
+		ErrorKeyGreaterThan, value, template...)
+
+	return validator
+}
+
+// Validate if an ordered value is greater than or equal to another.
+// For example:
+//
+//	Is(v.Ordered(Priority(3)).GreaterOrEqualTo(3))
+func (validator *ValidatorOrdered[T]) GreaterOrEqualTo(value T, template ...string) *ValidatorOrdered[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(T) >= value
+		},
+		ErrorKeyGreaterOrEqualTo, value, template...)
+
+	return validator
+}
+
+// Validate if an ordered value is less than another.
+// For example:
+//
+//	Is(v.Ordered("apple").LessThan("banana"))
+func (validator *ValidatorOrdered[T]) LessThan(value T, template ...string) *ValidatorOrdered[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(T) < value
+		},
+		ErrorKeyLessThan, value, template...)
+
+	return validator
+}
+
+// Validate if an ordered value is less than or equal to another.
+// For example:
+//
+//	Is(v.Ordered(Priority(5)).LessOrEqualTo(5))
+func (validator *ValidatorOrdered[T]) LessOrEqualTo(value T, template ...string) *ValidatorOrdered[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(T) <= value
+		},
+		ErrorKeyLessOrEqualTo, value, template...)
+
+	return validator
+}
+
+// Validate if an ordered value is within a range (inclusive).
+// For example:
+//
+//	Is(v.Ordered(Priority(3)).Between(1, 5))
+func (validator *ValidatorOrdered[T]) Between(min T, max T, template ...string) *ValidatorOrdered[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return OrderedRange[T]{Min: min, Max: max}.Contains(validator.context.Value().(T))
+		},
+		ErrorKeyBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if an ordered value is the zero value of its type.
+// For example:
+//
+//	Is(v.Ordered(Priority(0)).Zero())
+func (validator *ValidatorOrdered[T]) Zero(template ...string) *ValidatorOrdered[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			var zero T
+			return validator.context.Value().(T) == zero
+		},
+		ErrorKeyZero, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if an ordered value is within the bounds of a set of ranges, the
+// way a value clamped to them would be left untouched: it must be between the
+// lowest minimum and the highest maximum of the ranges, gaps included. The
+// validation fails when no range is given.
+// For example:
+//
+//	tiers := []v.OrderedRange[Priority]{{Min: 1, Max: 3}, {Min: 7, Max: 9}}
+//	Is(v.Ordered(Priority(5)).Within(tiers)) // Will be true
+func (validator *ValidatorOrdered[T]) Within(ranges []OrderedRange[T], template ...string) *ValidatorOrdered[T] {
+	bounds, ok := orderedRangesBounds(ranges)
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	if ok {
+		params["min"] = bounds.Min
+		params["max"] = bounds.Max
+	}
+
+	validator.context.AddWithParams(
+		func() bool {
+			return ok && bounds.Contains(validator.context.Value().(T))
+		},
+		ErrorKeyBetween, params, template...)
+
+	return validator
+}
+
+// Validate if an ordered value is inside at least one of a set of ranges.
+// Unlike `Within`, a value in a gap between the ranges fails.
+// For example:
+//
+//	tiers := []v.OrderedRange[Priority]{{Min: 1, Max: 3}, {Min: 7, Max: 9}}
+//	Is(v.Ordered(Priority(8)).OneOfRanges(tiers))
+func (validator *ValidatorOrdered[T]) OneOfRanges(ranges []OrderedRange[T], template ...string) *ValidatorOrdered[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isOrderedInRanges(validator.context.Value().(T), ranges)
+		},
+		ErrorKeyOneOfRanges,
+		map[string]any{"title": validator.context.title, "ranges": orderedRangesString(ranges), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if an ordered value is present in a slice.
+// For example:
+//
+//	Is(v.Ordered(Priority(3)).InSlice([]Priority{1, 3, 5}))
+func (validator *ValidatorOrdered[T]) InSlice(slice []T, template ...string) *ValidatorOrdered[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			for _, v := range slice {
+				if validator.context.Value().(T) == v {
+					return true
+				}
+			}
+			return false
+		},
+		ErrorKeyInSlice, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if an ordered value passes a custom function.
+// For example:
+//
+//	Is(v.Ordered(priority).Passing(func(p Priority) bool {
+//		return p != reservedPriority
+//	}))
+func (validator *ValidatorOrdered[T]) Passing(function func(v T) bool, template ...string) *ValidatorOrdered[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(T))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+func orderedRangesBounds[T cmp.Ordered](ranges []OrderedRange[T]) (OrderedRange[T], bool) {
+	if len(ranges) == 0 {
+		return OrderedRange[T]{}, false
+	}
+
+	bounds := ranges[0]
+	for _, r := range ranges[1:] {
+		bounds.Min = min(bounds.Min, r.Min)
+		bounds.Max = max(bounds.Max, r.Max)
+	}
+
+	return bounds, true
+}
+
+func isOrderedInRanges[T cmp.Ordered](value T, ranges []OrderedRange[T]) bool {
+	for _, r := range ranges {
+		if r.Contains(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func orderedRangesString[T cmp.Ordered](ranges []OrderedRange[T]) string {
+	parts := make([]string, len(ranges))
+	for i, r := range ranges {
+		parts[i] = r.String()
+	}
+	return strings.Join(parts, ", ")
+}
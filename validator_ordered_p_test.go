@@ -0,0 +1,51 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorOrderedP(t *testing.T) {
+
+	var v *Validation
+
+	priority := testPriority(3)
+	tiers := []OrderedRange[testPriority]{{Min: 1, Max: 3}, {Min: 7, Max: 9}}
+	v = Is(OrderedP(&priority).EqualTo(3).GreaterThan(2).GreaterOrEqualTo(3).
+		LessThan(4).LessOrEqualTo(3).Between(1, 5).Within(tiers).OneOfRanges(tiers).
+		InSlice([]testPriority{1, 3}).Passing(func(p *testPriority) bool { return p != nil && *p == 3 }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	priority = 5
+	v = Is(OrderedP(&priority, "priority").OneOfRanges(tiers))
+	assert.Equal(t,
+		"Priority must be in one of the ranges [1, 3], [7, 9]",
+		v.Errors()["priority"].Messages()[0])
+
+	priority = 0
+	assert.True(t, Is(OrderedP(&priority).Zero().ZeroOrNil()).Valid())
+	assert.False(t, Is(OrderedP(&priority).Nil()).Valid())
+
+	var nilPriority *testPriority
+	assert.True(t, Is(OrderedP(nilPriority).Nil().ZeroOrNil()).Valid())
+	for _, validator := range []*ValidatorOrderedP[testPriority]{
+		OrderedP(nilPriority).EqualTo(0), OrderedP(nilPriority).GreaterThan(0),
+		OrderedP(nilPriority).GreaterOrEqualTo(0), OrderedP(nilPriority).LessThan(1),
+		OrderedP(nilPriority).LessOrEqualTo(0), OrderedP(nilPriority).Between(0, 1),
+		OrderedP(nilPriority).Zero(), OrderedP(nilPriority).Within(tiers),
+		OrderedP(nilPriority).OneOfRanges(tiers), OrderedP(nilPriority).InSlice([]testPriority{0}),
+		OrderedP(nilPriority).Passing(func(p *testPriority) bool { return p != nil }),
+	} {
+		assert.False(t, Is(validator).Valid())
+	}
+
+	v = Is(OrderedP(nilPriority, "priority").Between(1, 5))
+	assert.Equal(t,
+		"Priority must be between \"1\" and \"5\"",
+		v.Errors()["priority"].Messages()[0])
+
+	v = Is(OrderedP(&priority, "priority").Not().Nil())
+	assert.True(t, v.Valid())
+}
@@ -0,0 +1,147 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testPriority uint8
+
+func TestValidatorOrderedNot(t *testing.T) {
+
+	v := Is(Ordered(testPriority(3)).Not().EqualTo(4))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorOrderedComparisons(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Ordered(testPriority(3)).EqualTo(3).GreaterThan(2).GreaterOrEqualTo(3).
+		LessThan(4).LessOrEqualTo(3).Between(1, 5)).
+		Is(Ordered("banana").GreaterThan("apple").LessThan("cherry")).
+		Is(Ordered(2.5).Between(2.5, 2.5))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Ordered(testPriority(3), "priority").EqualTo(4))
+	assert.Equal(t,
+		"Priority must be equal to \"4\"",
+		v.Errors()["priority"].Messages()[0])
+
+	v = Is(Ordered(testPriority(3), "priority").GreaterThan(3))
+	assert.Equal(t,
+		"Priority must be greater than \"3\"",
+		v.Errors()["priority"].Messages()[0])
+
+	v = Is(Ordered(testPriority(3), "priority").GreaterOrEqualTo(4))
+	assert.Equal(t,
+		"Priority must be greater than or equal to \"4\"",
+		v.Errors()["priority"].Messages()[0])
+
+	v = Is(Ordered("cherry", "fruit").LessThan("banana"))
+	assert.Equal(t,
+		"Fruit must be less than \"banana\"",
+		v.Errors()["fruit"].Messages()[0])
+
+	v = Is(Ordered(testPriority(6), "priority").LessOrEqualTo(5))
+	assert.Equal(t,
+		"Priority must be less than or equal to \"5\"",
+		v.Errors()["priority"].Messages()[0])
+
+	v = Is(Ordered(testPriority(6), "priority").Between(1, 5))
+	assert.Equal(t,
+		"Priority must be between \"1\" and \"5\"",
+		v.Errors()["priority"].Messages()[0])
+
+	v = Is(Ordered(testPriority(3), "priority").Not().Between(1, 5))
+	assert.Equal(t,
+		"Priority can't be a value between \"1\" and \"5\"",
+		v.Errors()["priority"].Messages()[0])
+}
+
+func TestValidatorOrderedZeroInSliceAndPassing(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Ordered(testPriority(0)).Zero()).
+		Is(Ordered("").Zero()).
+		Is(Ordered(testPriority(3)).InSlice([]testPriority{1, 3, 5})).
+		Is(Ordered(testPriority(3)).Passing(func(p testPriority) bool { return p%2 == 1 }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Ordered(testPriority(1), "priority").Zero())
+	assert.Equal(t,
+		"Priority must be zero",
+		v.Errors()["priority"].Messages()[0])
+
+	v = Is(Ordered(testPriority(2), "priority").InSlice([]testPriority{1, 3, 5}))
+	assert.Equal(t,
+		"Priority is not valid",
+		v.Errors()["priority"].Messages()[0])
+
+	v = Is(Ordered(testPriority(2), "priority").Passing(func(p testPriority) bool { return p%2 == 1 }))
+	assert.Equal(t,
+		"Priority is not valid",
+		v.Errors()["priority"].Messages()[0])
+}
+
+func TestValidatorOrderedWithinAndOneOfRanges(t *testing.T) {
+
+	var v *Validation
+
+	tiers := []OrderedRange[testPriority]{{Min: 7, Max: 9}, {Min: 1, Max: 3}}
+
+	for _, p := range []testPriority{1, 3, 5, 7, 9} {
+		assert.True(t, Is(Ordered(p).Within(tiers)).Valid(), p)
+	}
+	for _, p := range []testPriority{0, 10} {
+		assert.False(t, Is(Ordered(p).Within(tiers)).Valid(), p)
+	}
+	assert.False(t, Is(Ordered(testPriority(1)).Within(nil)).Valid())
+
+	v = Is(Ordered(testPriority(10), "priority").Within(tiers))
+	assert.Equal(t,
+		"Priority must be between \"1\" and \"9\"",
+		v.Errors()["priority"].Messages()[0])
+
+	for _, p := range []testPriority{1, 2, 3, 7, 9} {
+		assert.True(t, Is(Ordered(p).OneOfRanges(tiers)).Valid(), p)
+	}
+	for _, p := range []testPriority{0, 4, 6, 10} {
+		assert.False(t, Is(Ordered(p).OneOfRanges(tiers)).Valid(), p)
+	}
+	assert.False(t, Is(Ordered(testPriority(1)).OneOfRanges(nil)).Valid())
+
+	v = Is(Ordered(testPriority(5), "priority").OneOfRanges(tiers))
+	assert.Equal(t,
+		"Priority must be in one of the ranges [7, 9], [1, 3]",
+		v.Errors()["priority"].Messages()[0])
+
+	v = Is(Ordered("m", "initial").Not().OneOfRanges([]OrderedRange[string]{{Min: "a", Max: "z"}}))
+	assert.Equal(t,
+		"Initial can't be in any of the ranges [a, z]",
+		v.Errors()["initial"].Messages()[0])
+}
+
+func TestValidatorOrderedOrOperators(t *testing.T) {
+
+	assert.True(t, Is(Ordered(testPriority(0)).Zero().Or().Between(1, 5)).Valid())
+	assert.False(t, Is(Ordered(testPriority(6)).Zero().Or().Between(1, 5)).Valid())
+
+	assert.True(t, Is(Ordered(testPriority(0)).Zero().OrElse().GreaterOrEqualTo(1).LessOrEqualTo(5)).Valid())
+	assert.True(t, Is(Ordered(testPriority(4)).Zero().OrElse().GreaterOrEqualTo(1).LessOrEqualTo(5)).Valid())
+	assert.False(t, Is(Ordered(testPriority(6)).Zero().OrElse().GreaterOrEqualTo(1).LessOrEqualTo(5)).Valid())
+}
+
+func TestValidatorOrderedLocales(t *testing.T) {
+
+	tiers := []OrderedRange[testPriority]{{Min: 1, Max: 3}, {Min: 7, Max: 9}}
+	v := New(Options{LocaleCode: LocaleCodeEs}).Is(Ordered(testPriority(5), "priority", "Prioridad").OneOfRanges(tiers))
+	assert.Equal(t,
+		"Prioridad debe estar en uno de los rangos [1, 3], [7, 9]",
+		v.Errors()["priority"].Messages()[0])
+}
@@ -0,0 +1,321 @@
+package valgo
+
+import (
+	"net/netip"
+	"reflect"
+)
+
+// Custom generic type covering the network prefix types accepted by
+// [ValidatorPrefix]: a [netip.Prefix], or a string type holding the CIDR
+// notation of the prefix.
+type TypePrefix interface {
+	netip.Prefix | ~string
+}
+
+var (
+	privatePrefixes = []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("172.16.0.0/12"),
+		netip.MustParsePrefix("192.168.0.0/16"),
+		netip.MustParsePrefix("fc00::/7"),
+	}
+	loopbackPrefixes = []netip.Prefix{
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("::1/128"),
+	}
+	multicastPrefixes = []netip.Prefix{
+		netip.MustParsePrefix("224.0.0.0/4"),
+		netip.MustParsePrefix("ff00::/8"),
+	}
+)
+
+// Convert the value to a [netip.Prefix]. Returns false if the value is not a
+// valid prefix.
+func prefixOf[T TypePrefix](v T) (netip.Prefix, bool) {
+	if prefix, ok := any(v).(netip.Prefix); ok {
+		return prefix, prefix.IsValid()
+	}
+	// The value is a string type, but the generic type can't be converted to a
+	// string directly because netip.Prefix is part of the type set.
+	prefix, err := netip.ParsePrefix(reflect.ValueOf(v).String())
+	return prefix, err == nil
+}
+
+// Check if all the addresses of the prefix are within one of the parent
+// prefixes.
+func isPrefixWithin(prefix netip.Prefix, parents []netip.Prefix) bool {
+	for _, p := range parents {
+		if p.Bits() <= prefix.Bits() && p.Contains(prefix.Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrefixOverlapping(prefix netip.Prefix, prefixes []netip.Prefix) bool {
+	for _, p := range prefixes {
+		if p.Overlaps(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// The `ValidatorPrefix` provides functions for setting validation rules for a
+// network prefix (CIDR), either a [netip.Prefix] value or a string with the
+// CIDR notation of the prefix, such as "10.0.0.0/8" or "2001:db8::/32".
+//
+// A string that is not a valid prefix, or the zero [netip.Prefix], doesn't
+// satisfy any rule.
+type ValidatorPrefix[T TypePrefix] struct {
+	context *ValidatorContext
+}
+
+// Receive a network prefix to validate.
+//
+// The value can be a [netip.Prefix], a string, or a custom string type such as
+// type Subnet string;.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A `value_%N` pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name phone_number will be
+// humanized as Phone Number.
+func Prefix[T TypePrefix](value T, nameAndTitle ...string) *ValidatorPrefix[T] {
+	return &ValidatorPrefix[T]{context: NewContext(value, nameAndTitle...)}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorPrefix[T]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the boolean value associated with the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Private() function
+//	Is(v.Prefix("10.0.0.0/8").Not().Private()).Valid()
+func (validator *ValidatorPrefix[T]) Not() *ValidatorPrefix[T] {
+	validator.context.Not()
+
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the prefix is private (Loopback() OR Private()).
+//	isValid := v.Is(v.Prefix("10.1.0.0/16").Loopback().Or().Private()).Valid()
+func (validator *ValidatorPrefix[T]) Or() *ValidatorPrefix[T] {
+	validator.context.Or()
+
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the prefix is a loopback prefix, the chain succeeds and the rest is not evaluated.
+//	// Otherwise, the prefix must be a masked private prefix.
+//	isValid := v.Is(v.Prefix(subnet).Loopback().OrElse().Private().Masked()).Valid()
+func (validator *ValidatorPrefix[T]) OrElse() *ValidatorPrefix[T] {
+	validator.context.OrElse()
+
+	return validator
+}
+
+// Validate if the value is a valid network prefix in CIDR notation.
+// For example:
+//
+//	Is(v.Prefix("10.0.0.0/8").Valid()) // Will be true
+//	Is(v.Prefix("10.0.0.0/33").Valid()) // Will be false
+func (validator *ValidatorPrefix[T]) Valid(template ...string) *ValidatorPrefix[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			_, ok := prefixOf(validator.context.Value().(T))
+			return ok
+		},
+		ErrorKeyPrefix, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a network prefix is an IPv4 prefix.
+// For example:
+//
+//	Is(v.Prefix("10.0.0.0/8").IPv4())
+func (validator *ValidatorPrefix[T]) IPv4(template ...string) *ValidatorPrefix[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			prefix, ok := prefixOf(validator.context.Value().(T))
+			return ok && prefix.Addr().Is4()
+		},
+		ErrorKeyIPv4, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a network prefix is an IPv6 prefix.
+// For example:
+//
+//	Is(v.Prefix("2001:db8::/32").IPv6())
+func (validator *ValidatorPrefix[T]) IPv6(template ...string) *ValidatorPrefix[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			prefix, ok := prefixOf(validator.context.Value().(T))
+			return ok && prefix.Addr().Is6()
+		},
+		ErrorKeyIPv6, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a network prefix has no bits set after the prefix length, as
+// "10.0.0.0/8" but not "10.1.2.3/8".
+// For example:
+//
+//	Is(v.Prefix("192.168.1.0/24").Masked())
+func (validator *ValidatorPrefix[T]) Masked(template ...string) *ValidatorPrefix[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			prefix, ok := prefixOf(validator.context.Value().(T))
+			return ok && prefix == prefix.Masked()
+		},
+		ErrorKeyMasked, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if all the addresses of a network prefix are private addresses,
+// according to RFC 1918 for IPv4 and RFC 4193 for IPv6.
+// For example:
+//
+//	Is(v.Prefix("10.1.0.0/16").Private()) // Will be true
+//	Is(v.Prefix("10.0.0.0/7").Private()) // Will be false
+func (validator *ValidatorPrefix[T]) Private(template ...string) *ValidatorPrefix[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			prefix, ok := prefixOf(validator.context.Value().(T))
+			return ok && isPrefixWithin(prefix, privatePrefixes)
+		},
+		ErrorKeyPrivate, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if all the addresses of a network prefix are loopback addresses.
+// For example:
+//
+//	Is(v.Prefix("127.0.0.0/8").Loopback())
+func (validator *ValidatorPrefix[T]) Loopback(template ...string) *ValidatorPrefix[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			prefix, ok := prefixOf(validator.context.Value().(T))
+			return ok && isPrefixWithin(prefix, loopbackPrefixes)
+		},
+		ErrorKeyLoopback, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if all the addresses of a network prefix are multicast addresses.
+// For example:
+//
+//	Is(v.Prefix("239.0.0.0/8").Multicast())
+func (validator *ValidatorPrefix[T]) Multicast(template ...string) *ValidatorPrefix[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			prefix, ok := prefixOf(validator.context.Value().(T))
+			return ok && isPrefixWithin(prefix, multicastPrefixes)
+		},
+		ErrorKeyMulticast, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a network prefix is a subnet of another prefix, so all its
+// addresses belong to it.
+// For example:
+//
+//	Is(v.Prefix("10.1.0.0/16").InPrefix(netip.MustParsePrefix("10.0.0.0/8")))
+func (validator *ValidatorPrefix[T]) InPrefix(parent netip.Prefix, template ...string) *ValidatorPrefix[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			prefix, ok := prefixOf(validator.context.Value().(T))
+			return ok && isPrefixWithin(prefix, []netip.Prefix{parent})
+		},
+		ErrorKeyInPrefix,
+		map[string]any{"title": validator.context.title, "prefix": parent.String(), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a network prefix doesn't overlap any of the given prefixes. This
+// is useful to avoid conflicts with already allocated networks.
+// For example:
+//
+//	allocated := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/16")}
+//	Is(v.Prefix("10.1.0.0/16").NotInPrefixes(allocated)) // Will be true
+//	Is(v.Prefix("10.0.0.0/8").NotInPrefixes(allocated)) // Will be false
+func (validator *ValidatorPrefix[T]) NotInPrefixes(prefixes []netip.Prefix, template ...string) *ValidatorPrefix[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			prefix, ok := prefixOf(validator.context.Value().(T))
+			return ok && !isPrefixOverlapping(prefix, prefixes)
+		},
+		ErrorKeyOutsidePrefixes,
+		map[string]any{"title": validator.context.title, "prefixes": joinPrefixes(prefixes), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a network prefix passes a custom function.
+// For example:
+//
+//	Is(v.Prefix(subnet).Passing(func(p netip.Prefix) bool {
+//		return p.Bits() >= 16
+//	}))
+func (validator *ValidatorPrefix[T]) Passing(function func(v0 T) bool, template ...string) *ValidatorPrefix[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(T))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
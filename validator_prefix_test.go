@@ -0,0 +1,132 @@
+package valgo
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorPrefixNot(t *testing.T) {
+
+	v := Is(Prefix("8.0.0.0/8").Not().Private())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorPrefixValid(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Prefix("10.0.0.0/8").Valid())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Prefix(netip.MustParsePrefix("2001:db8::/32")).Valid())
+	assert.True(t, v.Valid())
+
+	v = Is(Prefix("10.0.0.0/33", "subnet").Valid())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Subnet must be a valid network prefix",
+		v.Errors()["subnet"].Messages()[0])
+
+	v = Is(Prefix("10.0.0.1").Valid())
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorPrefixVersionAndMasked(t *testing.T) {
+
+	var v *Validation
+
+	assert.True(t, Is(Prefix("10.0.0.0/8").IPv4()).Valid())
+	assert.False(t, Is(Prefix("10.0.0.0/8").IPv6()).Valid())
+	assert.True(t, Is(Prefix("2001:db8::/32").IPv6()).Valid())
+
+	v = Is(Prefix("192.168.1.0/24").Masked())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Prefix("192.168.1.1/24").Masked())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must not have bits set after the prefix length",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorPrefixScopes(t *testing.T) {
+
+	var v *Validation
+
+	assert.True(t, Is(Prefix("10.1.0.0/16").Private()).Valid())
+	assert.True(t, Is(Prefix("fd00::/8").Private()).Valid())
+
+	// Partially private prefixes are not private
+	v = Is(Prefix("10.0.0.0/7").Private())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be private",
+		v.Errors()["value_0"].Messages()[0])
+
+	assert.True(t, Is(Prefix("127.0.0.0/8").Loopback()).Valid())
+	assert.True(t, Is(Prefix("::1/128").Loopback()).Valid())
+	assert.False(t, Is(Prefix("::/127").Loopback()).Valid())
+
+	assert.True(t, Is(Prefix("239.0.0.0/8").Multicast()).Valid())
+	assert.False(t, Is(Prefix("192.0.0.0/2").Multicast()).Valid())
+}
+
+func TestValidatorPrefixInPrefix(t *testing.T) {
+
+	var v *Validation
+
+	parent := netip.MustParsePrefix("10.0.0.0/8")
+
+	v = Is(Prefix("10.1.0.0/16").InPrefix(parent))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Prefix("10.0.0.0/8").InPrefix(parent))
+	assert.True(t, v.Valid())
+
+	v = Is(Prefix("10.0.0.0/7").InPrefix(parent))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be within \"10.0.0.0/8\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorPrefixNotInPrefixes(t *testing.T) {
+
+	var v *Validation
+
+	allocated := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/16")}
+
+	v = Is(Prefix("10.1.0.0/16").NotInPrefixes(allocated))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Prefix("10.0.0.0/8", "subnet").NotInPrefixes(allocated))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Subnet can't be within \"10.0.0.0/16\"",
+		v.Errors()["subnet"].Messages()[0])
+
+	v = Is(Prefix("10.0.128.0/24").NotInPrefixes(allocated))
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorPrefixPassing(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Prefix(netip.MustParsePrefix("10.0.0.0/16")).Passing(func(p netip.Prefix) bool { return p.Bits() >= 16 }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Prefix(netip.MustParsePrefix("10.0.0.0/8")).Passing(func(p netip.Prefix) bool { return p.Bits() >= 16 }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
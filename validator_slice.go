@@ -0,0 +1,389 @@
+package valgo
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func isSliceEmpty[T any](v []T) bool {
+	return len(v) == 0
+}
+func isSliceLength[T any](v []T, length int) bool {
+	return len(v) == length
+}
+func isSliceMinLength[T any](v []T, length int) bool {
+	return len(v) >= length
+}
+func isSliceMaxLength[T any](v []T, length int) bool {
+	return len(v) <= length
+}
+func isSliceLengthBetween[T any](v []T, min int, max int) bool {
+	return len(v) >= min && len(v) <= max
+}
+func isSliceContains[T comparable](v []T, value T) bool {
+	for _, _v := range v {
+		if _v == value {
+			return true
+		}
+	}
+	return false
+}
+func isSliceUnique[T comparable](v []T) bool {
+	seen := make(map[T]struct{}, len(v))
+	for _, _v := range v {
+		if _, exists := seen[_v]; exists {
+			return false
+		}
+		seen[_v] = struct{}{}
+	}
+	return true
+}
+func isSliceContainsDeep[T any](v []T, value T) bool {
+	for _, _v := range v {
+		if reflect.DeepEqual(_v, value) {
+			return true
+		}
+	}
+	return false
+}
+func isSliceUniqueDeep[T any](v []T) bool {
+	for i := range v {
+		for j := i + 1; j < len(v); j++ {
+			if reflect.DeepEqual(v[i], v[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+func isSliceUniqueBy[T any](v []T, keyFn func(item T) any) bool {
+	seen := make(map[any]struct{}, len(v))
+	for _, _v := range v {
+		key := keyFn(_v)
+		if _, exists := seen[key]; exists {
+			return false
+		}
+		seen[key] = struct{}{}
+	}
+	return true
+}
+
+// The functions used by the Contains and Unique rules to compare the elements of
+// a slice. [Slice] compares them with the golang `==` operator, and [AnySlice]
+// with [reflect.DeepEqual].
+type sliceEquality[T any] struct {
+	contains func(v []T, value T) bool
+	unique   func(v []T) bool
+}
+
+func comparableSliceEquality[T comparable]() sliceEquality[T] {
+	return sliceEquality[T]{contains: isSliceContains[T], unique: isSliceUnique[T]}
+}
+
+func deepSliceEquality[T any]() sliceEquality[T] {
+	return sliceEquality[T]{contains: isSliceContainsDeep[T], unique: isSliceUniqueDeep[T]}
+}
+
+// Validate each element of a slice with the validator returned by the function
+// and add the errors to the indexed paths of the elements. The errors are
+// added with the same format used by [Validation.InCell], so the errors of the
+// third element of the slice "tags" are added to the path "tags[2]".
+//
+// When the element validator has no name, the title of the slice is used in
+// the error messages.
+func validateSliceEach[T any](v []T, function func(item T, index int) Validator, name string, title string, shortCircuit bool, validation *Validation) bool {
+	valid := true
+	for i, item := range v {
+		validator := function(item, i)
+		if validator == nil {
+			continue
+		}
+
+		if !validateNestedValidator(validator, fmt.Sprintf("%s[%v]", name, i), title, shortCircuit, validation) {
+			valid = false
+		}
+	}
+	return valid
+}
+
+// The `ValidatorSlice` provides functions for setting validation rules for a
+// slice value type. The rules can validate the slice as a collection, for
+// example its length or the uniqueness of its elements, and each one of its
+// elements using the [ValidatorSlice.Each] function.
+type ValidatorSlice[T any] struct {
+	context  *ValidatorContext
+	equality sliceEquality[T]
+}
+
+// Receive a slice value to validate.
+//
+// The value can also be a custom slice type such as type Tags []string;.
+//
+// Optionally, the function can receive a name and title, in that order, to be
+// displayed in the error messages. A value_%N` pattern is used as a name in the
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name phone_numbers will be
+// humanized as Phone Numbers.
+func Slice[T comparable](value []T, nameAndTitle ...string) *ValidatorSlice[T] {
+	return &ValidatorSlice[T]{context: NewContext(value, nameAndTitle...), equality: comparableSliceEquality[T]()}
+}
+
+// Receive a slice value of any element type to validate, such as a slice of
+// slices or of structs with slice fields. It provides the same rules as
+// [Slice], but the Contains and Unique rules compare the elements with
+// [reflect.DeepEqual] instead of the golang `==` operator.
+//
+// Example:
+//
+//	matrix := [][]int{{1, 2}, {3}}
+//	Is(v.AnySlice(matrix, "matrix").Each(func(row []int, i int) v.Validator {
+//		return v.Slice(row).Not().Empty()
+//	}))
+func AnySlice[T any](value []T, nameAndTitle ...string) *ValidatorSlice[T] {
+	return &ValidatorSlice[T]{context: NewContext(value, nameAndTitle...), equality: deepSliceEquality[T]()}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorSlice[T]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the boolean value associated with the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Empty() function
+//	Is(v.Slice([]string{}).Not().Empty()).Valid()
+func (validator *ValidatorSlice[T]) Not() *ValidatorSlice[T] {
+	validator.context.Not()
+
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the slice is empty (Empty() OR MinLength(2)).
+//	tags := []string{}
+//	isValid := v.Is(v.Slice(tags).Empty().Or().MinLength(2)).Valid()
+func (validator *ValidatorSlice[T]) Or() *ValidatorSlice[T] {
+	validator.context.Or()
+
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the slice is empty, the chain succeeds and MinLength/Unique are not evaluated.
+//	// Otherwise, the slice must have MinLength(2) AND Unique().
+//	tags := []string{}
+//	isValid := v.Is(v.Slice(tags).Empty().OrElse().MinLength(2).Unique()).Valid()
+func (validator *ValidatorSlice[T]) OrElse() *ValidatorSlice[T] {
+	validator.context.OrElse()
+
+	return validator
+}
+
+// Validate if a slice is empty. A nil slice is also considered empty.
+// For example:
+//
+//	Is(v.Slice([]string{}).Empty()) // Will be true
+//	Is(v.Slice([]string{"a"}).Empty()) // Will be false
+func (validator *ValidatorSlice[T]) Empty(template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isSliceEmpty(validator.context.Value().([]T))
+		},
+		ErrorKeyEmpty, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate the number of elements of a slice.
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.Slice(tags).Length(2))
+func (validator *ValidatorSlice[T]) Length(length int, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isSliceLength(validator.context.Value().([]T), length)
+		},
+		ErrorKeyLength,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the minimum number of elements of a slice.
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.Slice(tags).MinLength(1))
+func (validator *ValidatorSlice[T]) MinLength(length int, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isSliceMinLength(validator.context.Value().([]T), length)
+		},
+		ErrorKeyMinLength,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the maximum number of elements of a slice.
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.Slice(tags).MaxLength(5))
+func (validator *ValidatorSlice[T]) MaxLength(length int, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isSliceMaxLength(validator.context.Value().([]T), length)
+		},
+		ErrorKeyMaxLength,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the number of elements of a slice is within a range (inclusive).
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.Slice(tags).LengthBetween(1, 5))
+func (validator *ValidatorSlice[T]) LengthBetween(min int, max int, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isSliceLengthBetween(validator.context.Value().([]T), min, max)
+		},
+		ErrorKeyLengthBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a slice contains a value. This function internally uses the
+// golang `==` operator, or [reflect.DeepEqual] for an [AnySlice].
+// For example:
+//
+//	roles := []string{"admin", "editor"}
+//	Is(v.Slice(roles).Contains("admin"))
+func (validator *ValidatorSlice[T]) Contains(value T, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.equality.contains(validator.context.Value().([]T), value)
+		},
+		ErrorKeyContains, value, template...)
+
+	return validator
+}
+
+// Validate if the elements of a slice are unique. This function internally
+// uses the golang `==` operator, or [reflect.DeepEqual] for an [AnySlice].
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.Slice(tags).Unique())
+func (validator *ValidatorSlice[T]) Unique(template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.equality.unique(validator.context.Value().([]T))
+		},
+		ErrorKeyUnique, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the keys returned by a function for each element of a slice are
+// unique. The keys must be comparable values, otherwise the function panics.
+// For example:
+//
+//	users := []User{{ID: 1}, {ID: 2}}
+//	Is(v.Slice(users).UniqueBy(func(u User) any { return u.ID }))
+func (validator *ValidatorSlice[T]) UniqueBy(keyFn func(item T) any, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isSliceUniqueBy(validator.context.Value().([]T), keyFn)
+		},
+		ErrorKeyUnique, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a slice passes a custom function.
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.Slice(tags).Passing(func(v []string) bool {
+//		return v[0] == "go"
+//	}))
+func (validator *ValidatorSlice[T]) Passing(function func(v0 []T) bool, template ...string) *ValidatorSlice[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().([]T))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate each element of a slice with the validator returned by the function.
+// The errors are added to the indexed path of each element, using the same
+// format as [Validation.InCell]. If the element validator has no name, the
+// title of the slice is used in its error messages. Returning nil skips the
+// element. A Not() before Each is ignored; use Not() in the element validator
+// instead.
+//
+// For example:
+//
+//	tags := []string{"go", ""}
+//	val := Is(v.Slice(tags, "tags").Each(func(tag string, i int) v.Validator {
+//		return v.String(tag).Not().Blank()
+//	}))
+//	val.Errors()["tags[1]"] // Tags can't be blank
+func (validator *ValidatorSlice[T]) Each(function func(item T, index int) Validator) *ValidatorSlice[T] {
+	validator.context.addNested(
+		func(name string, title string, shortCircuit bool, validation *Validation) bool {
+			return validateSliceEach(validator.context.Value().([]T), function, name, title, shortCircuit, validation)
+		})
+
+	return validator
+}
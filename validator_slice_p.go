@@ -0,0 +1,302 @@
+package valgo
+
+// The Slice pointer validator type that keeps its validator context.
+type ValidatorSliceP[T any] struct {
+	context  *ValidatorContext
+	equality sliceEquality[T]
+}
+
+// Receives a slice pointer to validate.
+//
+// Optionally, the function can receive a name and title, in that order,
+// to be used in the error messages. A `value_%N` pattern is used as a name in
+// error messages if a name and title are not supplied; for example: value_0.
+// When the name is provided but not the title, then the name is humanized to be
+// used as the title as well; for example the name `phone_numbers` will be
+// humanized as `Phone Numbers`
+func SliceP[T comparable](value *[]T, nameAndTitle ...string) *ValidatorSliceP[T] {
+	return &ValidatorSliceP[T]{context: NewContext(value, nameAndTitle...), equality: comparableSliceEquality[T]()}
+}
+
+// Receives a pointer to a slice of any element type to validate, like
+// [AnySlice]. The Contains and Unique rules compare the elements with
+// [reflect.DeepEqual].
+func AnySliceP[T any](value *[]T, nameAndTitle ...string) *ValidatorSliceP[T] {
+	return &ValidatorSliceP[T]{context: NewContext(value, nameAndTitle...), equality: deepSliceEquality[T]()}
+}
+
+// Return the context of the validator. The context is useful to create a custom
+// validator by extending this validator.
+func (validator *ValidatorSliceP[T]) Context() *ValidatorContext {
+	return validator.context
+}
+
+// Invert the logical value associated to the next validator function.
+// For example:
+//
+//	// It will return false because Not() inverts the boolean value associated with the Empty() function
+//	tags := []string{}
+//	Is(v.SliceP(&tags).Not().Empty()).Valid()
+func (validator *ValidatorSliceP[T]) Not() *ValidatorSliceP[T] {
+	validator.context.Not()
+
+	return validator
+}
+
+// Or introduces a logical OR boundary in the current validator chain.
+//
+// Or groups adjacent validation fragments into a single OR-group that is
+// evaluated left-to-right until one fragment succeeds. The OR-group succeeds
+// if any fragment succeeds; it fails only if all fragments fail.
+//
+// Precedence: the OR-group is evaluated as a unit before the implicit AND
+// that continues the chain. For example:
+//
+//	A.Or().B.C   == (A OR B) AND C
+//
+// Error reporting: if the OR-group fails, the error message for that group is
+// a single message composed by joining the failing fragments' messages using
+// the localized OR list format.
+//
+// Example:
+//
+//	// Passes because the slice is nil (Nil() OR MinLength(2)).
+//	var tags *[]string
+//	isValid := v.Is(v.SliceP(tags).Nil().Or().MinLength(2)).Valid()
+func (validator *ValidatorSliceP[T]) Or() *ValidatorSliceP[T] {
+	validator.context.Or()
+
+	return validator
+}
+
+// OrElse introduces a logical OR boundary with a cut (short-circuit) in the
+// validator chain.
+//
+// OrElse behaves like Or for building an OR-group, but with an additional rule:
+// if the left side (a single fragment, or the entire OR-group accumulated to
+// the left of OrElse) succeeds, validation stops and no fragments to the right
+// of OrElse are evaluated.
+//
+// This is primarily used to express "accept X, otherwise validate the rest"
+// without repeating X across multiple OR fragments.
+//
+// Precedence: OrElse still participates in OR-grouping precedence. For example:
+//
+//	A.OrElse().B.C  == A OR (B AND C)   (with a cut if A succeeds)
+//
+// Error reporting: if the OR-group fails, its message is composed the same way
+// as Or (localized OR list join).
+//
+// Example:
+//
+//	// If the slice is nil, the chain succeeds and MinLength/Unique are not evaluated.
+//	// Otherwise, the slice must have MinLength(2) AND Unique().
+//	var tags *[]string
+//	isValid := v.Is(v.SliceP(tags).Nil().OrElse().MinLength(2).Unique()).Valid()
+func (validator *ValidatorSliceP[T]) OrElse() *ValidatorSliceP[T] {
+	validator.context.OrElse()
+
+	return validator
+}
+
+// Validate if a slice pointer's value is empty.
+// For example:
+//
+//	tags := []string{}
+//	Is(v.SliceP(&tags).Empty()) // Will be true
+func (validator *ValidatorSliceP[T]) Empty(template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*[]T) != nil && isSliceEmpty(*(validator.context.Value().(*[]T)))
+		},
+		ErrorKeyEmpty, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a slice pointer's value is empty or nil.
+// For example:
+//
+//	var tags *[]string
+//	Is(v.SliceP(tags).EmptyOrNil()) // Will be true
+func (validator *ValidatorSliceP[T]) EmptyOrNil(template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*[]T) == nil || isSliceEmpty(*(validator.context.Value().(*[]T)))
+		},
+		ErrorKeyEmpty, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate the number of elements of a slice pointer's value.
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.SliceP(&tags).Length(2))
+func (validator *ValidatorSliceP[T]) Length(length int, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*[]T) != nil && isSliceLength(*(validator.context.Value().(*[]T)), length)
+		},
+		ErrorKeyLength,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the minimum number of elements of a slice pointer's value.
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.SliceP(&tags).MinLength(1))
+func (validator *ValidatorSliceP[T]) MinLength(length int, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*[]T) != nil && isSliceMinLength(*(validator.context.Value().(*[]T)), length)
+		},
+		ErrorKeyMinLength,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate the maximum number of elements of a slice pointer's value.
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.SliceP(&tags).MaxLength(5))
+func (validator *ValidatorSliceP[T]) MaxLength(length int, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*[]T) != nil && isSliceMaxLength(*(validator.context.Value().(*[]T)), length)
+		},
+		ErrorKeyMaxLength,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the number of elements of a slice pointer's value is within a
+// range (inclusive).
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.SliceP(&tags).LengthBetween(1, 5))
+func (validator *ValidatorSliceP[T]) LengthBetween(min int, max int, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*[]T) != nil && isSliceLengthBetween(*(validator.context.Value().(*[]T)), min, max)
+		},
+		ErrorKeyLengthBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a slice pointer's value contains a value.
+// For example:
+//
+//	roles := []string{"admin", "editor"}
+//	Is(v.SliceP(&roles).Contains("admin"))
+func (validator *ValidatorSliceP[T]) Contains(value T, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*[]T) != nil && validator.equality.contains(*(validator.context.Value().(*[]T)), value)
+		},
+		ErrorKeyContains, value, template...)
+
+	return validator
+}
+
+// Validate if the elements of a slice pointer's value are unique.
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.SliceP(&tags).Unique())
+func (validator *ValidatorSliceP[T]) Unique(template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*[]T) != nil && validator.equality.unique(*(validator.context.Value().(*[]T)))
+		},
+		ErrorKeyUnique, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the keys returned by a function for each element of a slice
+// pointer's value are unique. The keys must be comparable values, otherwise
+// the function panics.
+// For example:
+//
+//	users := []User{{ID: 1}, {ID: 2}}
+//	Is(v.SliceP(&users).UniqueBy(func(u User) any { return u.ID }))
+func (validator *ValidatorSliceP[T]) UniqueBy(keyFn func(item T) any, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*[]T) != nil && isSliceUniqueBy(*(validator.context.Value().(*[]T)), keyFn)
+		},
+		ErrorKeyUnique, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a slice pointer passes a custom function.
+// For example:
+//
+//	tags := []string{"go", "valgo"}
+//	Is(v.SliceP(&tags).Passing(func(v *[]string) bool {
+//		return v != nil && (*v)[0] == "go"
+//	}))
+func (validator *ValidatorSliceP[T]) Passing(function func(v0 *[]T) bool, template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return function(validator.context.Value().(*[]T))
+		},
+		ErrorKeyPassing, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate each element of a slice pointer's value with the validator returned
+// by the function. The errors are added to the indexed path of each element,
+// using the same format as [Validation.InCell]. A nil pointer has no elements,
+// so use Not().Nil() to require the slice. A Not() before Each is ignored.
+//
+// For example:
+//
+//	tags := []string{"go", ""}
+//	val := Is(v.SliceP(&tags, "tags").Each(func(tag string, i int) v.Validator {
+//		return v.String(tag).Not().Blank()
+//	}))
+//	val.Errors()["tags[1]"] // Tags can't be blank
+func (validator *ValidatorSliceP[T]) Each(function func(item T, index int) Validator) *ValidatorSliceP[T] {
+	validator.context.addNested(
+		func(name string, title string, shortCircuit bool, validation *Validation) bool {
+			if validator.context.Value().(*[]T) == nil {
+				return true
+			}
+			return validateSliceEach(*(validator.context.Value().(*[]T)), function, name, title, shortCircuit, validation)
+		})
+
+	return validator
+}
+
+// Validate if a slice pointer is nil.
+// For example:
+//
+//	var tags *[]string
+//	Is(v.SliceP(tags).Nil())
+func (validator *ValidatorSliceP[T]) Nil(template ...string) *ValidatorSliceP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*[]T) == nil
+		},
+		ErrorKeyNil, validator.context.Value(), template...)
+
+	return validator
+}
@@ -0,0 +1,153 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorSlicePNot(t *testing.T) {
+
+	tags := []string{"a"}
+
+	v := Is(SliceP(&tags).Not().Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSlicePEmpty(t *testing.T) {
+
+	var v *Validation
+
+	tags := []string{}
+	v = Is(SliceP(&tags).Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	var nilTags *[]string
+	v = Is(SliceP(nilTags).Empty())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be empty",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(SliceP(nilTags).EmptyOrNil())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSlicePLength(t *testing.T) {
+
+	var v *Validation
+
+	tags := []string{"go", "valgo"}
+
+	v = Is(SliceP(&tags).Length(2).MinLength(1).MaxLength(2).LengthBetween(1, 2))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(SliceP(&tags).Length(3))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must have a length equal to \"3\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	var nilTags *[]string
+	v = Is(SliceP(nilTags).MaxLength(2))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must not have a length longer than \"2\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorSlicePContainsAndUnique(t *testing.T) {
+
+	var v *Validation
+
+	tags := []string{"go", "valgo", "go"}
+
+	v = Is(SliceP(&tags).Contains("valgo"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(SliceP(&tags, "tags").Unique())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags must not contain duplicate values",
+		v.Errors()["tags"].Messages()[0])
+
+	v = Is(SliceP(&tags, "tags").UniqueBy(func(tag string) any { return len(tag) }))
+	assert.False(t, v.Valid())
+
+	var nilTags *[]string
+	v = Is(SliceP(nilTags).Contains("go"))
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorSlicePWithNonComparableElements(t *testing.T) {
+
+	matrix := [][]int{{1}, {}}
+	v := Is(AnySliceP(&matrix, "matrix").Not().Nil().Each(func(row []int, i int) Validator {
+		return Slice(row).Not().Empty()
+	}))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "matrix[1]")
+}
+
+func TestValidatorAnySlicePContainsAndUnique(t *testing.T) {
+
+	matrix := [][]int{{1}, {2}, {1}}
+	assert.True(t, Is(AnySliceP(&matrix).Contains([]int{2})).Valid())
+	assert.False(t, Is(AnySliceP(&matrix).Unique()).Valid())
+
+	var nilMatrix *[][]int
+	assert.False(t, Is(AnySliceP(nilMatrix).Contains([]int{1})).Valid())
+	assert.False(t, Is(AnySliceP(nilMatrix).Unique()).Valid())
+}
+
+func TestValidatorSlicePNil(t *testing.T) {
+
+	var v *Validation
+
+	var nilTags *[]string
+	v = Is(SliceP(nilTags).Nil())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	tags := []string{}
+	v = Is(SliceP(&tags).Nil())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be nil",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorSlicePPassing(t *testing.T) {
+
+	tags := []string{"go"}
+
+	v := Is(SliceP(&tags).Passing(func(s *[]string) bool { return s != nil && len(*s) == 1 }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSlicePEach(t *testing.T) {
+
+	var v *Validation
+
+	each := func(tag string, i int) Validator {
+		return String(tag).Not().Blank()
+	}
+
+	tags := []string{"go", ""}
+	v = Is(SliceP(&tags, "tags").Each(each))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags can't be blank",
+		v.Errors()["tags[1]"].Messages()[0])
+
+	var nilTags *[]string
+	v = Is(SliceP(nilTags, "tags").Each(each))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
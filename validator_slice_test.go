@@ -0,0 +1,439 @@
+package valgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorSliceNot(t *testing.T) {
+
+	v := Is(Slice([]string{"a"}).Not().Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSliceEmptyValid(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Slice([]string{}).Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	var nilSlice []string
+	v = Is(Slice(nilSlice).Empty())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSliceEmptyInvalid(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Slice([]string{"a"}).Empty())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be empty",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Slice([]string{}, "tags").Not().Empty())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags can't be empty",
+		v.Errors()["tags"].Messages()[0])
+}
+
+func TestValidatorSliceWithCustomType(t *testing.T) {
+
+	type Tags []string
+
+	tags := Tags{"go", "valgo"}
+
+	v := Is(Slice(tags).Length(2).Contains("go"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSliceWithNonComparableElements(t *testing.T) {
+
+	type Post struct {
+		Title string
+		Tags  []string
+	}
+
+	posts := []Post{{"Valgo", []string{"go"}}, {"Empty", nil}}
+	v := Is(AnySlice(posts, "posts").Length(2).Each(func(post Post, i int) Validator {
+		return Slice(post.Tags, "tags").Not().Empty()
+	}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags can't be empty",
+		v.Errors()["posts[1]"].Messages()[0])
+
+	matrix := [][]string{{"a", "b"}, {"c"}}
+	v = Is(AnySlice(matrix, "matrix").Each(func(row []string, i int) Validator {
+		return Slice(row).Length(2)
+	}))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "matrix[1]")
+	assert.NotContains(t, v.Errors(), "matrix[0]")
+}
+
+func TestValidatorAnySliceContainsAndUnique(t *testing.T) {
+
+	var v *Validation
+
+	matrix := [][]string{{"a", "b"}, {"c"}}
+
+	v = Is(AnySlice(matrix).Contains([]string{"c"}).Unique())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(AnySlice(matrix, "matrix").Contains([]string{"a"}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Matrix must contain \"[a]\"",
+		v.Errors()["matrix"].Messages()[0])
+
+	v = Is(AnySlice(append(matrix, []string{"c"}), "matrix").Unique())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Matrix must not contain duplicate values",
+		v.Errors()["matrix"].Messages()[0])
+}
+
+func TestValidatorSliceLengthValid(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Slice([]int{1, 2}).Length(2))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Slice([]int{1, 2}).MinLength(2))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Slice([]int{1, 2}).MaxLength(2))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Slice([]int{1, 2}).LengthBetween(1, 3))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSliceLengthInvalid(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Slice([]int{1, 2}).Length(3))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must have a length equal to \"3\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Slice([]int{1, 2}).MinLength(3))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must not have a length shorter than \"3\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Slice([]int{1, 2}).MaxLength(1))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must not have a length longer than \"1\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Slice([]int{1, 2}).LengthBetween(3, 4))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must have a length between \"3\" and \"4\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorSliceContainsValid(t *testing.T) {
+
+	v := Is(Slice([]string{"admin", "editor"}).Contains("admin"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSliceContainsInvalid(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Slice([]string{"admin", "editor"}, "roles").Contains("owner"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Roles must contain \"owner\"",
+		v.Errors()["roles"].Messages()[0])
+
+	v = Is(Slice([]string{"admin", "editor"}, "roles").Not().Contains("admin"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Roles can't contain \"admin\"",
+		v.Errors()["roles"].Messages()[0])
+}
+
+func TestValidatorSliceUniqueValid(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Slice([]string{"go", "valgo"}).Unique())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Slice([]string{}).Unique())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSliceUniqueInvalid(t *testing.T) {
+
+	v := Is(Slice([]string{"go", "valgo", "go"}, "tags").Unique())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags must not contain duplicate values",
+		v.Errors()["tags"].Messages()[0])
+}
+
+func TestValidatorSliceUniqueBy(t *testing.T) {
+
+	type User struct {
+		ID   int
+		Name string
+	}
+
+	var v *Validation
+
+	v = Is(Slice([]User{{1, "a"}, {2, "a"}}).UniqueBy(func(u User) any { return u.ID }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Slice([]User{{1, "a"}, {2, "a"}}, "users").UniqueBy(func(u User) any { return u.Name }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Users must not contain duplicate values",
+		v.Errors()["users"].Messages()[0])
+}
+
+func TestValidatorSlicePassing(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Slice([]int{1, 2}).Passing(func(s []int) bool { return s[0] == 1 }))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Slice([]int{1, 2}).Passing(func(s []int) bool { return s[0] == 2 }))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 is not valid",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorSliceEachValid(t *testing.T) {
+
+	v := Is(Slice([]string{"go", "valgo"}, "tags").Each(func(tag string, i int) Validator {
+		return String(tag).Not().Blank()
+	}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+}
+
+func TestValidatorSliceEachInvalid(t *testing.T) {
+
+	tags := []string{"go", "", "valgo", " "}
+
+	v := Is(Slice(tags, "tags").Each(func(tag string, i int) Validator {
+		return String(tag).Not().Blank()
+	}))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Equal(t,
+		"Tags can't be blank",
+		v.Errors()["tags[1]"].Messages()[0])
+	assert.Equal(t,
+		"Tags can't be blank",
+		v.Errors()["tags[3]"].Messages()[0])
+
+	assert.False(t, v.PathValid("tags"))
+	assert.False(t, v.PathValid("tags[1]"))
+	assert.True(t, v.PathValid("tags[0]"))
+}
+
+func TestValidatorSliceEachSameFormatAsInCell(t *testing.T) {
+
+	tags := []string{"", "valgo"}
+
+	v := Is(Slice(tags, "tags").Each(func(tag string, i int) Validator {
+		return String(tag, "name").Not().Blank()
+	}))
+
+	expected := InCell("tags", 0, Is(String("", "name").Not().Blank()))
+
+	assert.False(t, v.Valid())
+	assert.Equal(t, expected.Errors()["tags[0]"].Messages(), v.Errors()["tags[0]"].Messages())
+}
+
+func TestValidatorSliceEachWithTitle(t *testing.T) {
+
+	v := Is(Slice([]string{""}, "tags", "Labels").Each(func(tag string, i int) Validator {
+		return String(tag).Not().Blank()
+	}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Labels can't be blank",
+		v.Errors()["tags[0]"].Messages()[0])
+
+	v = Is(Slice([]string{""}).Each(func(tag string, i int) Validator {
+		return String(tag).Not().Blank()
+	}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 can't be blank",
+		v.Errors()["value_0[0]"].Messages()[0])
+}
+
+func TestValidatorSliceEachSkipNil(t *testing.T) {
+
+	v := Is(Slice([]string{"", ""}, "tags").Each(func(tag string, i int) Validator {
+		if i == 0 {
+			return nil
+		}
+		return String(tag).Not().Blank()
+	}))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Contains(t, v.Errors(), "tags[1]")
+}
+
+func TestValidatorSliceEachShortCircuit(t *testing.T) {
+
+	var v *Validation
+
+	tags := []string{"", "valgo"}
+	each := func(tag string, i int) Validator {
+		return String(tag).Not().Blank().MinLength(3)
+	}
+
+	// Rules after a failed collection rule are not evaluated with Is
+	v = Is(Slice(tags, "tags").MaxLength(1).Each(each))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 1)
+	assert.Contains(t, v.Errors(), "tags")
+
+	// Element rules are short-circuited with Is
+	v = Is(Slice(tags, "tags").Each(each))
+	assert.Len(t, v.Errors()["tags[0]"].Messages(), 1)
+
+	// With Check all the rules are evaluated
+	v = Check(Slice(tags, "tags").MaxLength(1).Each(each))
+	assert.False(t, v.Valid())
+	assert.Len(t, v.Errors(), 2)
+	assert.Len(t, v.Errors()["tags[0]"].Messages(), 2)
+}
+
+func TestValidatorSliceEachLocale(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs}).Is(Slice([]string{""}, "tags").Each(func(tag string, i int) Validator {
+		return String(tag).Not().Blank()
+	}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags no puede estar en blanco",
+		v.Errors()["tags[0]"].Messages()[0])
+}
+
+func TestValidatorSliceEachInNamespace(t *testing.T) {
+
+	v := In("post", Is(Slice([]string{""}, "tags").Each(func(tag string, i int) Validator {
+		return String(tag).Not().Blank()
+	})))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "post.tags[0]")
+	assert.False(t, v.PathValid("post.tags"))
+}
+
+func TestValidatorSliceEachOr(t *testing.T) {
+
+	var v *Validation
+
+	each := func(tag string, i int) Validator {
+		return String(tag).Not().Blank()
+	}
+
+	// The errors of the elements are discarded when the OR-group succeeds
+	v = Is(Slice([]string{""}, "tags").Length(1).Or().Each(each))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(Slice([]string{""}, "tags").Length(2).Or().Each(each))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "tags")
+	assert.Contains(t, v.Errors(), "tags[0]")
+}
+
+func TestValidatorSliceEachKeepsElementValidator(t *testing.T) {
+
+	// The same element validator is reused for every element, and it keeps no
+	// name from the path of a previous element
+	shared := String("").Not().Blank()
+
+	v := Is(Slice([]string{"a", "b"}, "tags").Each(func(tag string, i int) Validator {
+		return shared
+	}))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "tags[0]")
+	assert.Contains(t, v.Errors(), "tags[1]")
+	assert.Nil(t, shared.context.name)
+	assert.Nil(t, shared.context.title)
+
+	v = Is(shared)
+	assert.Contains(t, v.Errors(), "value_0")
+}
+
+func TestValidatorSliceEachUsesSessionClock(t *testing.T) {
+
+	now := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := []time.Time{time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	v := New(Options{Clock: FixedClock(now)}).Is(Slice(times, "times").Each(func(ts time.Time, i int) Validator {
+		return Time(ts).InFuture()
+	}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = New(Options{Clock: FixedClock(now)}).Is(Map(map[string]time.Time{"start": times[0]}, "times").
+		EachValue(func(key string, ts time.Time) Validator {
+			return Time(ts).InPast()
+		}))
+	assert.False(t, v.Valid())
+	assert.Contains(t, v.Errors(), "times.start")
+}
+
+func TestValidatorSliceEachIgnoresNot(t *testing.T) {
+
+	each := func(tag string, i int) Validator {
+		return String(tag).Not().Blank()
+	}
+
+	// A Not() before Each is discarded, so Each still validates the elements
+	v := Is(Slice([]string{"go", ""}, "tags").Not().Each(each))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags can't be blank",
+		v.Errors()["tags[1]"].Messages()[0])
+
+	assert.True(t, Is(Slice([]string{"go"}).Not().Each(each)).Valid())
+
+	// and it doesn't apply to the next rule either
+	v = Is(Slice([]string{"go"}, "tags").Not().Each(each).Empty())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags must be empty",
+		v.Errors()["tags"].Messages()[0])
+}
@@ -0,0 +1,583 @@
+package valgo
+
+import (
+	"go/token"
+	"strings"
+	"unicode"
+)
+
+func isLowerASCII(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+func isUpperASCII(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+func isDigitASCII(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlphanumericASCII(c byte) bool {
+	return isLowerASCII(c) || isUpperASCII(c) || isDigitASCII(c)
+}
+
+// Check that the string isn't empty and all its bytes satisfy the function.
+func isEveryByte(v string, fn func(byte) bool) bool {
+	if v == "" {
+		return false
+	}
+	for i := 0; i < len(v); i++ {
+		if !fn(v[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Check that the string is made of words of lowercase letters and digits
+// joined by the separator, without empty words.
+func isLowercaseWords(v string, separator string) bool {
+	for _, word := range strings.Split(v, separator) {
+		if !isEveryByte(word, func(c byte) bool { return isLowerASCII(c) || isDigitASCII(c) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// Check that the string doesn't contain uppercase or titlecase letters.
+func isStringLowercase[T ~string](v T) bool {
+	return strings.IndexFunc(string(v), func(r rune) bool { return unicode.IsUpper(r) || unicode.IsTitle(r) }) < 0
+}
+
+// Check that the string doesn't contain lowercase or titlecase letters.
+func isStringUppercase[T ~string](v T) bool {
+	return strings.IndexFunc(string(v), func(r rune) bool { return unicode.IsLower(r) || unicode.IsTitle(r) }) < 0
+}
+
+func isStringSlug[T ~string](v T) bool {
+	return isLowercaseWords(string(v), "-")
+}
+
+func isStringSnakeCase[T ~string](v T) bool {
+	return len(v) > 0 && isLowerASCII(v[0]) && isLowercaseWords(string(v), "_")
+}
+
+func isStringKebabCase[T ~string](v T) bool {
+	return len(v) > 0 && isLowerASCII(v[0]) && isLowercaseWords(string(v), "-")
+}
+
+func isStringCamelCase[T ~string](v T) bool {
+	return len(v) > 0 && isLowerASCII(v[0]) && isEveryByte(string(v), isAlphanumericASCII)
+}
+
+func isStringPascalCase[T ~string](v T) bool {
+	return len(v) > 0 && isUpperASCII(v[0]) && isEveryByte(string(v), isAlphanumericASCII)
+}
+
+func isStringGoIdentifier[T ~string](v T) bool {
+	return token.IsIdentifier(string(v))
+}
+
+func isStringAlpha[T ~string](v T) bool {
+	return isEveryByte(string(v), func(c byte) bool { return isLowerASCII(c) || isUpperASCII(c) })
+}
+
+func isStringAlphanumeric[T ~string](v T) bool {
+	return isEveryByte(string(v), isAlphanumericASCII)
+}
+
+func isStringNumeric[T ~string](v T) bool {
+	return isEveryByte(string(v), isDigitASCII)
+}
+
+func isStringASCII[T ~string](v T) bool {
+	for i := 0; i < len(v); i++ {
+		if v[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// Letters and combining marks, so letters with decomposed diacritics are
+// accepted.
+func isStringAlphaUnicode[T ~string](v T) bool {
+	_, found := firstRuneNotSatisfying(string(v), func(r rune) bool { return unicode.IsLetter(r) || unicode.IsMark(r) })
+	return v != "" && !found
+}
+
+func isStringAlphanumericUnicode[T ~string](v T) bool {
+	_, found := firstRuneNotSatisfying(string(v), func(r rune) bool { return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) })
+	return v != "" && !found
+}
+
+func isStringNumericUnicode[T ~string](v T) bool {
+	_, found := firstRuneNotSatisfying(string(v), unicode.IsDigit)
+	return v != "" && !found
+}
+
+// Validate if a string doesn't contain uppercase letters. Characters without case,
+// like digits and punctuation, are allowed, and an empty string is valid.
+// For example:
+//
+//	Is(v.String("api-key_2").Lowercase())
+func (validator *ValidatorString[T]) Lowercase(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringLowercase(validator.context.Value().(T))
+		},
+		ErrorKeyLowercase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string doesn't contain lowercase letters. Characters without case,
+// like digits and punctuation, are allowed, and an empty string is valid.
+// For example:
+//
+//	Is(v.String("DATABASE_URL").Uppercase())
+func (validator *ValidatorString[T]) Uppercase(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringUppercase(validator.context.Value().(T))
+		},
+		ErrorKeyUppercase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is a URL slug: lowercase ASCII letters and digits, in
+// words separated by single hyphens.
+// For example:
+//
+//	Is(v.String("hello-world-2").Slug())
+func (validator *ValidatorString[T]) Slug(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringSlug(validator.context.Value().(T))
+		},
+		ErrorKeySlug, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is in snake_case: lowercase ASCII letters and digits, in
+// words separated by single underscores, starting with a letter.
+// For example:
+//
+//	Is(v.String("created_at").SnakeCase())
+func (validator *ValidatorString[T]) SnakeCase(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringSnakeCase(validator.context.Value().(T))
+		},
+		ErrorKeySnakeCase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is in kebab-case: lowercase ASCII letters and digits, in
+// words separated by single hyphens, starting with a letter.
+// For example:
+//
+//	Is(v.String("max-retries").KebabCase())
+func (validator *ValidatorString[T]) KebabCase(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringKebabCase(validator.context.Value().(T))
+		},
+		ErrorKeyKebabCase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is in camelCase: ASCII letters and digits, starting with
+// a lowercase letter.
+// For example:
+//
+//	Is(v.String("createdAt").CamelCase())
+func (validator *ValidatorString[T]) CamelCase(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringCamelCase(validator.context.Value().(T))
+		},
+		ErrorKeyCamelCase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is in PascalCase: ASCII letters and digits, starting with
+// an uppercase letter.
+// For example:
+//
+//	Is(v.String("CreatedAt").PascalCase())
+func (validator *ValidatorString[T]) PascalCase(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringPascalCase(validator.context.Value().(T))
+		},
+		ErrorKeyPascalCase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is a valid Go identifier that is not a keyword, like
+// the names of variables, types and functions.
+// For example:
+//
+//	Is(v.String("userID").GoIdentifier())
+func (validator *ValidatorString[T]) GoIdentifier(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringGoIdentifier(validator.context.Value().(T))
+		},
+		ErrorKeyGoIdentifier, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string only contains ASCII letters. An empty string is not valid.
+// For example:
+//
+//	Is(v.String("Jane").Alpha())
+func (validator *ValidatorString[T]) Alpha(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringAlpha(validator.context.Value().(T))
+		},
+		ErrorKeyAlpha, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string only contains letters of any script, including their
+// combining marks. An empty string is not valid.
+// For example:
+//
+//	Is(v.String("Zoë").AlphaUnicode())
+func (validator *ValidatorString[T]) AlphaUnicode(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringAlphaUnicode(validator.context.Value().(T))
+		},
+		ErrorKeyAlphaUnicode, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string only contains ASCII letters and digits. An empty string
+// is not valid.
+// For example:
+//
+//	Is(v.String("Jane2").Alphanumeric())
+func (validator *ValidatorString[T]) Alphanumeric(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringAlphanumeric(validator.context.Value().(T))
+		},
+		ErrorKeyAlphanumeric, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string only contains letters and decimal digits of any script,
+// including the combining marks of the letters. An empty string is not valid.
+// For example:
+//
+//	Is(v.String("Zoë2").AlphanumericUnicode())
+func (validator *ValidatorString[T]) AlphanumericUnicode(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringAlphanumericUnicode(validator.context.Value().(T))
+		},
+		ErrorKeyAlphanumericUnicode, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string only contains the ASCII digits 0 to 9, without signs or
+// decimal separators. An empty string is not valid.
+// For example:
+//
+//	Is(v.String("0042").Numeric())
+func (validator *ValidatorString[T]) Numeric(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringNumeric(validator.context.Value().(T))
+		},
+		ErrorKeyNumeric, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string only contains decimal digits of any script, like "٤٢".
+// An empty string is not valid.
+// For example:
+//
+//	Is(v.String("٤٢").NumericUnicode())
+func (validator *ValidatorString[T]) NumericUnicode(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringNumericUnicode(validator.context.Value().(T))
+		},
+		ErrorKeyNumericUnicode, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string only contains ASCII characters. An empty string is
+// valid.
+// For example:
+//
+//	Is(v.String("Jane Doe").ASCII())
+func (validator *ValidatorString[T]) ASCII(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringASCII(validator.context.Value().(T))
+		},
+		ErrorKeyASCII, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer doesn't contain uppercase letters,
+// like in [ValidatorString.Lowercase].
+// For example:
+//
+//	key := "api-key_2"
+//	Is(v.StringP(&key).Lowercase())
+func (validator *ValidatorStringP[T]) Lowercase(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringLowercase(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyLowercase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer doesn't contain lowercase letters,
+// like in [ValidatorString.Uppercase].
+// For example:
+//
+//	envVar := "DATABASE_URL"
+//	Is(v.StringP(&envVar).Uppercase())
+func (validator *ValidatorStringP[T]) Uppercase(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringUppercase(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyUppercase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a URL slug, like in
+// [ValidatorString.Slug].
+// For example:
+//
+//	slug := "hello-world-2"
+//	Is(v.StringP(&slug).Slug())
+func (validator *ValidatorStringP[T]) Slug(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringSlug(*(validator.context.Value().(*T)))
+		},
+		ErrorKeySlug, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is in snake_case, like in
+// [ValidatorString.SnakeCase].
+// For example:
+//
+//	column := "created_at"
+//	Is(v.StringP(&column).SnakeCase())
+func (validator *ValidatorStringP[T]) SnakeCase(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringSnakeCase(*(validator.context.Value().(*T)))
+		},
+		ErrorKeySnakeCase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is in kebab-case, like in
+// [ValidatorString.KebabCase].
+// For example:
+//
+//	flag := "max-retries"
+//	Is(v.StringP(&flag).KebabCase())
+func (validator *ValidatorStringP[T]) KebabCase(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringKebabCase(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyKebabCase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is in camelCase, like in
+// [ValidatorString.CamelCase].
+// For example:
+//
+//	field := "createdAt"
+//	Is(v.StringP(&field).CamelCase())
+func (validator *ValidatorStringP[T]) CamelCase(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringCamelCase(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyCamelCase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is in PascalCase, like in
+// [ValidatorString.PascalCase].
+// For example:
+//
+//	typeName := "CreatedAt"
+//	Is(v.StringP(&typeName).PascalCase())
+func (validator *ValidatorStringP[T]) PascalCase(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringPascalCase(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyPascalCase, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a valid Go identifier, like in
+// [ValidatorString.GoIdentifier].
+// For example:
+//
+//	ident := "userID"
+//	Is(v.StringP(&ident).GoIdentifier())
+func (validator *ValidatorStringP[T]) GoIdentifier(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringGoIdentifier(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyGoIdentifier, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer only contains ASCII letters, like
+// in [ValidatorString.Alpha].
+// For example:
+//
+//	name := "Jane"
+//	Is(v.StringP(&name).Alpha())
+func (validator *ValidatorStringP[T]) Alpha(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringAlpha(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyAlpha, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer only contains letters of any
+// script, like in [ValidatorString.AlphaUnicode].
+// For example:
+//
+//	name := "Zoë"
+//	Is(v.StringP(&name).AlphaUnicode())
+func (validator *ValidatorStringP[T]) AlphaUnicode(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringAlphaUnicode(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyAlphaUnicode, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer only contains ASCII letters and
+// digits, like in [ValidatorString.Alphanumeric].
+// For example:
+//
+//	code := "Jane2"
+//	Is(v.StringP(&code).Alphanumeric())
+func (validator *ValidatorStringP[T]) Alphanumeric(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringAlphanumeric(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyAlphanumeric, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer only contains letters and decimal
+// digits of any script, like in [ValidatorString.AlphanumericUnicode].
+// For example:
+//
+//	code := "Zoë2"
+//	Is(v.StringP(&code).AlphanumericUnicode())
+func (validator *ValidatorStringP[T]) AlphanumericUnicode(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringAlphanumericUnicode(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyAlphanumericUnicode, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer only contains ASCII digits, like in
+// [ValidatorString.Numeric].
+// For example:
+//
+//	pin := "0042"
+//	Is(v.StringP(&pin).Numeric())
+func (validator *ValidatorStringP[T]) Numeric(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringNumeric(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyNumeric, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer only contains decimal digits of any
+// script, like in [ValidatorString.NumericUnicode].
+// For example:
+//
+//	pin := "٤٢"
+//	Is(v.StringP(&pin).NumericUnicode())
+func (validator *ValidatorStringP[T]) NumericUnicode(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringNumericUnicode(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyNumericUnicode, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer only contains ASCII characters,
+// like in [ValidatorString.ASCII].
+// For example:
+//
+//	text := "Jane Doe"
+//	Is(v.StringP(&text).ASCII())
+func (validator *ValidatorStringP[T]) ASCII(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringASCII(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyASCII, validator.context.Value(), template...)
+
+	return validator
+}
@@ -0,0 +1,227 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringLowercaseAndUppercase(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"", "api-key_2", "zoë", "東京"} {
+		assert.True(t, Is(String(s).Lowercase()).Valid(), s)
+	}
+	for _, s := range []string{"Api-key", "zoË", "ǅ"} {
+		assert.False(t, Is(String(s).Lowercase()).Valid(), s)
+	}
+
+	for _, s := range []string{"", "DATABASE_URL", "ZOË 2"} {
+		assert.True(t, Is(String(s).Uppercase()).Valid(), s)
+	}
+	for _, s := range []string{"Database_URL", "ZOë"} {
+		assert.False(t, Is(String(s).Uppercase()).Valid(), s)
+	}
+
+	v = Is(String("Api-Key", "key").Lowercase())
+	assert.Equal(t,
+		"Key must not contain uppercase letters",
+		v.Errors()["key"].Messages()[0])
+
+	v = Is(String("database_url", "env_var").Uppercase())
+	assert.Equal(t,
+		"Env var must not contain lowercase letters",
+		v.Errors()["env_var"].Messages()[0])
+
+	v = Is(String("abc", "key").Not().Lowercase())
+	assert.Equal(t,
+		"Key must contain uppercase letters",
+		v.Errors()["key"].Messages()[0])
+}
+
+func TestValidatorStringIdentifierShapes(t *testing.T) {
+
+	cases := []struct {
+		rule    func(string) *ValidatorString[string]
+		valid   []string
+		invalid []string
+		message string
+	}{
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").Slug() },
+			[]string{"hello", "hello-world-2", "2024-report"},
+			[]string{"", "Hello", "hello--world", "-hello", "hello-", "hello_world", "héllo"},
+			"Value must be a slug of lowercase letters, digits and hyphens",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").SnakeCase() },
+			[]string{"created_at", "id", "utf8_name"},
+			[]string{"", "Created_at", "created__at", "_created", "created_", "2fa_code", "created-at"},
+			"Value must be in snake_case",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").KebabCase() },
+			[]string{"max-retries", "v2"},
+			[]string{"", "Max-retries", "max--retries", "2-max", "max_retries"},
+			"Value must be in kebab-case",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").CamelCase() },
+			[]string{"createdAt", "id", "userID", "utf8Name"},
+			[]string{"", "CreatedAt", "created_at", "2fa"},
+			"Value must be in camelCase",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").PascalCase() },
+			[]string{"CreatedAt", "ID", "HTTPServer"},
+			[]string{"", "createdAt", "Created_At", "Créé"},
+			"Value must be in PascalCase",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").GoIdentifier() },
+			[]string{"userID", "_", "_private", "año", "x2"},
+			[]string{"", "2x", "user-id", "func", "type"},
+			"Value must be a valid Go identifier",
+		},
+	}
+
+	for _, c := range cases {
+		for _, s := range c.valid {
+			v := Is(c.rule(s))
+			assert.True(t, v.Valid(), s)
+			assert.Empty(t, v.Errors(), s)
+		}
+		for _, s := range c.invalid {
+			v := Is(c.rule(s))
+			assert.False(t, v.Valid(), s)
+			assert.Equal(t, c.message, v.Errors()["value"].Messages()[0], s)
+		}
+	}
+
+	v := Is(String("hello-world", "slug").Not().Slug())
+	assert.Equal(t,
+		"Slug can't be a slug",
+		v.Errors()["slug"].Messages()[0])
+}
+
+func TestValidatorStringCharacterClasses(t *testing.T) {
+
+	cases := []struct {
+		rule    func(string) *ValidatorString[string]
+		valid   []string
+		invalid []string
+		message string
+	}{
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").Alpha() },
+			[]string{"Jane", "abcXYZ"},
+			[]string{"", "Jane Doe", "Jane2", "Zoë"},
+			"Value must only contain letters from a to z",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").AlphaUnicode() },
+			[]string{"Jane", "Zoë", "Zoe\u0308", "Ирина", "東京"},
+			[]string{"", "Jane Doe", "Zoë2", "Zo\xff"},
+			"Value must only contain letters",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").Alphanumeric() },
+			[]string{"Jane2", "2024"},
+			[]string{"", "Jane-2", "Zoë2"},
+			"Value must only contain letters from a to z and digits",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").AlphanumericUnicode() },
+			[]string{"Zoë2", "Ирина٤٢"},
+			[]string{"", "Zoë 2", "x²"},
+			"Value must only contain letters and digits",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").Numeric() },
+			[]string{"0042", "7"},
+			[]string{"", "-42", "4.2", "٤٢", "4 2"},
+			"Value must only contain digits from 0 to 9",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").NumericUnicode() },
+			[]string{"0042", "٤٢", "४२"},
+			[]string{"", "-42", "²", "Ⅻ"},
+			"Value must only contain digits",
+		},
+		{
+			func(s string) *ValidatorString[string] { return String(s, "value").ASCII() },
+			[]string{"", "Jane Doe\n~!"},
+			[]string{"Zoë", "Jane\xff"},
+			"Value must only contain ASCII characters",
+		},
+	}
+
+	for _, c := range cases {
+		for _, s := range c.valid {
+			v := Is(c.rule(s))
+			assert.True(t, v.Valid(), s)
+			assert.Empty(t, v.Errors(), s)
+		}
+		for _, s := range c.invalid {
+			v := Is(c.rule(s))
+			assert.False(t, v.Valid(), s)
+			assert.Equal(t, c.message, v.Errors()["value"].Messages()[0], s)
+		}
+	}
+
+	v := Is(String("1234", "pin").Not().Numeric())
+	assert.Equal(t,
+		"Pin can't only contain digits from 0 to 9",
+		v.Errors()["pin"].Messages()[0])
+}
+
+func TestValidatorStringPCaseAndCharacterClasses(t *testing.T) {
+
+	var v *Validation
+
+	slug := "hello-world"
+	name := "Zoë"
+	pin := "0042"
+	v = Is(StringP(&slug).Lowercase().Slug().KebabCase().ASCII()).
+		Is(StringP(&name).AlphaUnicode().AlphanumericUnicode()).
+		Is(StringP(&pin).Numeric().NumericUnicode().Alphanumeric())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	field := "createdAt"
+	typeName := "CreatedAt"
+	column := "CREATED_AT"
+	v = Is(StringP(&field).CamelCase().GoIdentifier().Alpha()).
+		Is(StringP(&typeName).PascalCase()).
+		Is(StringP(&column).Uppercase())
+	assert.True(t, v.Valid())
+
+	v = Is(StringP(&column, "column").SnakeCase())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Column must be in snake_case",
+		v.Errors()["column"].Messages()[0])
+
+	var nilString *string
+	for _, validator := range []*ValidatorStringP[string]{
+		StringP(nilString).Lowercase(), StringP(nilString).Uppercase(),
+		StringP(nilString).Slug(), StringP(nilString).SnakeCase(),
+		StringP(nilString).KebabCase(), StringP(nilString).CamelCase(),
+		StringP(nilString).PascalCase(), StringP(nilString).GoIdentifier(),
+		StringP(nilString).Alpha(), StringP(nilString).AlphaUnicode(),
+		StringP(nilString).Alphanumeric(), StringP(nilString).AlphanumericUnicode(),
+		StringP(nilString).Numeric(), StringP(nilString).NumericUnicode(),
+		StringP(nilString).ASCII(),
+	} {
+		assert.False(t, Is(validator).Valid())
+	}
+}
+
+func TestValidatorStringCaseLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeDe}).Is(String("Hello World", "slug", "Slug").Slug())
+	assert.Equal(t,
+		"Slug muss ein Slug aus Kleinbuchstaben, Ziffern und Bindestrichen sein",
+		v.Errors()["slug"].Messages()[0])
+}
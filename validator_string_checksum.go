@@ -0,0 +1,643 @@
+package valgo
+
+import (
+	"strings"
+)
+
+// Brand of a payment card, used by the `CreditCardBrand` rule of
+// [ValidatorString] and [ValidatorStringP].
+type CardBrand string
+
+const (
+	CardBrandVisa       CardBrand = "visa"
+	CardBrandMastercard CardBrand = "mastercard"
+	CardBrandAmex       CardBrand = "amex"
+	CardBrandDiscover   CardBrand = "discover"
+	CardBrandDinersClub CardBrand = "diners_club"
+	CardBrandJCB        CardBrand = "jcb"
+	CardBrandUnionPay   CardBrand = "unionpay"
+)
+
+// Card number prefix ranges and lengths of a card brand. A range is inclusive
+// and its bounds have the same number of digits.
+type cardBrandRule struct {
+	brand   CardBrand
+	ranges  [][2]string
+	lengths []int
+}
+
+// The order matters, because the first matching brand is the brand of the card.
+// Discover co-branded UnionPay cards (622126 to 622925) are reported as
+// Discover.
+var cardBrandRules = []cardBrandRule{
+	{CardBrandVisa, [][2]string{{"4", "4"}}, []int{13, 16, 19}},
+	{CardBrandMastercard, [][2]string{{"51", "55"}, {"2221", "2720"}}, []int{16}},
+	{CardBrandAmex, [][2]string{{"34", "34"}, {"37", "37"}}, []int{15}},
+	{CardBrandDiscover, [][2]string{{"6011", "6011"}, {"644", "649"}, {"65", "65"}, {"622126", "622925"}}, []int{16, 17, 18, 19}},
+	{CardBrandDinersClub, [][2]string{{"300", "305"}, {"36", "36"}, {"38", "39"}}, []int{14, 15, 16, 17, 18, 19}},
+	{CardBrandJCB, [][2]string{{"3528", "3589"}}, []int{16, 17, 18, 19}},
+	{CardBrandUnionPay, [][2]string{{"62", "62"}}, []int{16, 17, 18, 19}},
+}
+
+// Remove the hyphens and spaces used to group the digits in the printed form of
+// codes such as ISBNs.
+func removeSeparators(v string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(v)
+}
+
+func isLuhnChecksum(v string) bool {
+	sum := 0
+	double := false
+	for i := len(v) - 1; i >= 0; i-- {
+		d := int(v[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// Check the check digit of an EAN-13 code, also used by ISBN-13.
+func isEAN13Checksum(v string) bool {
+	sum := 0
+	for i := 0; i < 13; i++ {
+		d := int(v[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// Check the mod 11 check digit used by ISBN-10 and ISSN, where the weights
+// decrease to 1 for the check digit, and "X" means 10.
+func isMod11Checksum(v string) bool {
+	sum := 0
+	for i := 0; i < len(v); i++ {
+		d := 10
+		if v[i] != 'X' {
+			d = int(v[i] - '0')
+		}
+		sum += (len(v) - i) * d
+	}
+	return sum%11 == 0
+}
+
+// Check if the value has the length and is made of digits, except the check
+// digit, which can also be "X" in the codes using mod 11.
+func isDigitsWithCheckX(v string, length int) bool {
+	return len(v) == length && isDecimalDigits(v[:length-1]) &&
+		(v[length-1] == 'X' || isDecimalDigits(v[length-1:]))
+}
+
+func cardBrandOf(v string) (CardBrand, bool) {
+	for _, rule := range cardBrandRules {
+		lengthMatches := false
+		for _, length := range rule.lengths {
+			lengthMatches = lengthMatches || len(v) == length
+		}
+		if !lengthMatches {
+			continue
+		}
+		for _, r := range rule.ranges {
+			prefix := v[:len(r[0])]
+			if prefix >= r[0] && prefix <= r[1] {
+				return rule.brand, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Check the value as a number with a Luhn check digit. All the `check*`
+// functions return if the value is valid and the error key that describes the
+// problem: the format key for a bad format, or [ErrorKeyChecksum] for a bad
+// check digit.
+func checkLuhn(v string) (bool, string) {
+	if len(v) < 2 || !isDecimalDigits(v) {
+		return false, ErrorKeyLuhn
+	}
+	if !isLuhnChecksum(v) {
+		return false, ErrorKeyChecksum
+	}
+	return true, ErrorKeyLuhn
+}
+
+func checkCreditCard(v string) (bool, string) {
+	if len(v) < 12 || len(v) > 19 || !isDecimalDigits(v) {
+		return false, ErrorKeyCreditCard
+	}
+	if !isLuhnChecksum(v) {
+		return false, ErrorKeyChecksum
+	}
+	return true, ErrorKeyCreditCard
+}
+
+func checkCreditCardBrand(v string, brands []CardBrand) (bool, string) {
+	if valid, errorKey := checkCreditCard(v); !valid {
+		return valid, errorKey
+	}
+	brand, ok := cardBrandOf(v)
+	if !ok {
+		return false, ErrorKeyCreditCardBrand
+	}
+	for _, b := range brands {
+		if b == brand {
+			return true, ErrorKeyCreditCardBrand
+		}
+	}
+	return false, ErrorKeyCreditCardBrand
+}
+
+// Check the value as an IBAN in the electronic form, or in the printed form
+// with spaces. The letters must be uppercase, and the length must match the
+// length of the country.
+func checkIBAN(v string) (bool, string) {
+	v = strings.ReplaceAll(v, " ", "")
+	if len(v) < 5 || ibanLengths[v[:2]] != len(v) || !isDecimalDigits(v[2:4]) {
+		return false, ErrorKeyIBAN
+	}
+	for i := 4; i < len(v); i++ {
+		if !(v[i] >= '0' && v[i] <= '9' || v[i] >= 'A' && v[i] <= 'Z') {
+			return false, ErrorKeyIBAN
+		}
+	}
+
+	// Move the country code and check digits to the end, convert the letters
+	// to numbers (A = 10, ..., Z = 35), and compute the remainder by 97
+	// digit by digit to avoid big numbers.
+	remainder := 0
+	for _, c := range v[4:] + v[:4] {
+		if c >= 'A' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	if remainder != 1 {
+		return false, ErrorKeyChecksum
+	}
+	return true, ErrorKeyIBAN
+}
+
+func checkIBANCountry(v string, countries []string) (bool, string) {
+	v = strings.ReplaceAll(v, " ", "")
+	if valid, errorKey := checkIBAN(v); !valid {
+		return valid, errorKey
+	}
+	for _, country := range countries {
+		if strings.EqualFold(v[:2], country) {
+			return true, ErrorKeyIBANCountry
+		}
+	}
+	return false, ErrorKeyIBANCountry
+}
+
+// Check if the value is a BIC (ISO 9362): 4 letters for the institution, 2
+// letters for the country, 2 letters or digits for the location, and an
+// optional branch code of 3 letters or digits. The letters must be uppercase.
+func isBIC(v string) bool {
+	if len(v) != 8 && len(v) != 11 {
+		return false
+	}
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		isLetter := c >= 'A' && c <= 'Z'
+		if !isLetter && (i < 6 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func checkISBN10(v string) (bool, string) {
+	v = removeSeparators(v)
+	if !isDigitsWithCheckX(v, 10) {
+		return false, ErrorKeyISBN10
+	}
+	if !isMod11Checksum(v) {
+		return false, ErrorKeyChecksum
+	}
+	return true, ErrorKeyISBN10
+}
+
+func checkISBN13(v string) (bool, string) {
+	v = removeSeparators(v)
+	if len(v) != 13 || !isDecimalDigits(v) || (v[:3] != "978" && v[:3] != "979") {
+		return false, ErrorKeyISBN13
+	}
+	if !isEAN13Checksum(v) {
+		return false, ErrorKeyChecksum
+	}
+	return true, ErrorKeyISBN13
+}
+
+func checkEAN13(v string) (bool, string) {
+	if len(v) != 13 || !isDecimalDigits(v) {
+		return false, ErrorKeyEAN13
+	}
+	if !isEAN13Checksum(v) {
+		return false, ErrorKeyChecksum
+	}
+	return true, ErrorKeyEAN13
+}
+
+// Check the value as an ISSN, such as "0378-5955" or "03785955".
+func checkISSN(v string) (bool, string) {
+	if len(v) == 9 && v[4] == '-' {
+		v = v[:4] + v[5:]
+	}
+	if !isDigitsWithCheckX(v, 8) {
+		return false, ErrorKeyISSN
+	}
+	if !isMod11Checksum(v) {
+		return false, ErrorKeyChecksum
+	}
+	return true, ErrorKeyISSN
+}
+
+func joinCardBrands(brands []CardBrand) string {
+	s := make([]string, len(brands))
+	for i, b := range brands {
+		s[i] = string(b)
+	}
+	return strings.Join(s, ", ")
+}
+
+// Validate if a string is a number with a valid Luhn (mod 10) check digit, such
+// as the numbers of payment cards or IMEIs. Only digits are allowed. A value
+// with a bad format and a value with a bad check digit get different error
+// messages.
+// For example:
+//
+//	imei := "490154203237518"
+//	Is(v.String(imei).Luhn())
+func (validator *ValidatorString[T]) Luhn(template ...string) *ValidatorString[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkLuhn(string(validator.context.Value().(T)))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is a payment card number: 12 to 19 digits, without
+// spaces, with a valid Luhn check digit. A value with a bad format and a value
+// with a bad check digit get different error messages.
+// For example:
+//
+//	card := "4111111111111111"
+//	Is(v.String(card).CreditCard())
+func (validator *ValidatorString[T]) CreditCard(template ...string) *ValidatorString[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkCreditCard(string(validator.context.Value().(T)))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is a payment card number, like in `CreditCard`, of one
+// of the brands. The brand is detected by the prefix and the length of the
+// number.
+// For example:
+//
+//	card := "4111111111111111"
+//	Is(v.String(card).CreditCardBrand([]v.CardBrand{v.CardBrandVisa, v.CardBrandMastercard}))
+func (validator *ValidatorString[T]) CreditCardBrand(brands []CardBrand, template ...string) *ValidatorString[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkCreditCardBrand(string(validator.context.Value().(T)), brands)
+		},
+		map[string]any{"title": validator.context.title, "brands": joinCardBrands(brands), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is an IBAN, in the electronic form or in the printed
+// form with spaces, such as "DE89 3704 0044 0532 0130 00". The country code
+// must be in the IBAN registry and the length must match the country. A value
+// with a bad format and a value with bad check digits get different error
+// messages.
+// For example:
+//
+//	iban := "DE89370400440532013000"
+//	Is(v.String(iban).IBAN())
+func (validator *ValidatorString[T]) IBAN(template ...string) *ValidatorString[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkIBAN(string(validator.context.Value().(T)))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is an IBAN, like in `IBAN`, of one of the countries. The
+// countries are ISO 3166 alpha-2 codes, such as "DE".
+// For example:
+//
+//	iban := "DE89370400440532013000"
+//	Is(v.String(iban).IBANCountry([]string{"DE", "AT", "CH"}))
+func (validator *ValidatorString[T]) IBANCountry(countries []string, template ...string) *ValidatorString[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkIBANCountry(string(validator.context.Value().(T)), countries)
+		},
+		map[string]any{"title": validator.context.title, "countries": strings.Join(countries, ", "), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is a BIC (SWIFT code) of 8 or 11 uppercase characters,
+// such as "DEUTDEFF" or "DEUTDEFF500". A BIC has no check digit.
+// For example:
+//
+//	bic := "DEUTDEFF"
+//	Is(v.String(bic).BIC())
+func (validator *ValidatorString[T]) BIC(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isBIC(string(validator.context.Value().(T)))
+		},
+		ErrorKeyBIC, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is an ISBN-10, with or without hyphens or spaces, such as
+// "0-306-40615-2". The check digit can be "X".
+// For example:
+//
+//	isbn := "0-306-40615-2"
+//	Is(v.String(isbn).ISBN10())
+func (validator *ValidatorString[T]) ISBN10(template ...string) *ValidatorString[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkISBN10(string(validator.context.Value().(T)))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is an ISBN-13, with or without hyphens or spaces, such as
+// "978-0-306-40615-7". The prefix must be 978 or 979.
+// For example:
+//
+//	isbn := "978-0-306-40615-7"
+//	Is(v.String(isbn).ISBN13())
+func (validator *ValidatorString[T]) ISBN13(template ...string) *ValidatorString[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkISBN13(string(validator.context.Value().(T)))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is an EAN-13 barcode number of 13 digits with a valid
+// check digit.
+// For example:
+//
+//	barcode := "4006381333931"
+//	Is(v.String(barcode).EAN13())
+func (validator *ValidatorString[T]) EAN13(template ...string) *ValidatorString[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkEAN13(string(validator.context.Value().(T)))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is an ISSN, with or without the hyphen, such as
+// "0378-5955". The check digit can be "X".
+// For example:
+//
+//	issn := "0378-5955"
+//	Is(v.String(issn).ISSN())
+func (validator *ValidatorString[T]) ISSN(template ...string) *ValidatorString[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkISSN(string(validator.context.Value().(T)))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a number with a valid Luhn check digit, like in
+// [ValidatorString.Luhn].
+// For example:
+//
+//	imei := "490154203237518"
+//	Is(v.StringP(&imei).Luhn())
+func (validator *ValidatorStringP[T]) Luhn(template ...string) *ValidatorStringP[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyLuhn
+			}
+			return checkLuhn(string(*(validator.context.Value().(*T))))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a payment card number, like in
+// [ValidatorString.CreditCard].
+// For example:
+//
+//	card := "4111111111111111"
+//	Is(v.StringP(&card).CreditCard())
+func (validator *ValidatorStringP[T]) CreditCard(template ...string) *ValidatorStringP[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyCreditCard
+			}
+			return checkCreditCard(string(*(validator.context.Value().(*T))))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a payment card number of one of the brands, like in
+// [ValidatorString.CreditCardBrand].
+// For example:
+//
+//	card := "4111111111111111"
+//	Is(v.StringP(&card).CreditCardBrand([]v.CardBrand{v.CardBrandVisa}))
+func (validator *ValidatorStringP[T]) CreditCardBrand(brands []CardBrand, template ...string) *ValidatorStringP[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyCreditCard
+			}
+			return checkCreditCardBrand(string(*(validator.context.Value().(*T))), brands)
+		},
+		map[string]any{"title": validator.context.title, "brands": joinCardBrands(brands), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an IBAN, like in
+// [ValidatorString.IBAN].
+// For example:
+//
+//	iban := "DE89370400440532013000"
+//	Is(v.StringP(&iban).IBAN())
+func (validator *ValidatorStringP[T]) IBAN(template ...string) *ValidatorStringP[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyIBAN
+			}
+			return checkIBAN(string(*(validator.context.Value().(*T))))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an IBAN of one of the countries, like in
+// [ValidatorString.IBANCountry].
+// For example:
+//
+//	iban := "DE89370400440532013000"
+//	Is(v.StringP(&iban).IBANCountry([]string{"DE"}))
+func (validator *ValidatorStringP[T]) IBANCountry(countries []string, template ...string) *ValidatorStringP[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyIBAN
+			}
+			return checkIBANCountry(string(*(validator.context.Value().(*T))), countries)
+		},
+		map[string]any{"title": validator.context.title, "countries": strings.Join(countries, ", "), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a BIC (SWIFT code), like in
+// [ValidatorString.BIC].
+// For example:
+//
+//	bic := "DEUTDEFF"
+//	Is(v.StringP(&bic).BIC())
+func (validator *ValidatorStringP[T]) BIC(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isBIC(string(*(validator.context.Value().(*T))))
+		},
+		ErrorKeyBIC, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an ISBN-10, like in
+// [ValidatorString.ISBN10].
+// For example:
+//
+//	isbn := "0-306-40615-2"
+//	Is(v.StringP(&isbn).ISBN10())
+func (validator *ValidatorStringP[T]) ISBN10(template ...string) *ValidatorStringP[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyISBN10
+			}
+			return checkISBN10(string(*(validator.context.Value().(*T))))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an ISBN-13, like in
+// [ValidatorString.ISBN13].
+// For example:
+//
+//	isbn := "978-0-306-40615-7"
+//	Is(v.StringP(&isbn).ISBN13())
+func (validator *ValidatorStringP[T]) ISBN13(template ...string) *ValidatorStringP[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyISBN13
+			}
+			return checkISBN13(string(*(validator.context.Value().(*T))))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an EAN-13 barcode number, like in
+// [ValidatorString.EAN13].
+// For example:
+//
+//	barcode := "4006381333931"
+//	Is(v.StringP(&barcode).EAN13())
+func (validator *ValidatorStringP[T]) EAN13(template ...string) *ValidatorStringP[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyEAN13
+			}
+			return checkEAN13(string(*(validator.context.Value().(*T))))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an ISSN, like in
+// [ValidatorString.ISSN].
+// For example:
+//
+//	issn := "0378-5955"
+//	Is(v.StringP(&issn).ISSN())
+func (validator *ValidatorStringP[T]) ISSN(template ...string) *ValidatorStringP[T] {
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyISSN
+			}
+			return checkISSN(string(*(validator.context.Value().(*T))))
+		},
+		map[string]any{"title": validator.context.title, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
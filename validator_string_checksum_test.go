@@ -0,0 +1,291 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringLuhn(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("79927398713").Luhn())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("79927398710").Luhn())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 has an invalid check digit",
+		v.Errors()["value_0"].Messages()[0])
+
+	for _, s := range []string{"", "0", "7992 7398 713", "7992739871a"} {
+		v = Is(String(s).Luhn())
+		assert.False(t, v.Valid(), s)
+		assert.Equal(t,
+			"Value 0 must be a valid Luhn number",
+			v.Errors()["value_0"].Messages()[0])
+	}
+
+	v = Is(String("79927398713").Not().Luhn())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 can't be a valid Luhn number",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(String("79927398710").Not().Luhn())
+	assert.True(t, v.Valid())
+}
+
+func TestValidatorStringCreditCard(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("4111111111111111").CreditCard())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("4111 1111 1111 1111", "card").CreditCard())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Card must be a valid credit card number",
+		v.Errors()["card"].Messages()[0])
+
+	v = Is(String("79927398713").CreditCard())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be a valid credit card number",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(String("4111111111111112", "card").CreditCard())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Card has an invalid check digit",
+		v.Errors()["card"].Messages()[0])
+}
+
+func TestValidatorStringCreditCardBrand(t *testing.T) {
+
+	var v *Validation
+
+	for card, brand := range map[string]CardBrand{
+		"4111111111111111": CardBrandVisa,
+		"5555555555554444": CardBrandMastercard,
+		"2223003122003222": CardBrandMastercard,
+		"378282246310005":  CardBrandAmex,
+		"6011111111111117": CardBrandDiscover,
+		"3530111333300000": CardBrandJCB,
+		"30569309025904":   CardBrandDinersClub,
+		"6200000000000005": CardBrandUnionPay,
+	} {
+		assert.True(t, Is(String(card).CreditCardBrand([]CardBrand{brand})).Valid(), card)
+	}
+
+	v = Is(String("378282246310005", "card").CreditCardBrand([]CardBrand{CardBrandVisa, CardBrandMastercard}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Card must be a card of the brands \"visa, mastercard\"",
+		v.Errors()["card"].Messages()[0])
+
+	v = Is(String("4111111111111112", "card").CreditCardBrand([]CardBrand{CardBrandVisa}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Card has an invalid check digit",
+		v.Errors()["card"].Messages()[0])
+}
+
+func TestValidatorStringIBAN(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("DE89370400440532013000").IBAN())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, s := range []string{"GB82WEST12345698765432", "DE89 3704 0044 0532 0130 00", "NO9386011117947"} {
+		assert.True(t, Is(String(s).IBAN()).Valid(), s)
+	}
+
+	for _, s := range []string{"", "DE", "DE8937040044053201300", "de89370400440532013000", "XX89370400440532013000", "DEAB370400440532013000", "DE89-370400440532013000"} {
+		v = Is(String(s, "iban", "IBAN").IBAN())
+		assert.False(t, v.Valid(), s)
+		assert.Equal(t,
+			"IBAN must be a valid IBAN",
+			v.Errors()["iban"].Messages()[0])
+	}
+
+	v = Is(String("DE89370400440532013001", "iban", "IBAN").IBAN())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"IBAN has an invalid check digit",
+		v.Errors()["iban"].Messages()[0])
+}
+
+func TestValidatorStringIBANCountry(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("DE89370400440532013000").IBANCountry([]string{"AT", "de"}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("GB82 WEST 1234 5698 7654 32", "iban", "IBAN").IBANCountry([]string{"DE", "AT"}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"IBAN must be an IBAN of the countries \"DE, AT\"",
+		v.Errors()["iban"].Messages()[0])
+
+	v = Is(String("DE89370400440532013001", "iban", "IBAN").IBANCountry([]string{"DE"}))
+	assert.Equal(t,
+		"IBAN has an invalid check digit",
+		v.Errors()["iban"].Messages()[0])
+}
+
+func TestValidatorStringBIC(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("DEUTDEFF").BIC())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("DEUTDEFF500").BIC())
+	assert.True(t, v.Valid())
+
+	for _, s := range []string{"", "deutdeff", "DEUT1EFF", "DEUTDEF", "DEUTDEFF5", "DEUTDE-F"} {
+		v = Is(String(s, "bic", "BIC").BIC())
+		assert.False(t, v.Valid(), s)
+		assert.Equal(t,
+			"BIC must be a valid BIC",
+			v.Errors()["bic"].Messages()[0])
+	}
+}
+
+func TestValidatorStringISBN(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("0-306-40615-2").ISBN10())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.True(t, Is(String("080442957X").ISBN10()).Valid())
+	assert.True(t, Is(String("978-0-306-40615-7").ISBN13()).Valid())
+	assert.True(t, Is(String("978 0 306 40615 7").ISBN13()).Valid())
+
+	v = Is(String("0-306-40615-3", "isbn", "ISBN").ISBN10())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"ISBN has an invalid check digit",
+		v.Errors()["isbn"].Messages()[0])
+
+	v = Is(String("0-306-4061X-2", "isbn", "ISBN").ISBN10())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"ISBN must be a valid ISBN-10",
+		v.Errors()["isbn"].Messages()[0])
+
+	v = Is(String("9780306406158", "isbn", "ISBN").ISBN13())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"ISBN has an invalid check digit",
+		v.Errors()["isbn"].Messages()[0])
+
+	v = Is(String("4006381333931", "isbn", "ISBN").ISBN13())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"ISBN must be a valid ISBN-13",
+		v.Errors()["isbn"].Messages()[0])
+}
+
+func TestValidatorStringEAN13(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("4006381333931").EAN13())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("4006381333932", "barcode").EAN13())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Barcode has an invalid check digit",
+		v.Errors()["barcode"].Messages()[0])
+
+	v = Is(String("400638133393", "barcode").EAN13())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Barcode must be a valid EAN-13",
+		v.Errors()["barcode"].Messages()[0])
+}
+
+func TestValidatorStringISSN(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("0378-5955").ISSN())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.True(t, Is(String("03785955").ISSN()).Valid())
+	assert.True(t, Is(String("2434-561X").ISSN()).Valid())
+
+	v = Is(String("0378-5956", "issn", "ISSN").ISSN())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"ISSN has an invalid check digit",
+		v.Errors()["issn"].Messages()[0])
+
+	v = Is(String("037-85955", "issn", "ISSN").ISSN())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"ISSN must be a valid ISSN",
+		v.Errors()["issn"].Messages()[0])
+}
+
+func TestValidatorStringPChecksums(t *testing.T) {
+
+	var v *Validation
+
+	card := "4111111111111111"
+	iban := "DE89370400440532013000"
+	bic := "DEUTDEFF"
+	isbn := "978-0-306-40615-7"
+	v = Is(StringP(&card).Luhn().CreditCard().CreditCardBrand([]CardBrand{CardBrandVisa})).
+		Is(StringP(&iban).IBAN().IBANCountry([]string{"DE"})).
+		Is(StringP(&bic).BIC()).
+		Is(StringP(&isbn).ISBN13())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	card = "4111111111111112"
+	v = Is(StringP(&card, "card").CreditCard())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Card has an invalid check digit",
+		v.Errors()["card"].Messages()[0])
+
+	var nilString *string
+	v = Is(StringP(nilString, "card").CreditCard())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Card must be a valid credit card number",
+		v.Errors()["card"].Messages()[0])
+
+	assert.False(t, Is(StringP(nilString).BIC()).Valid())
+	assert.False(t, Is(StringP(nilString).ISSN()).Valid())
+}
+
+func TestValidatorStringChecksumLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs}).Is(String("DE89370400440532013001", "iban", "IBAN").IBAN())
+	assert.Equal(t,
+		"IBAN tiene un dígito de control inválido",
+		v.Errors()["iban"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(String("DE89", "iban", "IBAN").IBAN())
+	assert.Equal(t,
+		"IBAN debe ser un IBAN válido",
+		v.Errors()["iban"].Messages()[0])
+}
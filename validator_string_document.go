@@ -0,0 +1,333 @@
+package valgo
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"text/template/parse"
+	"unicode/utf8"
+)
+
+// Position of a syntax error in an embedded document. The line and column
+// start at 1, and the column counts characters, not bytes.
+type documentPosition struct {
+	line   int
+	column int
+}
+
+// Return the position of the byte at the index of the document.
+func documentPositionAt(v string, index int) documentPosition {
+	index = min(max(index, 0), len(v))
+	lineStart := strings.LastIndexByte(v[:index], '\n') + 1
+	return documentPosition{
+		line:   strings.Count(v[:index], "\n") + 1,
+		column: utf8.RuneCountInString(v[lineStart:index]) + 1,
+	}
+}
+
+// Set the position of the syntax error in the template params of a rule, so
+// the error message can show where the document is broken. The "column" param
+// is not set when the position has no column, as in the Go template errors.
+func setDocumentPositionParams(params map[string]any, position documentPosition) {
+	params["line"] = position.line
+	if position.column > 0 {
+		params["column"] = position.column
+	} else {
+		delete(params, "column")
+	}
+}
+
+// Parse the value as JSON and return the position of the syntax error, if any.
+func checkJSON(v string) (documentPosition, bool) {
+	var raw json.RawMessage
+	err := json.Unmarshal([]byte(v), &raw)
+	if err == nil {
+		return documentPosition{}, true
+	}
+
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		return documentPositionAt(v, 0), false
+	}
+	// The offset is the number of bytes read when the error occurred, so the
+	// invalid character is the last byte read, unless the input ended early
+	index := int(syntaxError.Offset) - 1
+	if syntaxError.Offset == int64(len(v)) && isJSONEndedEarly(v) {
+		index = len(v)
+	}
+	return documentPositionAt(v, index), false
+}
+
+// Check if the JSON syntax error at the end of the value is because the input
+// ended early, rather than because the last character is invalid. A trailing
+// space moves the error of an input that ended early, but not the error of an
+// invalid character.
+func isJSONEndedEarly(v string) bool {
+	var raw json.RawMessage
+	var syntaxError *json.SyntaxError
+	err := json.Unmarshal([]byte(v+" "), &raw)
+	return errors.As(err, &syntaxError) && syntaxError.Offset > int64(len(v))
+}
+
+// Return the first character of the value that is not a JSON whitespace.
+func jsonFirstChar(v string) byte {
+	v = strings.TrimLeft(v, " \t\r\n")
+	if v == "" {
+		return 0
+	}
+	return v[0]
+}
+
+// Compile the value as a regular expression and return the position of the
+// syntax error, if any. The error only holds the invalid part of the
+// expression, so the position is where that part first appears.
+func checkRegexp(v string) (documentPosition, bool) {
+	_, err := regexp.Compile(v)
+	if err == nil {
+		return documentPosition{}, true
+	}
+
+	var syntaxError *syntax.Error
+	if errors.As(err, &syntaxError) {
+		return documentPositionAt(v, strings.Index(v, syntaxError.Expr)), false
+	}
+	return documentPositionAt(v, 0), false
+}
+
+// Parse the value as a Go template of the text/template package and return the
+// position of the syntax error, if any. The functions called by the template
+// are not checked, since they are only known when the template is used. The
+// parser only reports the line of the error.
+func checkGoTemplate(v string) (documentPosition, bool) {
+	tree := parse.New("t")
+	tree.Mode = parse.SkipFuncCheck | parse.ParseComments
+	_, err := tree.Parse(v, "", "", map[string]*parse.Tree{})
+	if err == nil {
+		return documentPosition{}, true
+	}
+
+	// The parser errors have the form "template: t:LINE: message"
+	position := documentPosition{line: 1}
+	message, _ := strings.CutPrefix(err.Error(), "template: t:")
+	if line, _, ok := strings.Cut(message, ":"); ok {
+		if n, err := strconv.Atoi(line); err == nil {
+			position.line = n
+		}
+	}
+	return position, false
+}
+
+func isStringJSONKind[T ~string](v T, kind byte, kindErrorKey string, params map[string]any) (bool, string) {
+	position, ok := checkJSON(string(v))
+	if !ok {
+		setDocumentPositionParams(params, position)
+		return false, ErrorKeyJSON
+	}
+	return jsonFirstChar(string(v)) == kind, kindErrorKey
+}
+
+// Validate if a string is a valid JSON document. The error message can use the
+// params "line" and "column" of the syntax error.
+// For example:
+//
+//	settings := `{"retries": 3}`
+//	Is(v.String(settings).ValidJSON())
+func (validator *ValidatorString[T]) ValidJSON(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			position, ok := checkJSON(string(validator.context.Value().(T)))
+			setDocumentPositionParams(params, position)
+			return ok
+		},
+		ErrorKeyJSON, params, template...)
+
+	return validator
+}
+
+// Validate if a string is a valid JSON document with an object at the top
+// level. A document with a syntax error gets the same message as in
+// `ValidJSON`.
+// For example:
+//
+//	settings := `{"retries": 3}`
+//	Is(v.String(settings).JSONObject())
+func (validator *ValidatorString[T]) JSONObject(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return isStringJSONKind(validator.context.Value().(T), '{', ErrorKeyJSONObject, params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if a string is a valid JSON document with an array at the top level.
+// A document with a syntax error gets the same message as in `ValidJSON`.
+// For example:
+//
+//	tags := `["go", "validation"]`
+//	Is(v.String(tags).JSONArray())
+func (validator *ValidatorString[T]) JSONArray(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return isStringJSONKind(validator.context.Value().(T), '[', ErrorKeyJSONArray, params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if a string is a regular expression that compiles with the regexp
+// package. The error message can use the params "line" and "column" of the
+// syntax error.
+// For example:
+//
+//	pattern := `^[a-z]+-\d+$`
+//	Is(v.String(pattern).ValidRegexp())
+func (validator *ValidatorString[T]) ValidRegexp(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			position, ok := checkRegexp(string(validator.context.Value().(T)))
+			setDocumentPositionParams(params, position)
+			return ok
+		},
+		ErrorKeyRegexp, params, template...)
+
+	return validator
+}
+
+// Validate if a string is a Go template that parses with the text/template
+// package. The functions called by the template are not checked. The error
+// message can use the param "line" of the syntax error.
+// For example:
+//
+//	subject := "Welcome, {{.Name}}"
+//	Is(v.String(subject).ValidGoTemplate())
+func (validator *ValidatorString[T]) ValidGoTemplate(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			position, ok := checkGoTemplate(string(validator.context.Value().(T)))
+			setDocumentPositionParams(params, position)
+			return ok
+		},
+		ErrorKeyGoTemplate, params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a valid JSON document, like in
+// [ValidatorString.ValidJSON].
+// For example:
+//
+//	settings := `{"retries": 3}`
+//	Is(v.StringP(&settings).ValidJSON())
+func (validator *ValidatorStringP[T]) ValidJSON(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			if validator.context.Value().(*T) == nil {
+				setDocumentPositionParams(params, documentPosition{line: 1, column: 1})
+				return false
+			}
+			position, ok := checkJSON(string(*(validator.context.Value().(*T))))
+			setDocumentPositionParams(params, position)
+			return ok
+		},
+		ErrorKeyJSON, params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a valid JSON document with an
+// object at the top level.
+// For example:
+//
+//	settings := `{"retries": 3}`
+//	Is(v.StringP(&settings).JSONObject())
+func (validator *ValidatorStringP[T]) JSONObject(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyJSONObject
+			}
+			return isStringJSONKind(*(validator.context.Value().(*T)), '{', ErrorKeyJSONObject, params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a valid JSON document with an
+// array at the top level.
+// For example:
+//
+//	tags := `["go", "validation"]`
+//	Is(v.StringP(&tags).JSONArray())
+func (validator *ValidatorStringP[T]) JSONArray(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyJSONArray
+			}
+			return isStringJSONKind(*(validator.context.Value().(*T)), '[', ErrorKeyJSONArray, params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a regular expression that
+// compiles with the regexp package.
+// For example:
+//
+//	pattern := `^[a-z]+-\d+$`
+//	Is(v.StringP(&pattern).ValidRegexp())
+func (validator *ValidatorStringP[T]) ValidRegexp(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			if validator.context.Value().(*T) == nil {
+				setDocumentPositionParams(params, documentPosition{line: 1, column: 1})
+				return false
+			}
+			position, ok := checkRegexp(string(*(validator.context.Value().(*T))))
+			setDocumentPositionParams(params, position)
+			return ok
+		},
+		ErrorKeyRegexp, params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a Go template that parses with
+// the text/template package.
+// For example:
+//
+//	subject := "Welcome, {{.Name}}"
+//	Is(v.StringP(&subject).ValidGoTemplate())
+func (validator *ValidatorStringP[T]) ValidGoTemplate(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			if validator.context.Value().(*T) == nil {
+				setDocumentPositionParams(params, documentPosition{line: 1})
+				return false
+			}
+			position, ok := checkGoTemplate(string(*(validator.context.Value().(*T))))
+			setDocumentPositionParams(params, position)
+			return ok
+		},
+		ErrorKeyGoTemplate, params, template...)
+
+	return validator
+}
@@ -0,0 +1,172 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringValidJSON(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String(`{"retries": 3}`).ValidJSON())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, s := range []string{`[]`, `"text"`, `12.5`, `null`, " \n{\"a\": [1, 2]}\n"} {
+		assert.True(t, Is(String(s).ValidJSON()).Valid(), s)
+	}
+
+	v = Is(String("{\"a\": 1,\n \"b\": x}", "settings").ValidJSON())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Settings must be valid JSON, error at line 2, column 7",
+		v.Errors()["settings"].Messages()[0])
+
+	v = Is(String(`{"a":}`, "settings").ValidJSON())
+	assert.Equal(t,
+		"Settings must be valid JSON, error at line 1, column 6",
+		v.Errors()["settings"].Messages()[0])
+
+	v = Is(String(`[1, 2`, "settings").ValidJSON())
+	assert.Equal(t,
+		"Settings must be valid JSON, error at line 1, column 6",
+		v.Errors()["settings"].Messages()[0])
+
+	v = Is(String(`[1, `, "settings").ValidJSON())
+	assert.Equal(t,
+		"Settings must be valid JSON, error at line 1, column 5",
+		v.Errors()["settings"].Messages()[0])
+
+	v = Is(String(``, "settings").ValidJSON())
+	assert.Equal(t,
+		"Settings must be valid JSON, error at line 1, column 1",
+		v.Errors()["settings"].Messages()[0])
+
+	v = Is(String(`{}`, "settings").Not().ValidJSON())
+	assert.Equal(t,
+		"Settings can't be valid JSON",
+		v.Errors()["settings"].Messages()[0])
+}
+
+func TestValidatorStringJSONObjectAndArray(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String(` {"retries": 3}`).JSONObject())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String(`["go"]`).JSONArray())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String(`["go"]`, "settings").JSONObject())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Settings must be a JSON object",
+		v.Errors()["settings"].Messages()[0])
+
+	v = Is(String(`"go"`, "tags").JSONArray())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Tags must be a JSON array",
+		v.Errors()["tags"].Messages()[0])
+
+	v = Is(String(`{"retries": 3`, "settings").JSONObject())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Settings must be valid JSON, error at line 1, column 14",
+		v.Errors()["settings"].Messages()[0])
+}
+
+func TestValidatorStringValidRegexp(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String(`^[a-z]+-\d+$`).ValidRegexp())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String(`^ab[c-`, "pattern").ValidRegexp())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Pattern must be a valid regular expression, error at line 1, column 4",
+		v.Errors()["pattern"].Messages()[0])
+
+	v = Is(String(`a\k`, "pattern").ValidRegexp())
+	assert.Equal(t,
+		"Pattern must be a valid regular expression, error at line 1, column 2",
+		v.Errors()["pattern"].Messages()[0])
+}
+
+func TestValidatorStringValidGoTemplate(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("Welcome, {{.Name}}").ValidGoTemplate())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("{{/* greeting */}}{{upper .Name}}").ValidGoTemplate())
+	assert.True(t, v.Valid())
+
+	v = Is(String("Hello\n{{if .Admin}}admin", "subject").ValidGoTemplate())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Subject must be a valid Go template, error at line 2",
+		v.Errors()["subject"].Messages()[0])
+
+	v = Is(String("Hello {{.Name}", "subject").ValidGoTemplate())
+	assert.Equal(t,
+		"Subject must be a valid Go template, error at line 1",
+		v.Errors()["subject"].Messages()[0])
+	// The parser doesn't report a column, so the param is not set
+	v = Is(String("Hello\n{{.Name}", "subject").ValidGoTemplate("{{title}} {{line}}:{{column}}"))
+	assert.Equal(t,
+		"Subject 2:",
+		v.Errors()["subject"].Messages()[0])
+}
+
+func TestValidatorStringPDocuments(t *testing.T) {
+
+	var v *Validation
+
+	settings := `{"retries": 3}`
+	tags := `["go"]`
+	pattern := `^\d+$`
+	subject := "{{.Name}}"
+	v = Is(StringP(&settings).ValidJSON().JSONObject()).
+		Is(StringP(&tags).JSONArray()).
+		Is(StringP(&pattern).ValidRegexp()).
+		Is(StringP(&subject).ValidGoTemplate())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	pattern = `(`
+	v = Is(StringP(&pattern, "pattern").ValidRegexp())
+	assert.Equal(t,
+		"Pattern must be a valid regular expression, error at line 1, column 1",
+		v.Errors()["pattern"].Messages()[0])
+
+	var nilString *string
+	v = Is(StringP(nilString, "settings").ValidJSON())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Settings must be valid JSON, error at line 1, column 1",
+		v.Errors()["settings"].Messages()[0])
+
+	assert.False(t, Is(StringP(nilString).JSONObject()).Valid())
+	assert.False(t, Is(StringP(nilString).JSONArray()).Valid())
+	assert.False(t, Is(StringP(nilString).ValidRegexp()).Valid())
+	assert.False(t, Is(StringP(nilString).ValidGoTemplate()).Valid())
+}
+
+func TestValidatorStringDocumentLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeHu}).Is(String("{\n}}", "settings", "Beállítások").ValidJSON())
+	assert.Equal(t,
+		"Beállítások érvényes JSON kell legyen, hiba a(z) 2. sor 2. oszlopában",
+		v.Errors()["settings"].Messages()[0])
+}
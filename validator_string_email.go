@@ -0,0 +1,254 @@
+package valgo
+
+import (
+	"net/mail"
+	"net/netip"
+	"strings"
+	"unicode/utf8"
+)
+
+// Maximum lengths of an email address and its parts, in bytes, as defined by
+// RFC 5321.
+const (
+	emailMaxLength       = 254
+	emailLocalMaxLength  = 64
+	emailDomainMaxLength = 255
+	emailLabelMaxLength  = 63
+)
+
+// Options to change how the `Email` rules of [ValidatorString] and
+// [ValidatorStringP] validate an email address. The zero value validates a
+// plain address such as "john@example.com", without a display name.
+type EmailOptions struct {
+	// Allow an address with a display name, such as "John <john@example.com>".
+	AllowDisplayName bool
+	// Require a domain with a top-level domain, so "john@localhost" is invalid.
+	RequireTLD bool
+	// Reject an address with an IP literal as domain, such as "john@[192.0.2.1]".
+	RejectIPLiteral bool
+}
+
+// Parse the value as an email address, and return the address without the
+// display name split into the local part and the domain. Returns false if the
+// value is not a valid email address for the options.
+func parseEmail(v string, options EmailOptions) (string, string, bool) {
+	parsed, err := mail.ParseAddress(v)
+	if err != nil {
+		return "", "", false
+	}
+
+	address := parsed.Address
+	at := strings.LastIndexByte(address, '@')
+	local, domain := address[:at], address[at+1:]
+
+	// net/mail also accepts a name, comments, and surrounding spaces around
+	// the address. Without a display name, the value must be the bare address.
+	if !options.AllowDisplayName && (parsed.Name != "" ||
+		strings.ContainsRune(v, '<') ||
+		strings.TrimSpace(v) != v ||
+		!strings.HasSuffix(v, domain)) {
+		return "", "", false
+	}
+
+	if len(address) > emailMaxLength ||
+		len(local) > emailLocalMaxLength ||
+		len(domain) > emailDomainMaxLength {
+		return "", "", false
+	}
+
+	if literal, ok := strings.CutPrefix(domain, "["); ok {
+		return local, domain, !options.RejectIPLiteral && isEmailIPLiteral(strings.TrimSuffix(literal, "]"))
+	}
+
+	return local, domain, isEmailDomainName(domain, options.RequireTLD)
+}
+
+// Check if the content of an address literal is an IPv4 address, or an IPv6
+// address with the "IPv6:" tag, such as "192.0.2.1" or "IPv6:2001:db8::1".
+func isEmailIPLiteral(literal string) bool {
+	if len(literal) > 5 && strings.EqualFold(literal[:5], "IPv6:") {
+		addr, err := netip.ParseAddr(literal[5:])
+		return err == nil && addr.Is6() && addr.Zone() == ""
+	}
+	addr, err := netip.ParseAddr(literal)
+	return err == nil && addr.Is4()
+}
+
+// Check if the domain is made of labels with letters, digits and hyphens, where
+// a label can't start or end with a hyphen. Non ASCII letters are allowed for
+// internationalized domains.
+func isEmailDomainName(domain string, requireTLD bool) bool {
+	labels := strings.Split(domain, ".")
+	for _, label := range labels {
+		if label == "" || len(label) > emailLabelMaxLength ||
+			label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if c < utf8.RuneSelf && !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+
+	if requireTLD {
+		tld := labels[len(labels)-1]
+		if len(labels) < 2 || utf8.RuneCountInString(tld) < 2 {
+			return false
+		}
+		// A TLD is never numeric, so "john@192.0.2.1" has no TLD
+		if strings.Trim(tld, "0123456789") == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isStringEmail[T ~string](v T, options EmailOptions) bool {
+	_, _, ok := parseEmail(string(v), options)
+	return ok
+}
+
+// Check if the domain of the email address matches any of the domains. The
+// domains are matched like the hosts of [ValidatorURL.HostIn].
+func isStringEmailDomainIn[T ~string](v T, domains []string) bool {
+	_, domain, ok := parseEmail(string(v), EmailOptions{AllowDisplayName: true})
+	return ok && isHostnameIn(domain, domains)
+}
+
+func isStringEmailDomainNotIn[T ~string](v T, domains []string) bool {
+	_, domain, ok := parseEmail(string(v), EmailOptions{AllowDisplayName: true})
+	return ok && !isHostnameIn(domain, domains)
+}
+
+// Validate if a string is an email address such as "john@example.com". The
+// address is parsed with [mail.ParseAddress], and the lengths are limited as
+// defined by RFC 5321. Display names are not allowed, and domains without a
+// top-level domain, such as "john@localhost", are valid. To change that, use
+// `EmailWithOptions` instead.
+// For example:
+//
+//	email := "john@example.com"
+//	Is(v.String(email).Email())
+func (validator *ValidatorString[T]) Email(template ...string) *ValidatorString[T] {
+	return validator.EmailWithOptions(EmailOptions{}, template...)
+}
+
+// Validate if a string is an email address, using the options to allow display
+// names, require a top-level domain, or reject IP literals as domain.
+// For example:
+//
+//	email := "John <john@example.com>"
+//	Is(v.String(email).EmailWithOptions(v.EmailOptions{AllowDisplayName: true, RequireTLD: true}))
+func (validator *ValidatorString[T]) EmailWithOptions(options EmailOptions, template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringEmail(validator.context.Value().(T), options)
+		},
+		ErrorKeyEmail, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is an email address with a domain in the list. The
+// domains are compared ignoring the case, and a domain starting with "*."
+// matches any of its subdomains, but not the domain itself. A display name is
+// allowed, so combine this rule with `Email` to validate the format.
+// For example:
+//
+//	email := "john@mail.example.com"
+//	Is(v.String(email).Email().EmailDomainIn([]string{"example.com", "*.example.com"}))
+func (validator *ValidatorString[T]) EmailDomainIn(domains []string, template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringEmailDomainIn(validator.context.Value().(T), domains)
+		},
+		ErrorKeyEmailDomainIn,
+		map[string]any{"title": validator.context.title, "domains": strings.Join(domains, ", "), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is an email address with a domain that is not in the
+// list. The domains are matched like in `EmailDomainIn`. A value that is not
+// an email address doesn't satisfy this rule.
+// For example:
+//
+//	email := "john@example.com"
+//	Is(v.String(email).Email().EmailDomainNotIn([]string{"mailinator.com", "*.mailinator.com"}))
+func (validator *ValidatorString[T]) EmailDomainNotIn(domains []string, template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringEmailDomainNotIn(validator.context.Value().(T), domains)
+		},
+		ErrorKeyEmailDomainExcluded,
+		map[string]any{"title": validator.context.title, "domains": strings.Join(domains, ", "), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an email address such as
+// "john@example.com". The address is validated like in [ValidatorString.Email].
+// For example:
+//
+//	email := "john@example.com"
+//	Is(v.StringP(&email).Email())
+func (validator *ValidatorStringP[T]) Email(template ...string) *ValidatorStringP[T] {
+	return validator.EmailWithOptions(EmailOptions{}, template...)
+}
+
+// Validate if the value of a string pointer is an email address, using the
+// options to allow display names, require a top-level domain, or reject IP
+// literals as domain.
+// For example:
+//
+//	email := "John <john@example.com>"
+//	Is(v.StringP(&email).EmailWithOptions(v.EmailOptions{AllowDisplayName: true}))
+func (validator *ValidatorStringP[T]) EmailWithOptions(options EmailOptions, template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringEmail(*(validator.context.Value().(*T)), options)
+		},
+		ErrorKeyEmail, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an email address with a domain
+// in the list. The domains are matched like in [ValidatorString.EmailDomainIn].
+// For example:
+//
+//	email := "john@example.com"
+//	Is(v.StringP(&email).EmailDomainIn([]string{"example.com"}))
+func (validator *ValidatorStringP[T]) EmailDomainIn(domains []string, template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringEmailDomainIn(*(validator.context.Value().(*T)), domains)
+		},
+		ErrorKeyEmailDomainIn,
+		map[string]any{"title": validator.context.title, "domains": strings.Join(domains, ", "), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an email address with a domain
+// that is not in the list. A nil pointer doesn't satisfy this rule.
+// For example:
+//
+//	email := "john@example.com"
+//	Is(v.StringP(&email).EmailDomainNotIn([]string{"mailinator.com"}))
+func (validator *ValidatorStringP[T]) EmailDomainNotIn(domains []string, template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringEmailDomainNotIn(*(validator.context.Value().(*T)), domains)
+		},
+		ErrorKeyEmailDomainExcluded,
+		map[string]any{"title": validator.context.title, "domains": strings.Join(domains, ", "), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
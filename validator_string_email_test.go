@@ -0,0 +1,179 @@
+package valgo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringEmail(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("john@example.com").Email())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, s := range []string{
+		"john.doe+tag@example.com",
+		"\"john doe\"@example.com",
+		"john@localhost",
+		"john@bücher.de",
+		"john@xn--bcher-kva.de",
+		"john@[192.0.2.1]",
+		"john@[IPv6:2001:db8::1]",
+	} {
+		assert.True(t, Is(String(s).Email()).Valid(), s)
+	}
+
+	for _, s := range []string{
+		"",
+		"john",
+		"john@",
+		"@example.com",
+		"john..doe@example.com",
+		"john@example..com",
+		"john@example.com.",
+		"john@-example.com",
+		"john@example-.com",
+		"john@ex!ample.com",
+		"john@ex_ample.com",
+		" john@example.com",
+		"john@example.com (John)",
+		"John <john@example.com>",
+		"john@[300.0.0.1]",
+		"john@[2001:db8::1]",
+		"john@[IPv6:192.0.2.1]",
+		strings.Repeat("a", 65) + "@example.com",
+		"john@" + strings.Repeat("a", 64) + ".com",
+		"john@" + strings.Repeat(strings.Repeat("a", 60)+".", 5) + "com",
+	} {
+		assert.False(t, Is(String(s).Email()).Valid(), s)
+	}
+
+	type Email string
+	v = Is(String(Email("john@example.com")).Email())
+	assert.True(t, v.Valid())
+
+	v = Is(String("john@", "email").Email())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Email must be a valid email address",
+		v.Errors()["email"].Messages()[0])
+
+	v = Is(String("john@example.com").Not().Email())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 can't be a valid email address",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorStringEmailWithOptions(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("John Doe <john@example.com>").EmailWithOptions(EmailOptions{AllowDisplayName: true}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("john@example.com").EmailWithOptions(EmailOptions{AllowDisplayName: true}))
+	assert.True(t, v.Valid())
+
+	v = Is(String("john@example.com").EmailWithOptions(EmailOptions{RequireTLD: true}))
+	assert.True(t, v.Valid())
+
+	for _, s := range []string{"john@localhost", "john@example.c", "john@example.123"} {
+		assert.False(t, Is(String(s).EmailWithOptions(EmailOptions{RequireTLD: true})).Valid(), s)
+	}
+
+	v = Is(String("john@[192.0.2.1]").EmailWithOptions(EmailOptions{RejectIPLiteral: true}))
+	assert.False(t, v.Valid())
+
+	v = Is(String("john@example.com").EmailWithOptions(EmailOptions{RejectIPLiteral: true}))
+	assert.True(t, v.Valid())
+}
+
+func TestValidatorStringEmailDomainIn(t *testing.T) {
+
+	var v *Validation
+
+	domains := []string{"example.com", "*.example.org"}
+
+	v = Is(String("john@Example.com").EmailDomainIn(domains))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("John <john@mail.example.org>").EmailDomainIn(domains))
+	assert.True(t, v.Valid())
+
+	v = Is(String("john@example.org").EmailDomainIn(domains))
+	assert.False(t, v.Valid())
+
+	v = Is(String("john@example.com.evil.net", "email").EmailDomainIn(domains))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Email must use one of the domains \"example.com, *.example.org\"",
+		v.Errors()["email"].Messages()[0])
+}
+
+func TestValidatorStringEmailDomainNotIn(t *testing.T) {
+
+	var v *Validation
+
+	disposable := []string{"mailinator.com", "*.mailinator.com"}
+
+	v = Is(String("john@example.com").EmailDomainNotIn(disposable))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("john@MAILINATOR.com", "email").EmailDomainNotIn(disposable))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Email can't use any of the domains \"mailinator.com, *.mailinator.com\"",
+		v.Errors()["email"].Messages()[0])
+
+	v = Is(String("john@example.com").Not().EmailDomainNotIn(disposable))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must use one of the domains \"mailinator.com, *.mailinator.com\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(String("not-an-email").EmailDomainNotIn(disposable))
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorStringPEmail(t *testing.T) {
+
+	var v *Validation
+
+	email := "john@example.com"
+	v = Is(StringP(&email).Email().EmailDomainIn([]string{"example.com"}).EmailDomainNotIn([]string{"mailinator.com"}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	email = "John <john@example.com>"
+	v = Is(StringP(&email, "email").Email())
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Email must be a valid email address",
+		v.Errors()["email"].Messages()[0])
+
+	v = Is(StringP(&email).EmailWithOptions(EmailOptions{AllowDisplayName: true}))
+	assert.True(t, v.Valid())
+
+	var nilEmail *string
+	v = Is(StringP(nilEmail).Email())
+	assert.False(t, v.Valid())
+
+	v = Is(StringP(nilEmail).EmailDomainNotIn([]string{"mailinator.com"}))
+	assert.False(t, v.Valid())
+}
+
+func TestValidatorStringEmailLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs}).Is(String("john", "email").Email())
+	assert.Equal(t,
+		"Email debe ser una dirección de correo electrónico válida",
+		v.Errors()["email"].Messages()[0])
+}
@@ -0,0 +1,169 @@
+package valgo
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"strings"
+)
+
+// Check if the value is a non-empty string of hexadecimal digits, lowercase or
+// uppercase. If length is greater than zero, the value must have exactly that
+// number of digits.
+func isStringHex[T ~string](v T, length int) bool {
+	if len(v) == 0 || (length > 0 && len(v) != length) {
+		return false
+	}
+	for i := 0; i < len(v); i++ {
+		if !isHexDigit(v[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Check if the value is a non-empty string that can be decoded with the
+// encoding. The decoders of the standard library ignore line breaks, so they
+// are rejected here.
+func isStringBase64[T ~string](v T, encoding *base64.Encoding) bool {
+	if encoding == nil {
+		encoding = base64.StdEncoding
+	}
+	if len(v) == 0 || strings.ContainsAny(string(v), "\r\n") {
+		return false
+	}
+	_, err := encoding.Strict().DecodeString(string(v))
+	return err == nil
+}
+
+// Check if the value is a non-empty string encoded with the standard Base32
+// alphabet, with or without padding.
+func isStringBase32[T ~string](v T) bool {
+	if len(v) == 0 || strings.ContainsAny(string(v), "\r\n") {
+		return false
+	}
+	encoding := base32.StdEncoding
+	if !strings.HasSuffix(string(v), "=") {
+		// Without padding, the decoder accepts lengths that no input encodes to
+		switch len(v) % 8 {
+		case 1, 3, 6:
+			return false
+		}
+		encoding = encoding.WithPadding(base32.NoPadding)
+	}
+	_, err := encoding.DecodeString(string(v))
+	return err == nil
+}
+
+// Validate if a string is made of hexadecimal digits, lowercase or uppercase,
+// without a "0x" prefix. If length is greater than zero, the string must have
+// exactly that number of digits; otherwise any non-empty string is valid.
+// For example:
+//
+//	checksum := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+//	Is(v.String(checksum).Hex(64))
+func (validator *ValidatorString[T]) Hex(length int, template ...string) *ValidatorString[T] {
+	key := ErrorKeyHex
+	if length > 0 {
+		key = ErrorKeyHexLength
+	}
+
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringHex(validator.context.Value().(T), length)
+		},
+		key,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string can be decoded with the Base64 encoding, which is one of
+// [base64.StdEncoding], [base64.URLEncoding], [base64.RawStdEncoding], or
+// [base64.RawURLEncoding] to choose the alphabet and whether the string is
+// padded. A nil encoding is the same as [base64.StdEncoding]. An empty string
+// is not valid.
+// For example:
+//
+//	token := "c2VjcmV0X3Rva2Vu"
+//	Is(v.String(token).Base64(base64.RawURLEncoding))
+func (validator *ValidatorString[T]) Base64(encoding *base64.Encoding, template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringBase64(validator.context.Value().(T), encoding)
+		},
+		ErrorKeyBase64, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is encoded with the standard Base32 alphabet defined by
+// RFC 4648, with or without padding. An empty string is not valid.
+// For example:
+//
+//	secret := "JBSWY3DPEHPK3PXP"
+//	Is(v.String(secret).Base32())
+func (validator *ValidatorString[T]) Base32(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringBase32(validator.context.Value().(T))
+		},
+		ErrorKeyBase32, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is made of hexadecimal digits, like
+// in [ValidatorString.Hex].
+// For example:
+//
+//	color := "ff8800"
+//	Is(v.StringP(&color).Hex(6))
+func (validator *ValidatorStringP[T]) Hex(length int, template ...string) *ValidatorStringP[T] {
+	key := ErrorKeyHex
+	if length > 0 {
+		key = ErrorKeyHexLength
+	}
+
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringHex(*(validator.context.Value().(*T)), length)
+		},
+		key,
+		map[string]any{"title": validator.context.title, "length": length, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer can be decoded with the Base64
+// encoding, like in [ValidatorString.Base64].
+// For example:
+//
+//	token := "c2VjcmV0X3Rva2Vu"
+//	Is(v.StringP(&token).Base64(base64.StdEncoding))
+func (validator *ValidatorStringP[T]) Base64(encoding *base64.Encoding, template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringBase64(*(validator.context.Value().(*T)), encoding)
+		},
+		ErrorKeyBase64, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is encoded with the standard Base32
+// alphabet, with or without padding.
+// For example:
+//
+//	secret := "JBSWY3DPEHPK3PXP"
+//	Is(v.StringP(&secret).Base32())
+func (validator *ValidatorStringP[T]) Base32(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringBase32(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyBase32, validator.context.Value(), template...)
+
+	return validator
+}
@@ -0,0 +1,104 @@
+package valgo
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringHex(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("ff8800").Hex(6))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("DEADbeef").Hex(0))
+	assert.True(t, v.Valid())
+
+	for _, s := range []string{"", "0xff", "ff 88", "fg"} {
+		assert.False(t, Is(String(s).Hex(0)).Valid(), s)
+	}
+
+	v = Is(String("zz").Hex(0))
+	assert.Equal(t,
+		"Value 0 must be a hexadecimal string",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(String("ff88", "color").Hex(6))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Color must be a hexadecimal string of \"6\" characters",
+		v.Errors()["color"].Messages()[0])
+}
+
+func TestValidatorStringBase64(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("aGk/Pz8=").Base64(base64.StdEncoding))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("aGk/Pz8=").Base64(nil))
+	assert.True(t, v.Valid())
+
+	v = Is(String("aGk_Pz8").Base64(base64.RawURLEncoding))
+	assert.True(t, v.Valid())
+
+	v = Is(String("aGk_Pz8=").Base64(base64.URLEncoding))
+	assert.True(t, v.Valid())
+
+	for _, s := range []string{"", "aGk_Pz8=", "aGk/Pz8", "aGk/\nPz8=", "aGk/Pz9="} {
+		assert.False(t, Is(String(s).Base64(base64.StdEncoding)).Valid(), s)
+	}
+
+	v = Is(String("aGk/Pz8=", "token").Base64(base64.RawURLEncoding))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Token must be a valid Base64 string",
+		v.Errors()["token"].Messages()[0])
+}
+
+func TestValidatorStringBase32(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("JBSWY3DPEHPK3PXP").Base32())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("NBSWY3DP").Base32())
+	assert.True(t, v.Valid())
+
+	v = Is(String("NBSWY3A=").Base32())
+	assert.True(t, v.Valid())
+
+	for _, s := range []string{"", "jbswy3dpehpk3pxp", "JBSWY3DPEHPK3PX1", "NBSWY3", "NBSW\nY3DP"} {
+		assert.False(t, Is(String(s).Base32()).Valid(), s)
+	}
+
+	v = Is(String("123", "secret").Base32())
+	assert.Equal(t,
+		"Secret must be a valid Base32 string",
+		v.Errors()["secret"].Messages()[0])
+}
+
+func TestValidatorStringPEncodings(t *testing.T) {
+
+	var v *Validation
+
+	hex := "ff8800"
+	token := "aGk/Pz8="
+	secret := "JBSWY3DPEHPK3PXP"
+	v = Is(StringP(&hex).Hex(6)).Is(StringP(&token).Base64(base64.StdEncoding)).Is(StringP(&secret).Base32())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	var nilString *string
+	assert.False(t, Is(StringP(nilString).Hex(0)).Valid())
+	assert.False(t, Is(StringP(nilString).Base64(base64.StdEncoding)).Valid())
+	assert.False(t, Is(StringP(nilString).Base32()).Valid())
+}
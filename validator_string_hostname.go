@@ -0,0 +1,399 @@
+package valgo
+
+import (
+	"math"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+const (
+	hostnameMaxLength      = 253
+	hostnameLabelMaxLength = 63
+)
+
+// Check if the label only has letters, digits and hyphens, and doesn't start or
+// end with a hyphen.
+func isHostnameLabel(label string) bool {
+	if label == "" || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for i := 0; i < len(label); i++ {
+		if !isAlphanumericASCII(label[i]) && label[i] != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// Check the syntax of a host name defined by RFC 1123 and return its labels. A
+// host name is made of labels of letters, digits and hyphens separated by dots,
+// with at most 63 characters each and 253 in total. If the host name is wrong,
+// the error key says what is wrong, and the wrong label and the maximum length
+// are set in the "label" and "max" template params. Otherwise, the error key is
+// the key of the rule.
+func checkHostnameLabels(v string, allowTrailingDot bool, key string, params map[string]any) ([]string, bool, string) {
+	if allowTrailingDot {
+		v = strings.TrimSuffix(v, ".")
+	}
+	if v == "" {
+		return nil, false, key
+	}
+	if len(v) > hostnameMaxLength {
+		params["max"] = hostnameMaxLength
+		return nil, false, ErrorKeyHostnameLength
+	}
+
+	labels := strings.Split(v, ".")
+	for _, label := range labels {
+		switch {
+		case label == "":
+			return nil, false, ErrorKeyHostnameEmptyLabel
+		case len(label) > hostnameLabelMaxLength:
+			params["label"], params["max"] = label, hostnameLabelMaxLength
+			return nil, false, ErrorKeyHostnameLabelLength
+		case !isHostnameLabel(label):
+			params["label"] = label
+			return nil, false, ErrorKeyHostnameLabel
+		}
+	}
+	return labels, true, key
+}
+
+func checkHostname(v string, params map[string]any) (bool, string) {
+	_, ok, key := checkHostnameLabels(v, false, ErrorKeyHostname, params)
+	return ok, key
+}
+
+// Check if the value is a fully qualified domain name: a host name with at least
+// two labels, optionally ending with the dot of the root domain. The top-level
+// domain can't be numeric, so an IPv4 address is not a domain name.
+func checkFQDN(v string, params map[string]any) (bool, string) {
+	labels, ok, key := checkHostnameLabels(v, true, ErrorKeyFQDN, params)
+	if !ok {
+		return false, key
+	}
+	return len(labels) >= 2 && !isDecimalDigits(labels[len(labels)-1]), key
+}
+
+// Check if the value is a domain name whose top-level domain has at least two
+// letters, like "com", or is an internationalized domain in Punycode, like
+// "xn--p1ai".
+func checkDomainWithTLD(v string, params map[string]any) (bool, string) {
+	labels, ok, key := checkHostnameLabels(v, false, ErrorKeyDomainWithTLD, params)
+	if !ok {
+		return false, key
+	}
+	if len(labels) < 2 {
+		return false, key
+	}
+
+	tld := labels[len(labels)-1]
+	if isPunycodeLabelPrefix(tld) {
+		if !isPunycodeLabel(tld) {
+			params["label"] = tld
+			return false, ErrorKeyHostnameLabel
+		}
+		return true, key
+	}
+	return len(tld) >= 2 && isStringAlpha(tld), key
+}
+
+func isPunycodeLabelPrefix(label string) bool {
+	return len(label) > 4 && strings.EqualFold(label[:4], "xn--")
+}
+
+// The IDNA profile for registering a domain name. It doesn't map the labels,
+// so uppercase and other characters that IDNA maps before encoding a label
+// are not valid in the decoded label.
+var punycodeIDNAProfile = idna.New(idna.ValidateForRegistration())
+
+// Check if the label with the "xn--" prefix encodes an internationalized label,
+// as defined by IDNA: the rest of the label must be Punycode that decodes to a
+// valid IDNA label with at least one non ASCII character.
+func isPunycodeLabel(label string) bool {
+	// Host names are case insensitive, so the basic characters are lowercased
+	decoded, err := punycodeIDNAProfile.ToUnicode(strings.ToLower(label))
+	if err != nil {
+		return false
+	}
+	return strings.IndexFunc(decoded, func(r rune) bool { return r >= utf8.RuneSelf }) >= 0
+}
+
+// Check if the value is a host name in the ASCII form of IDNA, where the
+// internationalized labels are encoded in Punycode with the "xn--" prefix, like
+// "xn--mnchen-3ya.de" for "münchen.de". Other labels with hyphens in the third
+// and fourth positions are reserved, so they are not valid.
+func checkPunycodeHostname(v string, params map[string]any) (bool, string) {
+	labels, ok, key := checkHostnameLabels(v, false, ErrorKeyPunycodeHostname, params)
+	if !ok {
+		return false, key
+	}
+	for _, label := range labels {
+		reserved := len(label) >= 4 && label[2:4] == "--"
+		if reserved && !(isPunycodeLabelPrefix(label) && isPunycodeLabel(label)) {
+			params["label"] = label
+			return false, ErrorKeyHostnameLabel
+		}
+	}
+	return true, key
+}
+
+// Check if the value is a TCP or UDP port number between 1 and 65535, without
+// sign or leading zeros.
+func isStringPort[T ~string](v T) bool {
+	if !isDecimalDigits(string(v)) || v[0] == '0' || len(v) > 5 {
+		return false
+	}
+	port, err := strconv.Atoi(string(v))
+	return err == nil && port <= math.MaxUint16
+}
+
+// Check if the value is a host and a port separated by a colon. The host can be
+// a host name, an IPv4 address or an IPv6 address in brackets, like
+// "[2001:db8::1]:443".
+func checkHostPort(v string, params map[string]any) (bool, string) {
+	host, port, err := net.SplitHostPort(v)
+	if err != nil || !isStringPort(port) {
+		return false, ErrorKeyHostPort
+	}
+
+	bracketed := strings.HasPrefix(v, "[")
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr.Is6() == bracketed, ErrorKeyHostPort
+	}
+	if bracketed {
+		return false, ErrorKeyHostPort
+	}
+	_, ok, key := checkHostnameLabels(host, false, ErrorKeyHostPort, params)
+	return ok, key
+}
+
+// Validate if a string is a host name, as defined by RFC 1123: labels of ASCII
+// letters, digits and hyphens separated by dots, not starting or ending with a
+// hyphen, with at most 63 characters each and 253 in total. When a label is
+// wrong, the error message says which one.
+// For example:
+//
+//	host := "db-1.internal"
+//	Is(v.String(host).Hostname())
+func (validator *ValidatorString[T]) Hostname(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkHostname(string(validator.context.Value().(T)), params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if a string is a fully qualified domain name: a host name with at
+// least two labels and a top-level domain that is not numeric, like
+// "api.example.com". The trailing dot of the root domain is allowed.
+// For example:
+//
+//	host := "api.example.com"
+//	Is(v.String(host).FQDN())
+func (validator *ValidatorString[T]) FQDN(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkFQDN(string(validator.context.Value().(T)), params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if a string is a domain name with a top-level domain of at least two
+// letters, like "example.com", or an internationalized top-level domain in
+// Punycode, like "example.xn--p1ai".
+// For example:
+//
+//	domain := "example.com"
+//	Is(v.String(domain).DomainWithTLD())
+func (validator *ValidatorString[T]) DomainWithTLD(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkDomainWithTLD(string(validator.context.Value().(T)), params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if a string is a host and a port separated by a colon, like
+// "example.com:443". The host can be a host name, an IPv4 address or an IPv6
+// address in brackets, like "[2001:db8::1]:443". The port must be between 1 and
+// 65535.
+// For example:
+//
+//	address := "db-1.internal:5432"
+//	Is(v.String(address).HostPort())
+func (validator *ValidatorString[T]) HostPort(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkHostPort(string(validator.context.Value().(T)), params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if a string is a port number between 1 and 65535, without sign or
+// leading zeros.
+// For example:
+//
+//	port := "8080"
+//	Is(v.String(port).Port())
+func (validator *ValidatorString[T]) Port(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringPort(validator.context.Value().(T))
+		},
+		ErrorKeyPort, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is a host name in the ASCII form of IDNA, where the
+// internationalized labels are encoded in Punycode with the "xn--" prefix, like
+// "xn--mnchen-3ya.de" for "münchen.de". The Punycode labels must decode to an
+// internationalized label.
+// For example:
+//
+//	host := "xn--mnchen-3ya.de"
+//	Is(v.String(host).PunycodeHostname())
+func (validator *ValidatorString[T]) PunycodeHostname(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			return checkPunycodeHostname(string(validator.context.Value().(T)), params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a host name, like in
+// [ValidatorString.Hostname].
+// For example:
+//
+//	host := "db-1.internal"
+//	Is(v.StringP(&host).Hostname())
+func (validator *ValidatorStringP[T]) Hostname(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyHostname
+			}
+			return checkHostname(string(*(validator.context.Value().(*T))), params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a fully qualified domain name,
+// like in [ValidatorString.FQDN].
+// For example:
+//
+//	host := "api.example.com"
+//	Is(v.StringP(&host).FQDN())
+func (validator *ValidatorStringP[T]) FQDN(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyFQDN
+			}
+			return checkFQDN(string(*(validator.context.Value().(*T))), params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a domain name with a top-level
+// domain, like in [ValidatorString.DomainWithTLD].
+// For example:
+//
+//	domain := "example.com"
+//	Is(v.StringP(&domain).DomainWithTLD())
+func (validator *ValidatorStringP[T]) DomainWithTLD(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyDomainWithTLD
+			}
+			return checkDomainWithTLD(string(*(validator.context.Value().(*T))), params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a host and a port separated by a
+// colon, like in [ValidatorString.HostPort].
+// For example:
+//
+//	address := "db-1.internal:5432"
+//	Is(v.StringP(&address).HostPort())
+func (validator *ValidatorStringP[T]) HostPort(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyHostPort
+			}
+			return checkHostPort(string(*(validator.context.Value().(*T))), params)
+		},
+		params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a port number between 1 and
+// 65535.
+// For example:
+//
+//	port := "8080"
+//	Is(v.StringP(&port).Port())
+func (validator *ValidatorStringP[T]) Port(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringPort(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyPort, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a host name in the ASCII form of
+// IDNA, like in [ValidatorString.PunycodeHostname].
+// For example:
+//
+//	host := "xn--mnchen-3ya.de"
+//	Is(v.StringP(&host).PunycodeHostname())
+func (validator *ValidatorStringP[T]) PunycodeHostname(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.addWithKeyFunction(
+		func() (bool, string) {
+			if validator.context.Value().(*T) == nil {
+				return false, ErrorKeyPunycodeHostname
+			}
+			return checkPunycodeHostname(string(*(validator.context.Value().(*T))), params)
+		},
+		params, template...)
+
+	return validator
+}
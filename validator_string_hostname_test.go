@@ -0,0 +1,217 @@
+package valgo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringHostname(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"localhost", "db-1.internal", "3com.com", "EXAMPLE.com", "a.b.c.d.e"} {
+		assert.True(t, Is(String(s).Hostname()).Valid(), s)
+	}
+
+	for _, s := range []string{"", "example.com.", "exa_mple.com", "ex ample.com", "münchen.de", "[::1]"} {
+		assert.False(t, Is(String(s).Hostname()).Valid(), s)
+	}
+
+	v = Is(String("db-1.internal").Hostname())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("", "host").Hostname())
+	assert.Equal(t,
+		"Host must be a valid hostname",
+		v.Errors()["host"].Messages()[0])
+
+	v = Is(String("api.-internal.example.com", "host").Hostname())
+	assert.Equal(t,
+		"Host has an invalid label \"-internal\"",
+		v.Errors()["host"].Messages()[0])
+
+	v = Is(String("api..example.com", "host").Hostname())
+	assert.Equal(t,
+		"Host can't have empty labels",
+		v.Errors()["host"].Messages()[0])
+
+	longLabel := strings.Repeat("a", 64)
+	v = Is(String("api."+longLabel+".com", "host").Hostname())
+	assert.Equal(t,
+		"Host has the label \""+longLabel+"\" longer than 63 characters",
+		v.Errors()["host"].Messages()[0])
+
+	assert.True(t, Is(String(strings.Repeat("a", 63)+".com").Hostname()).Valid())
+
+	// 4 labels of 63 characters and 3 dots are 255 characters
+	v = Is(String(strings.Repeat(strings.Repeat("a", 63)+".", 3)+strings.Repeat("a", 63), "host").Hostname())
+	assert.Equal(t,
+		"Host can't be longer than 253 characters",
+		v.Errors()["host"].Messages()[0])
+
+	v = Is(String("localhost", "host").Not().Hostname())
+	assert.Equal(t,
+		"Host can't be a valid hostname",
+		v.Errors()["host"].Messages()[0])
+}
+
+func TestValidatorStringFQDN(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"api.example.com", "api.example.com.", "example.io", "a.b2"} {
+		assert.True(t, Is(String(s).FQDN()).Valid(), s)
+	}
+
+	for _, s := range []string{"", "localhost", "localhost.", "192.168.1.1", "example..com", "."} {
+		assert.False(t, Is(String(s).FQDN()).Valid(), s)
+	}
+
+	v = Is(String("localhost", "host").FQDN())
+	assert.Equal(t,
+		"Host must be a fully qualified domain name",
+		v.Errors()["host"].Messages()[0])
+
+	v = Is(String("api.exa$mple.com", "host").FQDN())
+	assert.Equal(t,
+		"Host has an invalid label \"exa$mple\"",
+		v.Errors()["host"].Messages()[0])
+}
+
+func TestValidatorStringDomainWithTLD(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"example.com", "sub.example.co.uk", "example.xn--p1ai", "xn--mnchen-3ya.de"} {
+		assert.True(t, Is(String(s).DomainWithTLD()).Valid(), s)
+	}
+
+	for _, s := range []string{"", "localhost", "example.c", "example.c0m", "example.com.", "10.0.0.1"} {
+		assert.False(t, Is(String(s).DomainWithTLD()).Valid(), s)
+	}
+
+	v = Is(String("example.c", "domain").DomainWithTLD())
+	assert.Equal(t,
+		"Domain must be a domain name with a top-level domain",
+		v.Errors()["domain"].Messages()[0])
+
+	v = Is(String("example.xn--99", "domain").DomainWithTLD())
+	assert.Equal(t,
+		"Domain has an invalid label \"xn--99\"",
+		v.Errors()["domain"].Messages()[0])
+}
+
+func TestValidatorStringHostPortAndPort(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"example.com:443", "db-1:5432", "10.0.0.1:8080", "[2001:db8::1]:443", "[::1]:1"} {
+		assert.True(t, Is(String(s).HostPort()).Valid(), s)
+	}
+
+	for _, s := range []string{"", "example.com", "example.com:", ":443", "example.com:0", "example.com:65536",
+		"example.com:https", "2001:db8::1:443", "[10.0.0.1]:80", "[example.com]:80"} {
+		assert.False(t, Is(String(s).HostPort()).Valid(), s)
+	}
+
+	v = Is(String("example.com", "address").HostPort())
+	assert.Equal(t,
+		"Address must be a host and a port, like \"example.com:443\"",
+		v.Errors()["address"].Messages()[0])
+
+	v = Is(String("db_1.internal:5432", "address").HostPort())
+	assert.Equal(t,
+		"Address has an invalid label \"db_1\"",
+		v.Errors()["address"].Messages()[0])
+
+	for _, s := range []string{"1", "80", "8080", "65535"} {
+		assert.True(t, Is(String(s).Port()).Valid(), s)
+	}
+
+	for _, s := range []string{"", "0", "080", "65536", "-1", "+80", "8080 ", "100000"} {
+		assert.False(t, Is(String(s).Port()).Valid(), s)
+	}
+
+	v = Is(String("65536", "port").Port())
+	assert.Equal(t,
+		"Port must be a port number between 1 and 65535",
+		v.Errors()["port"].Messages()[0])
+}
+
+func TestValidatorStringPunycodeHostname(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"example.com", "xn--mnchen-3ya.de", "XN--MNCHEN-3YA.de", "xn--fiqs8s.xn--fiqs8s", "api.xn--p1ai"} {
+		assert.True(t, Is(String(s).PunycodeHostname()).Valid(), s)
+	}
+
+	for _, s := range []string{"", "münchen.de", "ab--cd.com", "xn--.com", "xn--abc-.com", "xn--example.com", "xn--99999999999.com"} {
+		assert.False(t, Is(String(s).PunycodeHostname()).Valid(), s)
+	}
+
+	v = Is(String("münchen.de", "host").PunycodeHostname())
+	assert.Equal(t,
+		"Host has an invalid label \"münchen\"",
+		v.Errors()["host"].Messages()[0])
+
+	v = Is(String("xn--example.com", "host").PunycodeHostname())
+	assert.Equal(t,
+		"Host has an invalid label \"xn--example\"",
+		v.Errors()["host"].Messages()[0])
+
+	v = Is(String("xn--mnchen-3ya.de", "host").Not().PunycodeHostname())
+	assert.Equal(t,
+		"Host can't be a valid hostname with internationalized labels in Punycode",
+		v.Errors()["host"].Messages()[0])
+
+	// Labels that decode to uppercase or ASCII-only characters
+	assert.False(t, isPunycodeLabel("xn--mnchen-psa"))
+	assert.False(t, isPunycodeLabel("xn--abc-"))
+	assert.True(t, isPunycodeLabel("xn--mnchen-3ya"))
+}
+
+func TestValidatorStringPHostnames(t *testing.T) {
+
+	var v *Validation
+
+	host := "api.example.com"
+	address := "api.example.com:443"
+	port := "443"
+	v = Is(StringP(&host).Hostname().FQDN().DomainWithTLD().PunycodeHostname()).
+		Is(StringP(&address).HostPort()).
+		Is(StringP(&port).Port())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	host = "api.-example.com"
+	v = Is(StringP(&host, "host").Hostname())
+	assert.Equal(t,
+		"Host has an invalid label \"-example\"",
+		v.Errors()["host"].Messages()[0])
+
+	var nilString *string
+	for _, validator := range []*ValidatorStringP[string]{
+		StringP(nilString).Hostname(), StringP(nilString).FQDN(),
+		StringP(nilString).DomainWithTLD(), StringP(nilString).HostPort(),
+		StringP(nilString).Port(), StringP(nilString).PunycodeHostname(),
+	} {
+		assert.False(t, Is(validator).Valid())
+	}
+
+	v = Is(StringP(nilString, "host").Hostname())
+	assert.Equal(t,
+		"Host must be a valid hostname",
+		v.Errors()["host"].Messages()[0])
+}
+
+func TestValidatorStringHostnameLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeDe}).Is(String("api.-x.com", "host", "Host").Hostname())
+	assert.Equal(t,
+		"Host hat ein ungültiges Label \"-x\"",
+		v.Errors()["host"].Messages()[0])
+}
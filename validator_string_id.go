@@ -0,0 +1,232 @@
+package valgo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// The largest KSUID, in its base62 textual form. The base62 alphabet is sorted
+// like ASCII, so KSUIDs of the same length can be compared as strings.
+const ksuidMax = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+func isHexDigit(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// Return the version of a UUID in the canonical textual form, such as
+// "f47ac10b-58cc-4372-a567-0e02b2c3d479". Returns false if the value is not a
+// UUID with the variant defined by RFC 9562 and a version between 1 and 8.
+func uuidVersionOf(v string) (int, bool) {
+	if len(v) != 36 {
+		return 0, false
+	}
+	for i := 0; i < len(v); i++ {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			if v[i] != '-' {
+				return 0, false
+			}
+		} else if !isHexDigit(v[i]) {
+			return 0, false
+		}
+	}
+
+	// The variant is in the high bits of the 17th digit, which must be 10xx
+	if !strings.ContainsRune("89abAB", rune(v[19])) {
+		return 0, false
+	}
+
+	version := int(v[14] - '0')
+	if version < 1 || version > 8 {
+		return 0, false
+	}
+	return version, true
+}
+
+func isStringUUID[T ~string](v T) bool {
+	_, ok := uuidVersionOf(string(v))
+	return ok
+}
+
+func isStringUUIDVersion[T ~string](v T, versions []int) bool {
+	version, ok := uuidVersionOf(string(v))
+	if !ok {
+		return false
+	}
+	for _, allowed := range versions {
+		if version == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// Check if the value is a ULID: 26 characters of the Crockford base32 alphabet,
+// ignoring the case. The first character can't be greater than "7", because the
+// value wouldn't fit in 128 bits.
+func isStringULID[T ~string](v T) bool {
+	if len(v) != 26 || v[0] < '0' || v[0] > '7' {
+		return false
+	}
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if !(c >= '0' && c <= '9' || c >= 'A' && c <= 'Z') || c == 'I' || c == 'L' || c == 'O' || c == 'U' {
+			return false
+		}
+	}
+	return true
+}
+
+// Check if the value is a KSUID: 27 base62 characters not greater than the
+// largest KSUID.
+func isStringKSUID[T ~string](v T) bool {
+	if len(v) != len(ksuidMax) {
+		return false
+	}
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return string(v) <= ksuidMax
+}
+
+func joinInts(values []int) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.Itoa(v)
+	}
+	return strings.Join(s, ", ")
+}
+
+// Validate if a string is a UUID in the canonical textual form, such as
+// "f47ac10b-58cc-4372-a567-0e02b2c3d479", with the variant defined by RFC 9562
+// and any version between 1 and 8. Hexadecimal digits can be lowercase or
+// uppercase. The nil UUID and the max UUID are not valid.
+// For example:
+//
+//	id := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+//	Is(v.String(id).UUID())
+func (validator *ValidatorString[T]) UUID(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringUUID(validator.context.Value().(T))
+		},
+		ErrorKeyUUID, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is a UUID, like in `UUID`, with one of the versions.
+// For example:
+//
+//	id := "0190a5e4-2c5d-7b1e-8f3a-6d2b9c4e1f70"
+//	Is(v.String(id).UUIDVersion([]int{4, 7}))
+func (validator *ValidatorString[T]) UUIDVersion(versions []int, template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringUUIDVersion(validator.context.Value().(T), versions)
+		},
+		ErrorKeyUUIDVersion,
+		map[string]any{"title": validator.context.title, "versions": joinInts(versions), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is a ULID, such as "01ARZ3NDEKTSV4RRFFQ69G5FAV". The
+// letters can be lowercase or uppercase.
+// For example:
+//
+//	id := "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+//	Is(v.String(id).ULID())
+func (validator *ValidatorString[T]) ULID(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringULID(validator.context.Value().(T))
+		},
+		ErrorKeyULID, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is a KSUID, such as "0ujtsYcgvSTl8PAuAdqWYSMnLOv".
+// For example:
+//
+//	id := "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+//	Is(v.String(id).KSUID())
+func (validator *ValidatorString[T]) KSUID(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringKSUID(validator.context.Value().(T))
+		},
+		ErrorKeyKSUID, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a UUID in the canonical textual
+// form, like in [ValidatorString.UUID].
+// For example:
+//
+//	id := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+//	Is(v.StringP(&id).UUID())
+func (validator *ValidatorStringP[T]) UUID(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringUUID(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyUUID, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a UUID with one of the versions.
+// For example:
+//
+//	id := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+//	Is(v.StringP(&id).UUIDVersion([]int{4}))
+func (validator *ValidatorStringP[T]) UUIDVersion(versions []int, template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringUUIDVersion(*(validator.context.Value().(*T)), versions)
+		},
+		ErrorKeyUUIDVersion,
+		map[string]any{"title": validator.context.title, "versions": joinInts(versions), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a ULID.
+// For example:
+//
+//	id := "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+//	Is(v.StringP(&id).ULID())
+func (validator *ValidatorStringP[T]) ULID(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringULID(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyULID, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a KSUID.
+// For example:
+//
+//	id := "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+//	Is(v.StringP(&id).KSUID())
+func (validator *ValidatorStringP[T]) KSUID(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringKSUID(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyKSUID, validator.context.Value(), template...)
+
+	return validator
+}
@@ -0,0 +1,142 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringUUID(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("f47ac10b-58cc-4372-a567-0e02b2c3d479").UUID())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, s := range []string{
+		"F47AC10B-58CC-4372-A567-0E02B2C3D479",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"0190a5e4-2c5d-7b1e-8f3a-6d2b9c4e1f70",
+	} {
+		assert.True(t, Is(String(s).UUID()).Valid(), s)
+	}
+
+	for _, s := range []string{
+		"",
+		"f47ac10b58cc4372a5670e02b2c3d479",
+		"{f47ac10b-58cc-4372-a567-0e02b2c3d479}",
+		"f47ac10b-58cc-4372-a567-0e02b2c3d47",
+		"f47ac10b-58cc-4372-a567_0e02b2c3d479",
+		"g47ac10b-58cc-4372-a567-0e02b2c3d479",
+		"f47ac10b-58cc-4372-c567-0e02b2c3d479",
+		"f47ac10b-58cc-9372-a567-0e02b2c3d479",
+		"00000000-0000-0000-0000-000000000000",
+		"ffffffff-ffff-ffff-ffff-ffffffffffff",
+	} {
+		assert.False(t, Is(String(s).UUID()).Valid(), s)
+	}
+
+	v = Is(String("123", "id", "ID").UUID())
+	assert.Equal(t,
+		"ID must be a valid UUID",
+		v.Errors()["id"].Messages()[0])
+}
+
+func TestValidatorStringUUIDVersion(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("0190a5e4-2c5d-7b1e-8f3a-6d2b9c4e1f70").UUIDVersion([]int{4, 7}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("6ba7b810-9dad-11d1-80b4-00c04fd430c8").UUIDVersion([]int{4, 7}))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be a UUID of version \"4, 7\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(String("not-a-uuid").Not().UUIDVersion([]int{4}))
+	assert.True(t, v.Valid())
+}
+
+func TestValidatorStringULID(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("01ARZ3NDEKTSV4RRFFQ69G5FAV").ULID())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("01arz3ndektsv4rrffq69g5fav").ULID())
+	assert.True(t, v.Valid())
+
+	for _, s := range []string{
+		"",
+		"01ARZ3NDEKTSV4RRFFQ69G5FA",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAVX",
+		"81ARZ3NDEKTSV4RRFFQ69G5FAV",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAU",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAI",
+		"01ARZ3NDEKTSV4RRFFQ69G5-AV",
+	} {
+		assert.False(t, Is(String(s).ULID()).Valid(), s)
+	}
+
+	v = Is(String("123", "id", "ID").ULID())
+	assert.Equal(t,
+		"ID must be a valid ULID",
+		v.Errors()["id"].Messages()[0])
+}
+
+func TestValidatorStringKSUID(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("0ujtsYcgvSTl8PAuAdqWYSMnLOv").KSUID())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("aWgEPTl1tmebfsQzFP4bxwgy80V").KSUID())
+	assert.True(t, v.Valid())
+
+	for _, s := range []string{"", "0ujtsYcgvSTl8PAuAdqWYSMnLO", "aWgEPTl1tmebfsQzFP4bxwgy80W", "zzzzzzzzzzzzzzzzzzzzzzzzzzz", "0ujtsYcgvSTl8PAuAdqWYSMnLO-"} {
+		assert.False(t, Is(String(s).KSUID()).Valid(), s)
+	}
+
+	v = Is(String("123", "id", "ID").KSUID())
+	assert.Equal(t,
+		"ID must be a valid KSUID",
+		v.Errors()["id"].Messages()[0])
+}
+
+func TestValidatorStringPIdentifiers(t *testing.T) {
+
+	var v *Validation
+
+	uuid := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	v = Is(StringP(&uuid).UUID().UUIDVersion([]int{4}))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	ulid := "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	assert.True(t, Is(StringP(&ulid).ULID()).Valid())
+
+	ksuid := "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+	assert.True(t, Is(StringP(&ksuid).KSUID()).Valid())
+
+	var nilID *string
+	assert.False(t, Is(StringP(nilID).UUID()).Valid())
+	assert.False(t, Is(StringP(nilID).UUIDVersion([]int{4})).Valid())
+	assert.False(t, Is(StringP(nilID).ULID()).Valid())
+	assert.False(t, Is(StringP(nilID).KSUID()).Valid())
+}
+
+func TestValidatorStringIdentifierLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeDe}).Is(String("abc", "id", "ID").UUIDVersion([]int{4}))
+	assert.Equal(t,
+		"ID muss eine UUID der Version \"4\" sein",
+		v.Errors()["id"].Messages()[0])
+}
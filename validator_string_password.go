@@ -0,0 +1,187 @@
+package valgo
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Requirements of the `Password` rule of [ValidatorString] and
+// [ValidatorStringP]. The zero value of each field disables its requirement.
+type PasswordPolicy struct {
+	// Minimum number of characters.
+	MinLength int
+	// Require at least one lowercase letter.
+	RequireLowercase bool
+	// Require at least one uppercase letter.
+	RequireUppercase bool
+	// Require at least one digit.
+	RequireDigit bool
+	// Require at least one symbol or punctuation character, such as "!" or "_".
+	RequireSymbol bool
+	// Maximum number of times the same character can be repeated in a row.
+	MaxRepeated int
+	// Minimum estimated entropy, in bits. The entropy is estimated from the
+	// length of the password and the size of the character classes it uses, so
+	// it's an upper bound of the real entropy.
+	MinEntropy float64
+	// Reject common passwords. The passwords are compared ignoring the case.
+	RejectCommon bool
+	// List of passwords rejected when RejectCommon is true. If nil, a small
+	// embedded list of the most common passwords is used.
+	Blocklist []string
+}
+
+// Estimate the entropy of a password, in bits, as the number of characters
+// multiplied by the bits needed to pick a character from the classes used.
+func estimatePasswordEntropy(v string) float64 {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range v {
+		switch {
+		case r < utf8.RuneSelf && unicode.IsLower(r):
+			lower = true
+		case r < utf8.RuneSelf && unicode.IsUpper(r):
+			upper = true
+		case r < utf8.RuneSelf && unicode.IsDigit(r):
+			digit = true
+		case r < utf8.RuneSelf:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	pool := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if class.used {
+			pool += class.size
+		}
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(utf8.RuneCountInString(v)) * math.Log2(float64(pool))
+}
+
+// Return the length of the longest run of the same character.
+func longestRepeatedRun(v string) int {
+	longest, current := 0, 0
+	var previous rune = -1
+	for _, r := range v {
+		if r == previous {
+			current++
+		} else {
+			current = 1
+			previous = r
+		}
+		longest = max(longest, current)
+	}
+	return longest
+}
+
+func isPasswordCommon(v string, blocklist []string) bool {
+	if blocklist == nil {
+		blocklist = commonPasswords
+	}
+	for _, password := range blocklist {
+		if strings.EqualFold(v, password) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check the password against the policy and return the error templates of the
+// unmet requirements.
+func unmetPasswordRequirements(v string, policy PasswordPolicy) []*errorTemplate {
+	unmet := []*errorTemplate{}
+	requirement := func(key string, params map[string]any) {
+		if params == nil {
+			params = map[string]any{}
+		}
+		unmet = append(unmet, &errorTemplate{key: key, params: params})
+	}
+
+	if utf8.RuneCountInString(v) < policy.MinLength {
+		requirement(ErrorKeyPasswordMinLength, map[string]any{"min": policy.MinLength})
+	}
+	if policy.RequireLowercase && strings.IndexFunc(v, unicode.IsLower) < 0 {
+		requirement(ErrorKeyPasswordLowercase, nil)
+	}
+	if policy.RequireUppercase && strings.IndexFunc(v, unicode.IsUpper) < 0 {
+		requirement(ErrorKeyPasswordUppercase, nil)
+	}
+	if policy.RequireDigit && strings.IndexFunc(v, unicode.IsDigit) < 0 {
+		requirement(ErrorKeyPasswordDigit, nil)
+	}
+	if policy.RequireSymbol && strings.IndexFunc(v, func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) }) < 0 {
+		requirement(ErrorKeyPasswordSymbol, nil)
+	}
+	if policy.MaxRepeated > 0 && longestRepeatedRun(v) > policy.MaxRepeated {
+		requirement(ErrorKeyPasswordMaxRepeated, map[string]any{"max": policy.MaxRepeated})
+	}
+	if policy.MinEntropy > 0 && estimatePasswordEntropy(v) < policy.MinEntropy {
+		requirement(ErrorKeyPasswordEntropy, map[string]any{"bits": policy.MinEntropy})
+	}
+	if policy.RejectCommon && isPasswordCommon(v, policy.Blocklist) {
+		requirement(ErrorKeyPasswordCommon, nil)
+	}
+
+	return unmet
+}
+
+// Check the password against the policy and set the unmet requirements in the
+// "requirements" template param.
+func isPasswordValid(v string, policy PasswordPolicy, params map[string]any) bool {
+	unmet := unmetPasswordRequirements(v, policy)
+	params["requirements"] = &localizedList{items: unmet}
+	return len(unmet) == 0
+}
+
+// Validate if a string is a password that meets the requirements of the policy.
+// Unlike chaining a rule for each requirement, a single error message lists
+// all the unmet requirements, for example "Password must have at least 12
+// characters, contain a digit, not be a common password". A custom template
+// can use the list with the "requirements" param.
+// For example:
+//
+//	policy := v.PasswordPolicy{MinLength: 12, RequireDigit: true, RejectCommon: true}
+//	Is(v.String(password).Password(policy))
+func (validator *ValidatorString[T]) Password(policy PasswordPolicy, template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			return isPasswordValid(string(validator.context.Value().(T)), policy, params)
+		},
+		ErrorKeyPassword, params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a password that meets the
+// requirements of the policy, like in [ValidatorString.Password]. A nil pointer
+// is not valid, and its message lists the requirements unmet by an empty
+// password.
+// For example:
+//
+//	policy := v.PasswordPolicy{MinLength: 12, RequireDigit: true, RejectCommon: true}
+//	Is(v.StringP(&password).Password(policy))
+func (validator *ValidatorStringP[T]) Password(policy PasswordPolicy, template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			var password string
+			if validator.context.Value().(*T) != nil {
+				password = string(*(validator.context.Value().(*T)))
+			}
+			valid := isPasswordValid(password, policy, params)
+			return validator.context.Value().(*T) != nil && valid
+		},
+		ErrorKeyPassword, params, template...)
+
+	return validator
+}
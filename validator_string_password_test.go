@@ -0,0 +1,116 @@
+package valgo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringPassword(t *testing.T) {
+
+	var v *Validation
+
+	policy := PasswordPolicy{
+		MinLength:        12,
+		RequireLowercase: true,
+		RequireUppercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+		MaxRepeated:      2,
+		MinEntropy:       60,
+		RejectCommon:     true,
+	}
+
+	v = Is(String("Tr0ub4dor&3-horse").Password(policy))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("passw0rd", "password").Password(policy))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Password must have at least 12 characters, contain an uppercase letter, contain a symbol, be less predictable, not be a common password",
+		v.Errors()["password"].Messages()[0])
+	assert.Len(t, v.Errors()["password"].Messages(), 1)
+
+	v = Is(String("Abcdefgh1!!!", "password").Password(policy))
+	assert.Equal(t,
+		"Password must not repeat a character more than 2 times in a row",
+		v.Errors()["password"].Messages()[0])
+
+	v = Is(String("ÁRVÍZTŰRŐ tükörfúrógép 1!", "password").Password(policy))
+	assert.True(t, v.Valid())
+
+	v = Is(String("Tr0ub4dor&3-horse", "password").Not().Password(policy))
+	assert.Equal(t,
+		"Password can't meet the password requirements",
+		v.Errors()["password"].Messages()[0])
+}
+
+func TestValidatorStringPasswordBlocklist(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("QWERTY").Password(PasswordPolicy{RejectCommon: true}))
+	assert.False(t, v.Valid())
+
+	v = Is(String("qwerty").Password(PasswordPolicy{}))
+	assert.True(t, v.Valid())
+
+	policy := PasswordPolicy{RejectCommon: true, Blocklist: []string{"acme2024"}}
+
+	v = Is(String("qwerty").Password(policy))
+	assert.True(t, v.Valid())
+
+	v = Is(String("Acme2024", "password").Password(policy))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Password must not be a common password",
+		v.Errors()["password"].Messages()[0])
+}
+
+func TestValidatorStringPasswordTemplate(t *testing.T) {
+
+	v := Is(String("short", "password").Password(PasswordPolicy{MinLength: 8, RequireDigit: true}, "Choose another {{title}}: {{requirements}}"))
+	assert.Equal(t,
+		"Choose another Password: have at least 8 characters, contain a digit",
+		v.Errors()["password"].Messages()[0])
+}
+
+func TestValidatorStringPasswordEntropy(t *testing.T) {
+
+	assert.Equal(t, float64(0), estimatePasswordEntropy(""))
+	assert.InDelta(t, 8*math.Log2(26), estimatePasswordEntropy("abcdefgh"), 0.001)
+	assert.InDelta(t, 8*math.Log2(52), estimatePasswordEntropy("abcdEFGH"), 0.001)
+
+	assert.Equal(t, 0, longestRepeatedRun(""))
+	assert.Equal(t, 3, longestRepeatedRun("abbbcc"))
+	assert.Equal(t, 2, longestRepeatedRun("xééy"))
+}
+
+func TestValidatorStringPPassword(t *testing.T) {
+
+	var v *Validation
+
+	policy := PasswordPolicy{MinLength: 8, RequireDigit: true}
+
+	password := "secret-password-1"
+	v = Is(StringP(&password).Password(policy))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	var nilPassword *string
+	v = Is(StringP(nilPassword, "password").Password(policy))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Password must have at least 8 characters, contain a digit",
+		v.Errors()["password"].Messages()[0])
+}
+
+func TestValidatorStringPasswordLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeDe}).Is(String("abc", "password", "Passwort").Password(PasswordPolicy{MinLength: 8, RequireUppercase: true}))
+	assert.Equal(t,
+		"Passwort muss mindestens 8 Zeichen haben, einen Großbuchstaben enthalten",
+		v.Errors()["password"].Messages()[0])
+}
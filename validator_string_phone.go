@@ -0,0 +1,172 @@
+package valgo
+
+import (
+	"strings"
+)
+
+// Replace the characters used to group the digits of a phone number written
+// for humans, such as "+49 (30) 1234-5678".
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "/", "")
+
+// Check if the value is a phone number in the E.164 format: a "+" followed by
+// the country calling code and the subscriber number, without separators, and
+// with at most 15 digits. Numbers shorter than 7 digits are not valid, since
+// no country has them.
+func isStringE164[T ~string](v T) bool {
+	return len(v) >= 8 && len(v) <= 16 && v[0] == '+' && v[1] != '0' && isDecimalDigits(string(v[1:]))
+}
+
+// Return the national number of the value, without separators, when the value
+// is in the international format with a "+" and the calling code of the
+// region, or in the national format with the trunk prefix of the region, such
+// as "030 1234567" in Germany. The digits can be grouped with spaces, hyphens,
+// dots, slashes, and parentheses, and the international format can keep the
+// trunk prefix in parentheses, such as "+49 (0)30 1234567".
+func cutPhoneNationalNumber(v string, plan phoneNumberingPlan) (string, bool) {
+	if international, ok := strings.CutPrefix(strings.TrimSpace(v), "+"); ok {
+		before, after, found := strings.Cut(international, "("+plan.trunkPrefix+")")
+		if found && plan.trunkPrefix != "" && phoneSeparators.Replace(before) == plan.callingCode {
+			international = before + after
+		}
+		return strings.CutPrefix(phoneSeparators.Replace(international), plan.callingCode)
+	}
+	return strings.CutPrefix(phoneSeparators.Replace(v), plan.trunkPrefix)
+}
+
+// Parse the value as a phone number of the region, as read by
+// cutPhoneNationalNumber, and check it against the numbering plan of the
+// region. Returns the number in the E.164 format.
+func parsePhoneForRegion(v string, region string) (string, bool) {
+	plan, ok := phoneNumberingPlans[strings.ToUpper(region)]
+	if !ok {
+		return "", false
+	}
+
+	national, ok := cutPhoneNationalNumber(v, plan)
+	if !ok {
+		return "", false
+	}
+
+	if len(national) < plan.minLength || len(national) > plan.maxLength ||
+		!isDecimalDigits(national) || !strings.Contains(plan.leadingDigits, national[:1]) {
+		return "", false
+	}
+
+	return "+" + plan.callingCode + national, true
+}
+
+func isStringPhoneForRegion[T ~string](v T, region string) bool {
+	_, ok := parsePhoneForRegion(string(v), region)
+	return ok
+}
+
+// Return the template params of the `PhoneForRegion` rules, with the calling
+// code and trunk prefix of the region to help users write the number, and the
+// phone in the international format without separators as the rule reads it.
+// The normalized phone is empty when the phone doesn't start with the calling
+// code or the trunk prefix of the region.
+func phoneForRegionParams(title *string, region string, phone string, value any) map[string]any {
+	plan, ok := phoneNumberingPlans[strings.ToUpper(region)]
+	callingCode := ""
+	normalized := ""
+	if ok {
+		callingCode = "+" + plan.callingCode
+		if national, ok := cutPhoneNationalNumber(phone, plan); ok {
+			normalized = callingCode + national
+		}
+	}
+
+	return map[string]any{
+		"title":       title,
+		"region":      strings.ToUpper(region),
+		"callingCode": callingCode,
+		"trunkPrefix": plan.trunkPrefix,
+		"normalized":  normalized,
+		"value":       value,
+	}
+}
+
+// Validate if a string is a phone number in the strict E.164 format, such as
+// "+14155552671": a "+" followed by up to 15 digits, without spaces or other
+// separators.
+// For example:
+//
+//	phone := "+14155552671"
+//	Is(v.String(phone).Phone())
+func (validator *ValidatorString[T]) Phone(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringE164(validator.context.Value().(T))
+		},
+		ErrorKeyPhone, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is a phone number of the region, which is an ISO 3166
+// alpha-2 code such as "DE". The number can be in the international format,
+// such as "+49 30 1234567", or in the national format with the trunk prefix,
+// such as "030 1234567". The international format can keep the trunk prefix in
+// parentheses, such as "+49 (0)30 1234567". The length and the leading digits
+// are checked against the numbering plan of the region. A region without an
+// embedded numbering plan never satisfies this rule.
+//
+// Besides the title and the region, the error message can use the params
+// "callingCode" and "trunkPrefix" of the region, such as "+49" and "0", and
+// "normalized", the phone in the international format without separators, such
+// as "+49301234567". The normalized phone is empty when the phone doesn't start
+// with the calling code or the trunk prefix.
+// For example:
+//
+//	phone := "030 1234567"
+//	Is(v.String(phone).PhoneForRegion("DE"))
+func (validator *ValidatorString[T]) PhoneForRegion(region string, template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringPhoneForRegion(validator.context.Value().(T), region)
+		},
+		ErrorKeyPhoneForRegion,
+		phoneForRegionParams(validator.context.title, region, string(validator.context.Value().(T)), validator.context.Value()),
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a phone number in the strict
+// E.164 format, like in [ValidatorString.Phone].
+// For example:
+//
+//	phone := "+14155552671"
+//	Is(v.StringP(&phone).Phone())
+func (validator *ValidatorStringP[T]) Phone(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringE164(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyPhone, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a phone number of the region,
+// like in [ValidatorString.PhoneForRegion].
+// For example:
+//
+//	phone := "+49 30 1234567"
+//	Is(v.StringP(&phone).PhoneForRegion("DE"))
+func (validator *ValidatorStringP[T]) PhoneForRegion(region string, template ...string) *ValidatorStringP[T] {
+	phone := ""
+	if validator.context.Value().(*T) != nil {
+		phone = string(*(validator.context.Value().(*T)))
+	}
+
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringPhoneForRegion(*(validator.context.Value().(*T)), region)
+		},
+		ErrorKeyPhoneForRegion,
+		phoneForRegionParams(validator.context.title, region, phone, validator.context.Value()),
+		template...)
+
+	return validator
+}
@@ -0,0 +1,115 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringPhone(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("+14155552671").Phone())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.True(t, Is(String("+493012345678").Phone()).Valid())
+	assert.True(t, Is(String("+6834002").Phone()).Valid())
+
+	for _, s := range []string{"", "+", "14155552671", "+1 415 555 2671", "+1-415-555-2671", "+04155552671", "+123456", "+1234567890123456", "+1415555267a"} {
+		assert.False(t, Is(String(s).Phone()).Valid(), s)
+	}
+
+	v = Is(String("415 555 2671", "phone").Phone())
+	assert.Equal(t,
+		"Phone must be a valid phone number in international format",
+		v.Errors()["phone"].Messages()[0])
+}
+
+func TestValidatorStringPhoneForRegion(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("030 1234567").PhoneForRegion("DE"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, s := range []string{"+49 30 1234567", "+49 (30) 123-4567", "+49 (0)30 1234567", "+49(0)30-1234567", "030/1234567", "01512 3456789", "+4915123456789"} {
+		assert.True(t, Is(String(s).PhoneForRegion("de")).Valid(), s)
+	}
+
+	for _, s := range []string{"", "301234567", "+43 30 1234567", "+43 (0)30 1234567", "+49 (00)30 1234567", "0 1234", "00 301234567", "030 1234567 890123", "030 123456a"} {
+		assert.False(t, Is(String(s).PhoneForRegion("DE")).Valid(), s)
+	}
+
+	assert.True(t, Is(String("(415) 555-2671").PhoneForRegion("US")).Valid())
+	assert.True(t, Is(String("+1 415 555 2671").PhoneForRegion("US")).Valid())
+	assert.False(t, Is(String("(015) 555-2671").PhoneForRegion("US")).Valid())
+	assert.False(t, Is(String("415 555 267").PhoneForRegion("US")).Valid())
+
+	assert.True(t, Is(String("612 34 56 78").PhoneForRegion("ES")).Valid())
+	assert.False(t, Is(String("512 34 56 78").PhoneForRegion("ES")).Valid())
+
+	assert.True(t, Is(String("06 1 234 5678").PhoneForRegion("HU")).Valid())
+
+	assert.False(t, Is(String("+14155552671").PhoneForRegion("XX")).Valid())
+
+	v = Is(String("12345", "phone").PhoneForRegion("de"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Phone must be a valid phone number for DE",
+		v.Errors()["phone"].Messages()[0])
+
+	v = Is(String("12345", "phone").PhoneForRegion("DE", "{{title}} must start with {{callingCode}} or {{trunkPrefix}}"))
+	assert.Equal(t,
+		"Phone must start with +49 or 0",
+		v.Errors()["phone"].Messages()[0])
+
+	// The normalized param is the phone as the rule reads it
+	v = Is(String("+49 (0)30 12", "phone").PhoneForRegion("DE", "{{title}} {{normalized}} is too short"))
+	assert.Equal(t,
+		"Phone +493012 is too short",
+		v.Errors()["phone"].Messages()[0])
+
+	v = Is(String("030 1234567", "phone").Not().PhoneForRegion("DE", "{{title}} {{normalized}} is taken"))
+	assert.Equal(t,
+		"Phone +49301234567 is taken",
+		v.Errors()["phone"].Messages()[0])
+
+	v = Is(String("301234567", "phone").PhoneForRegion("DE", "{{title}} [{{normalized}}]"))
+	assert.Equal(t,
+		"Phone []",
+		v.Errors()["phone"].Messages()[0])
+}
+
+func TestValidatorStringPPhone(t *testing.T) {
+
+	var v *Validation
+
+	phone := "+49 30 1234567"
+	v = Is(StringP(&phone).PhoneForRegion("DE"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(&phone).Phone())
+	assert.False(t, v.Valid())
+
+	phone = "0151 2"
+	v = Is(StringP(&phone, "phone").PhoneForRegion("DE", "{{title}} {{normalized}}"))
+	assert.Equal(t,
+		"Phone +491512",
+		v.Errors()["phone"].Messages()[0])
+
+	var nilPhone *string
+	assert.False(t, Is(StringP(nilPhone).Phone()).Valid())
+	assert.False(t, Is(StringP(nilPhone).PhoneForRegion("DE")).Valid())
+}
+
+func TestValidatorStringPhoneLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeDe}).Is(String("123", "phone", "Telefon").PhoneForRegion("AT"))
+	assert.Equal(t,
+		"Telefon muss eine gültige Telefonnummer für AT sein",
+		v.Errors()["phone"].Messages()[0])
+}
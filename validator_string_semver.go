@@ -0,0 +1,514 @@
+package valgo
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// A version parsed with the rules of Semantic Versioning 2.0.0. The numbers
+// are kept as strings of digits, so versions with numbers of any size can be
+// compared.
+type semVersion struct {
+	major      string
+	minor      string
+	patch      string
+	preRelease []string
+}
+
+func isSemVerNumber(v string) bool {
+	return isDecimalDigits(v) && (len(v) == 1 || v[0] != '0')
+}
+
+// Check if the value is a non-empty identifier of letters, digits, and hyphens.
+func isSemVerIdentifier(v string) bool {
+	if v == "" {
+		return false
+	}
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse a version such as "1.2.3", "1.2.3-rc.1", or "1.2.3+build.5", without
+// a "v" prefix. Returns false if the version doesn't follow SemVer 2.0.0.
+func parseSemVer(v string) (semVersion, bool) {
+	version := semVersion{}
+
+	v, build, hasBuild := strings.Cut(v, "+")
+	if hasBuild {
+		for _, identifier := range strings.Split(build, ".") {
+			if !isSemVerIdentifier(identifier) {
+				return version, false
+			}
+		}
+	}
+
+	v, preRelease, hasPreRelease := strings.Cut(v, "-")
+	if hasPreRelease {
+		version.preRelease = strings.Split(preRelease, ".")
+		for _, identifier := range version.preRelease {
+			if !isSemVerIdentifier(identifier) || (isDecimalDigits(identifier) && !isSemVerNumber(identifier)) {
+				return version, false
+			}
+		}
+	}
+
+	numbers := strings.Split(v, ".")
+	if len(numbers) != 3 {
+		return version, false
+	}
+	for _, number := range numbers {
+		if !isSemVerNumber(number) {
+			return version, false
+		}
+	}
+	version.major, version.minor, version.patch = numbers[0], numbers[1], numbers[2]
+
+	return version, true
+}
+
+// Compare two numbers without leading zeros written as strings of digits.
+func compareDigits(a, b string) int {
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// Compare two versions by their precedence, as defined by SemVer 2.0.0. The
+// build metadata is ignored, and a pre-release version has a lower precedence
+// than the normal version.
+func (v semVersion) compare(other semVersion) int {
+	if c := compareDigits(v.major, other.major); c != 0 {
+		return c
+	}
+	if c := compareDigits(v.minor, other.minor); c != 0 {
+		return c
+	}
+	if c := compareDigits(v.patch, other.patch); c != 0 {
+		return c
+	}
+
+	switch {
+	case len(v.preRelease) == 0 && len(other.preRelease) == 0:
+		return 0
+	case len(v.preRelease) == 0:
+		return 1
+	case len(other.preRelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.preRelease) && i < len(other.preRelease); i++ {
+		a, b := v.preRelease[i], other.preRelease[i]
+		aNumeric, bNumeric := isDecimalDigits(a), isDecimalDigits(b)
+		var c int
+		switch {
+		case aNumeric && bNumeric:
+			c = compareDigits(a, b)
+		case aNumeric:
+			c = -1
+		case bNumeric:
+			c = 1
+		default:
+			c = strings.Compare(a, b)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	// A larger set of pre-release identifiers has a higher precedence
+	return cmp.Compare(len(v.preRelease), len(other.preRelease))
+}
+
+// The operators of the comparisons of a version constraint. The operators
+// with two characters go first, so they are found before their prefixes.
+var semVerOperators = []string{">=", "<=", "!=", ">", "<", "=", "~", "^"}
+
+// A comparison of a version constraint, such as ">=1.2.0" or "^1.2". A partial
+// version, such as "1.2" or "1.2.x", is completed with zeros in lower, and the
+// comparison uses upper, the lowest version after the versions it matches.
+type semVerComparison struct {
+	operator string
+	lower    semVersion
+	upper    semVersion
+	partial  bool
+}
+
+// Return the number written as a string of digits plus one.
+func incrementDigits(v string) string {
+	digits := []byte(v)
+	for i := len(digits) - 1; i >= 0; i-- {
+		if digits[i] < '9' {
+			digits[i]++
+			return string(digits)
+		}
+		digits[i] = '0'
+	}
+	return "1" + string(digits)
+}
+
+// Return the lowest version, including pre-releases, after the versions that
+// share the first count numbers of v. For example, the bound of "1.2.3" with a
+// count of 2 is "1.3.0-0".
+func semVerUpperBound(v semVersion, count int) semVersion {
+	upper := semVersion{major: v.major, minor: v.minor, patch: v.patch, preRelease: []string{"0"}}
+	switch count {
+	case 1:
+		upper.major, upper.minor, upper.patch = incrementDigits(v.major), "0", "0"
+	case 2:
+		upper.minor, upper.patch = incrementDigits(v.minor), "0"
+	default:
+		upper.patch = incrementDigits(v.patch)
+	}
+	return upper
+}
+
+// Parse a version of a constraint, which can be partial, such as "1.2", "1.x",
+// or "1.2.X". Returns the version completed with zeros and the count of
+// numbers that were given.
+func parseSemVerPartial(v string) (semVersion, int, bool) {
+	if version, ok := parseSemVer(v); ok {
+		return version, 3, true
+	}
+
+	numbers := strings.Split(v, ".")
+	if len(numbers) > 3 {
+		return semVersion{}, 0, false
+	}
+	count := 0
+	for i, number := range numbers {
+		switch {
+		case number == "x" || number == "X" || number == "*":
+			if i == 0 {
+				return semVersion{}, 0, false
+			}
+		case count == i && isSemVerNumber(number):
+			count++
+		default:
+			return semVersion{}, 0, false
+		}
+	}
+	numbers = append(numbers[:count], "0", "0")
+	return semVersion{major: numbers[0], minor: numbers[1], patch: numbers[2]}, count, true
+}
+
+// Parse a single comparison of a constraint, such as ">=1.2.0", "~1.2", or
+// "1.2.0".
+func parseSemVerComparison(v string) (semVerComparison, bool) {
+	comparison := semVerComparison{}
+	for _, operator := range semVerOperators {
+		if strings.HasPrefix(v, operator) {
+			comparison.operator = operator
+			break
+		}
+	}
+
+	version, count, ok := parseSemVerPartial(v[len(comparison.operator):])
+	if !ok {
+		return comparison, false
+	}
+	comparison.lower, comparison.partial = version, count < 3
+
+	switch comparison.operator {
+	case "~":
+		// Allow patch updates: ~1.2.3 is >=1.2.3 and <1.3.0, and ~1 is <2.0.0
+		comparison.upper = semVerUpperBound(version, min(count, 2))
+	case "^":
+		// Allow updates that don't change the leftmost non-zero number:
+		// ^1.2.3 is <2.0.0, ^0.2.3 is <0.3.0, and ^0.0 is <0.1.0
+		bound := count
+		for i, number := range []string{version.major, version.minor, version.patch}[:count] {
+			if number != "0" {
+				bound = i + 1
+				break
+			}
+		}
+		comparison.upper = semVerUpperBound(version, bound)
+	default:
+		comparison.upper = semVerUpperBound(version, count)
+	}
+	return comparison, true
+}
+
+// Check if the version satisfies the comparison.
+func (comparison semVerComparison) matches(v semVersion) bool {
+	lower := v.compare(comparison.lower)
+	// A partial version matches a range of versions, such as "1.2", which
+	// matches from "1.2.0" up to "1.3.0-0"
+	equal := lower == 0
+	if comparison.partial {
+		equal = lower >= 0 && v.compare(comparison.upper) < 0
+	}
+
+	switch comparison.operator {
+	case "", "=":
+		return equal
+	case "!=":
+		return !equal
+	case ">":
+		return lower > 0 && !equal
+	case ">=":
+		return lower >= 0
+	case "<":
+		return lower < 0
+	case "<=":
+		return lower < 0 || equal
+	default:
+		// The "~" and "^" operators
+		return lower >= 0 && v.compare(comparison.upper) < 0
+	}
+}
+
+// Parse a constraint made of comparisons separated by spaces that must all be
+// satisfied, and groups of these comparisons separated by "||" to satisfy any
+// of them. For example: ">=1.2.0 <2.0.0 || ^3". An operator can be separated
+// from its version by spaces, as in ">= 1.2.0". Returns false if the constraint
+// is malformed.
+func parseSemVerConstraint(constraint string) ([][]semVerComparison, bool) {
+	groups := [][]semVerComparison{}
+	for _, group := range strings.Split(constraint, "||") {
+		fields := strings.Fields(group)
+		if len(fields) == 0 {
+			return nil, false
+		}
+
+		comparisons := []semVerComparison{}
+		for i := 0; i < len(fields); i++ {
+			field := fields[i]
+			if slices.Contains(semVerOperators, field) && i+1 < len(fields) {
+				i++
+				field += fields[i]
+			}
+			comparison, ok := parseSemVerComparison(field)
+			if !ok {
+				return nil, false
+			}
+			comparisons = append(comparisons, comparison)
+		}
+		groups = append(groups, comparisons)
+	}
+	return groups, true
+}
+
+// Check if the version satisfies any group of comparisons of a constraint.
+func isSemVerSatisfying(v semVersion, constraint [][]semVerComparison) bool {
+	for _, group := range constraint {
+		satisfied := true
+		for _, comparison := range group {
+			satisfied = satisfied && comparison.matches(v)
+		}
+		if satisfied {
+			return true
+		}
+	}
+	return false
+}
+
+// Add the rule of a constraint that can't be parsed. The rule always fails
+// with its own message, so the mistake in the constraint is reported instead
+// of rejecting every version silently. A pending Not() is discarded, since it
+// would accept every version.
+func addSemVerMalformedConstraint(ctx *ValidatorContext, constraint string, template []string) {
+	ctx.boolOperation = true
+	ctx.AddWithParams(
+		func() bool {
+			return false
+		},
+		ErrorKeyMalformedConstraint,
+		map[string]any{"title": ctx.title, "constraint": constraint, "value": ctx.Value()},
+		template...)
+}
+
+func isStringSemVer[T ~string](v T) bool {
+	_, ok := parseSemVer(string(v))
+	return ok
+}
+
+func isStringSatisfyingConstraint[T ~string](v T, constraint [][]semVerComparison) bool {
+	version, ok := parseSemVer(string(v))
+	return ok && isSemVerSatisfying(version, constraint)
+}
+
+func isStringSemVerGreaterThan[T ~string](v T, other string) bool {
+	version, ok0 := parseSemVer(string(v))
+	otherVersion, ok1 := parseSemVer(other)
+	return ok0 && ok1 && version.compare(otherVersion) > 0
+}
+
+func isStringSemVerBetween[T ~string](v T, min string, max string) bool {
+	version, ok0 := parseSemVer(string(v))
+	minVersion, ok1 := parseSemVer(min)
+	maxVersion, ok2 := parseSemVer(max)
+	return ok0 && ok1 && ok2 && version.compare(minVersion) >= 0 && version.compare(maxVersion) <= 0
+}
+
+// Validate if a string is a version that follows Semantic Versioning 2.0.0,
+// such as "1.2.3", "1.0.0-rc.1", or "1.0.0+20130313144700". A "v" prefix is
+// not allowed.
+// For example:
+//
+//	version := "1.4.0-beta.2"
+//	Is(v.String(version).SemVer())
+func (validator *ValidatorString[T]) SemVer(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringSemVer(validator.context.Value().(T))
+		},
+		ErrorKeySemVer, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is a semantic version that satisfies the constraint.
+// The constraint is made of comparisons separated by spaces, which must all be
+// satisfied, and groups of comparisons can be separated by "||". The operators
+// are "=", "!=", ">", ">=", "<", "<=", "~" (patch updates), and "^" (updates
+// that don't change the leftmost non-zero number); no operator means "=". An
+// operator can be separated from its version by spaces, as in ">= 1.2.0". The
+// versions can be partial, such as "1.2" or "1.2.x", to match every version
+// starting with those numbers, so "^1.2" is the same as "^1.2.0".
+//
+// A constraint that can't be parsed is never satisfied, and it's reported with
+// its own error message, even after Not().
+// For example:
+//
+//	version := "1.4.0"
+//	Is(v.String(version).SatisfiesConstraint(">=1.2.0 <2.0.0"))
+func (validator *ValidatorString[T]) SatisfiesConstraint(constraint string, template ...string) *ValidatorString[T] {
+	comparisons, ok := parseSemVerConstraint(constraint)
+	if !ok {
+		addSemVerMalformedConstraint(validator.context, constraint, template)
+		return validator
+	}
+
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringSatisfyingConstraint(validator.context.Value().(T), comparisons)
+		},
+		ErrorKeySatisfiesConstraint,
+		map[string]any{"title": validator.context.title, "constraint": constraint, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is a semantic version with a higher precedence than
+// another version. Unlike `GreaterThan`, which compares the strings, "1.10.0"
+// is greater than "1.9.0", and "1.0.0" is greater than "1.0.0-rc.1".
+// For example:
+//
+//	version := "1.10.0"
+//	Is(v.String(version).SemVerGreaterThan("1.9.0"))
+func (validator *ValidatorString[T]) SemVerGreaterThan(version string, template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringSemVerGreaterThan(validator.context.Value().(T), version)
+		},
+		ErrorKeySemVerGreaterThan,
+		map[string]any{"title": validator.context.title, "version": version, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is a semantic version with a precedence between two
+// versions (inclusive).
+// For example:
+//
+//	version := "1.10.0"
+//	Is(v.String(version).SemVerBetween("1.2.0", "1.12.0"))
+func (validator *ValidatorString[T]) SemVerBetween(min string, max string, template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringSemVerBetween(validator.context.Value().(T), min, max)
+		},
+		ErrorKeySemVerBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a version that follows Semantic
+// Versioning 2.0.0.
+// For example:
+//
+//	version := "1.4.0-beta.2"
+//	Is(v.StringP(&version).SemVer())
+func (validator *ValidatorStringP[T]) SemVer(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringSemVer(*(validator.context.Value().(*T)))
+		},
+		ErrorKeySemVer, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a semantic version that
+// satisfies the constraint, like in [ValidatorString.SatisfiesConstraint].
+// For example:
+//
+//	version := "1.4.0"
+//	Is(v.StringP(&version).SatisfiesConstraint("^1.2.0"))
+func (validator *ValidatorStringP[T]) SatisfiesConstraint(constraint string, template ...string) *ValidatorStringP[T] {
+	comparisons, ok := parseSemVerConstraint(constraint)
+	if !ok {
+		addSemVerMalformedConstraint(validator.context, constraint, template)
+		return validator
+	}
+
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringSatisfyingConstraint(*(validator.context.Value().(*T)), comparisons)
+		},
+		ErrorKeySatisfiesConstraint,
+		map[string]any{"title": validator.context.title, "constraint": constraint, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a semantic version with a higher
+// precedence than another version.
+// For example:
+//
+//	version := "1.10.0"
+//	Is(v.StringP(&version).SemVerGreaterThan("1.9.0"))
+func (validator *ValidatorStringP[T]) SemVerGreaterThan(version string, template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringSemVerGreaterThan(*(validator.context.Value().(*T)), version)
+		},
+		ErrorKeySemVerGreaterThan,
+		map[string]any{"title": validator.context.title, "version": version, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a semantic version with a
+// precedence between two versions (inclusive).
+// For example:
+//
+//	version := "1.10.0"
+//	Is(v.StringP(&version).SemVerBetween("1.2.0", "1.12.0"))
+func (validator *ValidatorStringP[T]) SemVerBetween(min string, max string, template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringSemVerBetween(*(validator.context.Value().(*T)), min, max)
+		},
+		ErrorKeySemVerBetween,
+		map[string]any{"title": validator.context.title, "min": min, "max": max, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
@@ -0,0 +1,229 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringSemVer(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("1.2.3").SemVer())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, s := range []string{
+		"0.0.0",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-0.3.7",
+		"1.0.0-x.7.z.92",
+		"1.0.0-x-y-z.--",
+		"1.0.0+20130313144700",
+		"1.0.0-beta+exp.sha.5114f85",
+		"99999999999999999999.0.0",
+	} {
+		assert.True(t, Is(String(s).SemVer()).Valid(), s)
+	}
+
+	for _, s := range []string{
+		"",
+		"1",
+		"1.2",
+		"1.2.3.4",
+		"v1.2.3",
+		"01.2.3",
+		"1.02.3",
+		"1.2.3-",
+		"1.2.3-01",
+		"1.2.3-alpha..1",
+		"1.2.3+",
+		"1.2.3+build..1",
+		"1.2.3-al_pha",
+		" 1.2.3",
+	} {
+		assert.False(t, Is(String(s).SemVer()).Valid(), s)
+	}
+
+	v = Is(String("1.2", "version").SemVer())
+	assert.Equal(t,
+		"Version must be a valid semantic version",
+		v.Errors()["version"].Messages()[0])
+}
+
+func TestValidatorStringSatisfiesConstraint(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("1.4.0").SatisfiesConstraint(">=1.2.0 <2.0.0"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	cases := []struct {
+		version    string
+		constraint string
+		valid      bool
+	}{
+		{"1.2.0", ">=1.2.0 <2.0.0", true},
+		{"2.0.0", ">=1.2.0 <2.0.0", false},
+		{"2.0.0-rc.1", ">=1.2.0 <2.0.0", true},
+		{"1.1.9", ">=1.2.0 <2.0.0", false},
+		{"3.1.0", ">=1.2.0 <2.0.0 || ^3.0.0", true},
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3+build", "=1.2.3", true},
+		{"1.2.4", "!=1.2.3", true},
+		{"1.2.3", ">1.2.3", false},
+		{"1.2.3", "<=1.2.3", true},
+		{"1.2.9", "~1.2.3", true},
+		{"1.3.0", "~1.2.3", false},
+		{"1.2.2", "~1.2.3", false},
+		{"1.9.0", "^1.2.3", true},
+		{"2.0.0", "^1.2.3", false},
+		{"0.2.9", "^0.2.3", true},
+		{"0.3.0", "^0.2.3", false},
+		{"0.0.3", "^0.0.3", true},
+		{"0.0.4", "^0.0.3", false},
+		{"1.2.3", "", false},
+		{"1.2.3", "=>1.2.0", false},
+		{"1.2", ">=1.0.0", false},
+
+		// An operator can be separated from its version
+		{"1.4.0", ">= 1.0.0 < 2.0.0", true},
+		{"2.0.0", ">= 1.0.0 < 2.0.0", false},
+		{"1.0.0", "^ 1.0.0 || = 3.0.0", true},
+
+		// Partial versions match every version starting with their numbers
+		{"1.2.3", ">=1.2", true},
+		{"1.2.3", "1.2", true},
+		{"1.3.0", "1.2.x", false},
+		{"1.3.0-rc.1", "1.2", false},
+		{"1.9.0", "^1.2", true},
+		{"2.0.0", "^1.2", false},
+		{"2.0.0-rc.1", "^1.2", false},
+		{"0.2.9", "^0.2", true},
+		{"0.3.0", "^0.2", false},
+		{"0.0.9", "^0.0", true},
+		{"0.1.0", "^0.0", false},
+		{"0.9.0", "^0", true},
+		{"1.0.0", "^0", false},
+		{"1.2.9", "~1.2", true},
+		{"1.3.0", "~1.2", false},
+		{"1.9.0", "~1", true},
+		{"2.0.0", "~1.x", false},
+		{"1.3.0", ">1.2", true},
+		{"1.2.9", ">1.2", false},
+		{"1.2.9", "<=1.2", true},
+		{"1.3.0", "<=1.2", false},
+		{"1.1.9", "<1.2", true},
+		{"1.2.0", "<1.2", false},
+		{"1.3.0", "!=1.2", true},
+		{"1.2.5", "!=1.2", false},
+		{"10.0.0", "^9", false},
+		{"9.9.9", "<=9", true},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.valid, Is(String(c.version).SatisfiesConstraint(c.constraint)).Valid(), c.version+" "+c.constraint)
+	}
+
+	v = Is(String("2.1.0", "version").SatisfiesConstraint(">=1.2.0 <2.0.0"))
+	assert.Equal(t,
+		"Version must satisfy the version constraint \">=1.2.0 <2.0.0\"",
+		v.Errors()["version"].Messages()[0])
+
+	// A malformed constraint is reported as malformed, even after Not()
+	for _, constraint := range []string{"", ">=", "=>1.2.0", "1.2.3 ||", "^x", "1.x.2", "1.2-rc.1", "1.0.0 - 2.0.0", ">= >= 1.0.0"} {
+		v = Is(String("1.2.3", "version").SatisfiesConstraint(constraint))
+		assert.Equal(t,
+			"Version can't be checked against the malformed version constraint \""+constraint+"\"",
+			v.Errors()["version"].Messages()[0], constraint)
+
+		assert.False(t, Is(String("1.2.3").Not().SatisfiesConstraint(constraint)).Valid(), constraint)
+	}
+
+	version := "1.2.3"
+	v = Is(StringP(&version, "version").SatisfiesConstraint("~> 1.2"))
+	assert.Equal(t,
+		"Version can't be checked against the malformed version constraint \"~> 1.2\"",
+		v.Errors()["version"].Messages()[0])
+}
+
+func TestValidatorStringSemVerGreaterThan(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("1.10.0").SemVerGreaterThan("1.9.0"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	// The lexical comparison of GreaterThan gives the opposite result
+	assert.False(t, Is(String("1.10.0").GreaterThan("1.9.0")).Valid())
+
+	// Precedence examples of the SemVer 2.0.0 specification, in ascending order
+	versions := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+	}
+	for i := 1; i < len(versions); i++ {
+		assert.True(t, Is(String(versions[i]).SemVerGreaterThan(versions[i-1])).Valid(), versions[i])
+		assert.False(t, Is(String(versions[i-1]).SemVerGreaterThan(versions[i])).Valid(), versions[i-1])
+	}
+
+	assert.False(t, Is(String("1.0.0+build.2").SemVerGreaterThan("1.0.0+build.1")).Valid())
+	assert.False(t, Is(String("1.0.0").SemVerGreaterThan("invalid")).Valid())
+
+	v = Is(String("1.0.0", "version").SemVerGreaterThan("1.2.0"))
+	assert.Equal(t,
+		"Version must be a version greater than \"1.2.0\"",
+		v.Errors()["version"].Messages()[0])
+}
+
+func TestValidatorStringSemVerBetween(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("1.10.0").SemVerBetween("1.2.0", "1.12.0"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.True(t, Is(String("1.2.0").SemVerBetween("1.2.0", "1.12.0")).Valid())
+	assert.True(t, Is(String("1.12.0").SemVerBetween("1.2.0", "1.12.0")).Valid())
+	assert.False(t, Is(String("1.12.0").SemVerBetween("1.2.0", "1.12.0-rc.1")).Valid())
+
+	v = Is(String("1.1.0", "version").SemVerBetween("1.2.0", "1.12.0"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Version must be a version between \"1.2.0\" and \"1.12.0\"",
+		v.Errors()["version"].Messages()[0])
+}
+
+func TestValidatorStringPSemVer(t *testing.T) {
+
+	var v *Validation
+
+	version := "1.4.0"
+	v = Is(StringP(&version).SemVer().SatisfiesConstraint("^1.2.0").SemVerGreaterThan("1.3.9").SemVerBetween("1.0.0", "2.0.0"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	var nilVersion *string
+	assert.False(t, Is(StringP(nilVersion).SemVer()).Valid())
+	assert.False(t, Is(StringP(nilVersion).SatisfiesConstraint(">=1.0.0")).Valid())
+	assert.False(t, Is(StringP(nilVersion).SemVerGreaterThan("1.0.0")).Valid())
+	assert.False(t, Is(StringP(nilVersion).SemVerBetween("1.0.0", "2.0.0")).Valid())
+}
+
+func TestValidatorStringSemVerLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs}).Is(String("0.9.0", "version", "Versión").SemVerBetween("1.0.0", "2.0.0"))
+	assert.Equal(t,
+		"Versión debe ser una versión entre \"1.0.0\" y \"2.0.0\"",
+		v.Errors()["version"].Messages()[0])
+}
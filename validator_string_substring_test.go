@@ -0,0 +1,189 @@
+package valgo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringContains(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("jane@example.com").Contains("@"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("jane.example.com", "email").Contains("@"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Email must contain \"@\"",
+		v.Errors()["email"].Messages()[0])
+
+	v = Is(String("jane@example.com", "email").Not().Contains("@"))
+	assert.Equal(t,
+		"Email can't contain \"@\"",
+		v.Errors()["email"].Messages()[0])
+}
+
+func TestValidatorStringHasPrefixAndSuffix(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("sk_live_4eC39Hq").HasPrefix("sk_live_")).
+		Is(String("report.pdf").HasSuffix(".pdf"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("pk_live_4eC39Hq", "api_key").HasPrefix("sk_live_"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Api key must start with \"sk_live_\"",
+		v.Errors()["api_key"].Messages()[0])
+
+	v = Is(String("report.PDF", "file_name").HasSuffix(".pdf"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"File name must end with \".pdf\"",
+		v.Errors()["file_name"].Messages()[0])
+
+	v = Is(String("sk_test_4eC39Hq", "api_key").Not().HasPrefix("sk_test_"))
+	assert.Equal(t,
+		"Api key can't start with \"sk_test_\"",
+		v.Errors()["api_key"].Messages()[0])
+
+	v = Is(String("setup.exe", "file_name").Not().HasSuffix(".exe"))
+	assert.Equal(t,
+		"File name can't end with \".exe\"",
+		v.Errors()["file_name"].Messages()[0])
+}
+
+func TestValidatorStringContainsAnyAndExcludesAll(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("s3cret!").ContainsAny("!@#$%")).
+		Is(String("Jane Doe").ExcludesAll("<>\"'"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("s3cret", "password").ContainsAny("!@#$%"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Password must contain at least one of the characters \"!@#$%\"",
+		v.Errors()["password"].Messages()[0])
+
+	v = Is(String("<b>Jane</b>", "name").ExcludesAll("<>"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Name can't contain any of the characters \"<>\"",
+		v.Errors()["name"].Messages()[0])
+
+	assert.False(t, Is(String("").ContainsAny("")).Valid())
+	assert.True(t, Is(String("Jane").ExcludesAll("")).Valid())
+
+	v = Is(String("Jane", "name").Not().ExcludesAll("<>"))
+	assert.Equal(t,
+		"Name must contain at least one of the characters \"<>\"",
+		v.Errors()["name"].Messages()[0])
+}
+
+func TestValidatorStringContainsFoldAndEqualToFold(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"URGENT: server down", "Urgent", "an urgent issue", "URGENT"} {
+		assert.True(t, Is(String(s).ContainsFold("urgent")).Valid(), s)
+	}
+	// The Kelvin sign folds to "k", but has a different length in bytes
+	assert.True(t, Is(String("Temperature in \u212a").ContainsFold("in k")).Valid())
+	assert.True(t, Is(String("Straße").ContainsFold("STRAßE")).Valid())
+	assert.True(t, Is(String("anything").ContainsFold("")).Valid())
+	assert.True(t, Is(String("").ContainsFold("")).Valid())
+
+	v = Is(String("Server down", "subject").ContainsFold("urgent"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Subject must contain \"urgent\", ignoring case",
+		v.Errors()["subject"].Messages()[0])
+
+	v = Is(String("YES").EqualToFold("yes")).Is(String("Σίσυφος").EqualToFold("ΣΊΣΥΦΟΣ"))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("yes!", "answer").EqualToFold("yes"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Answer must be equal to \"yes\", ignoring case",
+		v.Errors()["answer"].Messages()[0])
+
+	v = Is(String("Admin", "username").Not().EqualToFold("admin"))
+	assert.Equal(t,
+		"Username can't be equal to \"admin\", ignoring case",
+		v.Errors()["username"].Messages()[0])
+}
+
+func TestValidatorStringWordCountBetween(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("Validation rules for Go").WordCountBetween(3, 5))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.Equal(t, 0, countWords(""))
+	assert.Equal(t, 0, countWords(" - ... "))
+	assert.Equal(t, 5, countWords("  Go — fast,\tsimple\nand 100% "))
+	assert.Equal(t, 3, countWords("東京 は 大きい"))
+
+	v = Is(String("Validation rules", "summary").WordCountBetween(3, 5))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Summary must have between \"3\" and \"5\" words",
+		v.Errors()["summary"].Messages()[0])
+
+	v = Is(String("one two three four five six", "summary").WordCountBetween(3, 5))
+	assert.False(t, v.Valid())
+
+	v = Is(String("one two three", "summary").Not().WordCountBetween(3, 5))
+	assert.Equal(t,
+		"Summary can't have between \"3\" and \"5\" words",
+		v.Errors()["summary"].Messages()[0])
+}
+
+func TestValidatorStringPSubstrings(t *testing.T) {
+
+	var v *Validation
+
+	email := "jane@example.com"
+	subject := "URGENT: server down"
+	v = Is(StringP(&email).Contains("@").HasPrefix("jane").HasSuffix(".com").
+		ContainsAny("@.").ExcludesAll("<>").EqualToFold("JANE@EXAMPLE.COM")).
+		Is(StringP(&subject).ContainsFold("urgent").WordCountBetween(1, 5))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(StringP(&email, "email").HasSuffix(".org"))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Email must end with \".org\"",
+		v.Errors()["email"].Messages()[0])
+
+	var nilString *string
+	for _, validator := range []*ValidatorStringP[string]{
+		StringP(nilString).Contains(""), StringP(nilString).HasPrefix(""),
+		StringP(nilString).HasSuffix(""), StringP(nilString).ContainsAny("@"),
+		StringP(nilString).ExcludesAll("@"), StringP(nilString).ContainsFold(""),
+		StringP(nilString).EqualToFold(""), StringP(nilString).WordCountBetween(0, 1),
+	} {
+		assert.False(t, Is(validator).Valid())
+	}
+}
+
+func TestValidatorStringSubstringLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs}).Is(String("Hola", "summary", "Resumen").WordCountBetween(3, 5))
+	assert.Equal(t,
+		"Resumen debe tener entre \"3\" y \"5\" palabras",
+		v.Errors()["summary"].Messages()[0])
+}
@@ -0,0 +1,341 @@
+package valgo
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+func isStringTime[T ~string](v T, layout string) bool {
+	_, err := time.Parse(layout, string(v))
+	return err == nil
+}
+
+func isStringISO8601Date[T ~string](v T) bool {
+	_, err := ParseDate(string(v))
+	return err == nil
+}
+
+// Parse the number of an ISO 8601 duration component at the start of v, such
+// as "3" or "1.5", and return the rest of v. A number with a fraction may use a
+// comma or a dot.
+func cutISO8601DurationNumber(v string) (string, bool, bool) {
+	i := 0
+	for i < len(v) && v[i] >= '0' && v[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return v, false, false
+	}
+	if i < len(v) && (v[i] == '.' || v[i] == ',') {
+		j := i + 1
+		for j < len(v) && v[j] >= '0' && v[j] <= '9' {
+			j++
+		}
+		if j == i+1 {
+			return v, false, false
+		}
+		return v[j:], true, true
+	}
+	return v[i:], false, true
+}
+
+// Check if v is an ISO 8601 duration such as "P1Y2M10DT2H30M", "PT0.5S" or
+// "P2W". Only the last component can have a fraction.
+func isISO8601Duration(v string) bool {
+	v, ok := strings.CutPrefix(v, "P")
+	if !ok || v == "" {
+		return false
+	}
+
+	datePart, timePart, hasTime := strings.Cut(v, "T")
+	if hasTime && timePart == "" {
+		return false
+	}
+
+	components := 0
+	fraction := false
+	check := func(part string, designators string) bool {
+		for part != "" {
+			if fraction {
+				return false
+			}
+			rest, hasFraction, ok := cutISO8601DurationNumber(part)
+			if !ok || rest == "" {
+				return false
+			}
+			i := strings.IndexByte(designators, rest[0])
+			if i < 0 {
+				return false
+			}
+			// Designators must follow their order, so they can't repeat either
+			designators = designators[i+1:]
+			part = rest[1:]
+			fraction = hasFraction
+			components++
+		}
+		return true
+	}
+
+	return check(datePart, "YMWD") && check(timePart, "HMS") && components > 0
+}
+
+func isStringISO8601Duration[T ~string](v T) bool {
+	return isISO8601Duration(string(v))
+}
+
+// Check if v is a whole number of seconds since the Unix epoch that fits in an
+// int64, such as "1700000000" or "-86400".
+func isStringUnixTimestamp[T ~string](v T) bool {
+	s := strings.TrimPrefix(string(v), "-")
+	if !isDecimalDigits(s) {
+		return false
+	}
+	_, err := strconv.ParseInt(string(v), 10, 64)
+	return err == nil
+}
+
+// Parse a string lazily for AsTime. The string is read when the rules are
+// evaluated, and the result is kept for the rules of the time while the string
+// doesn't change.
+type stringTimeParser struct {
+	layout string
+	source func() (string, bool)
+	input  string
+	done   bool
+	value  time.Time
+	parsed bool
+}
+
+func (p *stringTimeParser) parse() (time.Time, bool) {
+	input, ok := p.source()
+	if !ok {
+		return time.Time{}, false
+	}
+	if !p.done || p.input != input {
+		t, err := time.Parse(p.layout, input)
+		p.input, p.done, p.value, p.parsed = input, true, t, err == nil
+	}
+	return p.value, p.parsed
+}
+
+func (p *stringTimeParser) time() any {
+	value, _ := p.parse()
+	return value
+}
+
+func (p *stringTimeParser) valid() bool {
+	_, parsed := p.parse()
+	return parsed
+}
+
+// Add the rule that parses the string of the context with the layout, and
+// return the [ValidatorTime] that continues the chain with the parsed time.
+func addStringAsTime(ctx *ValidatorContext, source func() (string, bool), layout string, template []string) *ValidatorTime {
+	parser := &stringTimeParser{layout: layout, source: source}
+
+	// A pending Not() is ignored, since an inverted parse would let the rules
+	// of the time run without a time
+	ctx.boolOperation = true
+	ctx.AddWithParams(
+		parser.valid,
+		ErrorKeyTime,
+		map[string]any{"title": ctx.title, "layout": layout, "value": ctx.Value()},
+		template...)
+
+	return &ValidatorTime{context: ctx.convert(parser.time, parser.valid)}
+}
+
+// Validate if a string is a time in the format of the layout, as accepted by
+// time.Parse. Times without a time zone are interpreted as UTC.
+// For example:
+//
+//	Is(v.String("2024-02-29 10:30").Time("2006-01-02 15:04"))
+func (validator *ValidatorString[T]) Time(layout string, template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringTime(validator.context.Value().(T), layout)
+		},
+		ErrorKeyTime,
+		map[string]any{"title": validator.context.title, "layout": layout, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string is an RFC 3339 timestamp, such as
+// "2024-02-29T10:30:00Z" or "2024-02-29T10:30:00.5+01:00".
+// For example:
+//
+//	Is(v.String("2024-02-29T10:30:00Z").RFC3339())
+func (validator *ValidatorString[T]) RFC3339(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringTime(validator.context.Value().(T), time.RFC3339)
+		},
+		ErrorKeyRFC3339, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is an ISO 8601 calendar date that exists, such as
+// "2024-02-29" or "20240229", as accepted by [ParseDate].
+// For example:
+//
+//	Is(v.String("2024-02-29").ISO8601Date())
+func (validator *ValidatorString[T]) ISO8601Date(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringISO8601Date(validator.context.Value().(T))
+		},
+		ErrorKeyISO8601Date, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is an ISO 8601 duration, such as "P1Y2M10DT2H30M",
+// "PT1.5S", or "P2W". The designators must be in order, and only the last
+// component can have a fraction.
+// For example:
+//
+//	Is(v.String("PT30M").ISO8601Duration())
+func (validator *ValidatorString[T]) ISO8601Duration(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringISO8601Duration(validator.context.Value().(T))
+		},
+		ErrorKeyISO8601Duration, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a string is a whole number of seconds since the Unix epoch, such
+// as "1700000000". Negative timestamps are allowed, but fractions and signs
+// other than "-" are not.
+// For example:
+//
+//	Is(v.String("1700000000").UnixTimestamp())
+func (validator *ValidatorString[T]) UnixTimestamp(template ...string) *ValidatorString[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return isStringUnixTimestamp(validator.context.Value().(T))
+		},
+		ErrorKeyUnixTimestamp, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Parse the string with the layout and continue the chain with the rules of a
+// [ValidatorTime] for the parsed time. When the string can't be parsed, the
+// error message is the same as the one of the Time rule, and the rules of the
+// time are skipped, so a parse failure and a range failure have different
+// messages. A Not() before AsTime is ignored; use Not() before the rules of
+// the time instead.
+// For example:
+//
+//	Is(v.String(since, "since").AsTime(time.RFC3339).After(start))
+func (validator *ValidatorString[T]) AsTime(layout string, template ...string) *ValidatorTime {
+	return addStringAsTime(validator.context,
+		func() (string, bool) {
+			return string(validator.context.Value().(T)), true
+		},
+		layout, template)
+}
+
+// Validate if the value of a string pointer is a time in the format of the
+// layout, like in [ValidatorString.Time].
+// For example:
+//
+//	value := "2024-02-29 10:30"
+//	Is(v.StringP(&value).Time("2006-01-02 15:04"))
+func (validator *ValidatorStringP[T]) Time(layout string, template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringTime(*(validator.context.Value().(*T)), layout)
+		},
+		ErrorKeyTime,
+		map[string]any{"title": validator.context.title, "layout": layout, "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an RFC 3339 timestamp.
+// For example:
+//
+//	value := "2024-02-29T10:30:00Z"
+//	Is(v.StringP(&value).RFC3339())
+func (validator *ValidatorStringP[T]) RFC3339(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringTime(*(validator.context.Value().(*T)), time.RFC3339)
+		},
+		ErrorKeyRFC3339, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an ISO 8601 calendar date that
+// exists.
+// For example:
+//
+//	value := "2024-02-29"
+//	Is(v.StringP(&value).ISO8601Date())
+func (validator *ValidatorStringP[T]) ISO8601Date(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringISO8601Date(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyISO8601Date, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is an ISO 8601 duration, like in
+// [ValidatorString.ISO8601Duration].
+// For example:
+//
+//	value := "PT30M"
+//	Is(v.StringP(&value).ISO8601Duration())
+func (validator *ValidatorStringP[T]) ISO8601Duration(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringISO8601Duration(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyISO8601Duration, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is a whole number of seconds since
+// the Unix epoch.
+// For example:
+//
+//	value := "1700000000"
+//	Is(v.StringP(&value).UnixTimestamp())
+func (validator *ValidatorStringP[T]) UnixTimestamp(template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isStringUnixTimestamp(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyUnixTimestamp, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Parse the value of a string pointer with the layout and continue the chain
+// with the rules of a [ValidatorTime], like in [ValidatorString.AsTime]. A nil
+// pointer can't be parsed.
+// For example:
+//
+//	Is(v.StringP(since, "since").AsTime(time.RFC3339).After(start))
+func (validator *ValidatorStringP[T]) AsTime(layout string, template ...string) *ValidatorTime {
+	return addStringAsTime(validator.context,
+		func() (string, bool) {
+			if validator.context.Value().(*T) == nil {
+				return "", false
+			}
+			return string(*(validator.context.Value().(*T))), true
+		},
+		layout, template)
+}
@@ -0,0 +1,204 @@
+package valgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringTime(t *testing.T) {
+	layout := "2006-01-02 15:04"
+
+	assert.True(t, Is(String("2024-02-29 10:30").Time(layout)).Valid())
+	assert.True(t, Is(String("2024-02-29").Not().Time(layout)).Valid())
+
+	v := Is(String("2024-02-30 10:30", "starts_at").Time(layout))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Starts at must be a time in the format \"2006-01-02 15:04\"",
+		v.Errors()["starts_at"].Messages()[0])
+}
+
+func TestValidatorStringRFC3339(t *testing.T) {
+	for _, value := range []string{"2024-02-29T10:30:00Z", "2024-02-29T10:30:00.5+01:00", "2024-02-29T10:30:00-08:00"} {
+		assert.True(t, Is(String(value).RFC3339()).Valid(), value)
+	}
+	for _, value := range []string{"2024-02-29", "2024-02-29T10:30:00", "2024-02-29 10:30:00Z", "1700000000", ""} {
+		assert.False(t, Is(String(value).RFC3339()).Valid(), value)
+	}
+
+	v := Is(String("yesterday", "since").RFC3339())
+	assert.Equal(t,
+		"Since must be an RFC 3339 timestamp",
+		v.Errors()["since"].Messages()[0])
+}
+
+func TestValidatorStringISO8601Date(t *testing.T) {
+	assert.True(t, Is(String("2024-02-29").ISO8601Date()).Valid())
+	assert.True(t, Is(String("20240229").ISO8601Date()).Valid())
+	assert.False(t, Is(String("2023-02-29").ISO8601Date()).Valid())
+	assert.False(t, Is(String("29/02/2024").ISO8601Date()).Valid())
+
+	v := Is(String("2024-13-01", "birthday").ISO8601Date())
+	assert.Equal(t,
+		"Birthday must be an ISO 8601 date",
+		v.Errors()["birthday"].Messages()[0])
+}
+
+func TestValidatorStringISO8601Duration(t *testing.T) {
+	for _, value := range []string{"P1Y2M10DT2H30M", "PT30M", "PT0.5S", "PT1,5S", "P2W", "P1D", "PT36H", "P0D", "P1Y2M3W4D"} {
+		assert.True(t, Is(String(value).ISO8601Duration()).Valid(), value)
+	}
+	for _, value := range []string{"", "P", "PT", "P1DT", "1D", "P1H", "PT1D", "P1M1Y", "P1Y1Y", "P1.5Y2M", "PT1.S", "P-1D", "pt1h", "P1DT1H1"} {
+		assert.False(t, Is(String(value).ISO8601Duration()).Valid(), value)
+	}
+
+	v := Is(String("30m", "timeout").ISO8601Duration())
+	assert.Equal(t,
+		"Timeout must be an ISO 8601 duration",
+		v.Errors()["timeout"].Messages()[0])
+}
+
+func TestValidatorStringUnixTimestamp(t *testing.T) {
+	for _, value := range []string{"0", "1700000000", "-86400", "9223372036854775807"} {
+		assert.True(t, Is(String(value).UnixTimestamp()).Valid(), value)
+	}
+	for _, value := range []string{"", "-", "+1", "1700000000.5", "1e9", "9223372036854775808", "2024-02-29"} {
+		assert.False(t, Is(String(value).UnixTimestamp()).Valid(), value)
+	}
+
+	v := Is(String("now", "created_at").UnixTimestamp())
+	assert.Equal(t,
+		"Created at must be a Unix timestamp",
+		v.Errors()["created_at"].Messages()[0])
+}
+
+func TestValidatorStringAsTime(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, Is(String("2024-02-29T10:30:00Z").AsTime(time.RFC3339).After(start)).Valid())
+	assert.True(t, Is(String("2024-02-29T10:30:00Z").Not().Blank().AsTime(time.RFC3339).After(start).Before(start.AddDate(1, 0, 0))).Valid())
+
+	// A range failure reports the rule of the time
+	v := Is(String("2023-06-01T00:00:00Z", "since").AsTime(time.RFC3339).After(start))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Since must be after \""+start.String()+"\"",
+		v.Errors()["since"].Messages()[0])
+
+	// A parse failure reports only the format
+	v = Is(String("yesterday", "since").AsTime(time.RFC3339).After(start))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		[]string{"Since must be a time in the format \"" + time.RFC3339 + "\""},
+		v.Errors()["since"].Messages())
+
+	// Even when the rules are not short-circuited
+	v = Check(String("yesterday", "since").AsTime(time.RFC3339).After(start).Before(start))
+	assert.Equal(t,
+		[]string{"Since must be a time in the format \"" + time.RFC3339 + "\""},
+		v.Errors()["since"].Messages())
+
+	// The rules of the string are still evaluated
+	v = Check(String("", "since").Not().Blank().AsTime(time.RFC3339).After(start))
+	assert.Len(t, v.Errors()["since"].Messages(), 2)
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(String("yesterday", "since").AsTime("2006-01-02"))
+	assert.Equal(t,
+		"Since debe ser una hora con el formato \"2006-01-02\"",
+		v.Errors()["since"].Messages()[0])
+}
+
+func TestValidatorStringAsTimeReusedBase(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Rules added to the string after AsTime don't replace the rules of the time
+	s := String("2023-06-01T00:00:00Z", "since")
+	since := s.AsTime(time.RFC3339).After(start)
+	s.MinLength(1)
+	v := Check(since)
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		[]string{"Since must be after \"" + start.String() + "\""},
+		v.Errors()["since"].Messages())
+	assert.True(t, Check(s).Valid())
+
+	// Two conversions of the same string keep their own rules
+	s = String("2023-06-01T00:00:00Z", "since")
+	after := s.AsTime(time.RFC3339).After(start)
+	before := s.AsTime(time.RFC3339).Before(start)
+	assert.False(t, Check(after).Valid())
+	assert.True(t, Check(before).Valid())
+}
+
+func TestValidatorStringAsTimeAfterNot(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// A Not() before AsTime doesn't invert the parse
+	v := Check(String("bad", "since").Not().AsTime(time.RFC3339).After(start))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		[]string{"Since must be a time in the format \"" + time.RFC3339 + "\""},
+		v.Errors()["since"].Messages())
+
+	assert.True(t, Check(String("2024-02-29T10:30:00Z").Not().AsTime(time.RFC3339).After(start)).Valid())
+}
+
+func TestValidatorStringPAsTimeLazy(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// A nil pointer is not dereferenced
+	var since *string
+	assert.NotPanics(t, func() {
+		assert.False(t, Check(StringP(since).AsTime(time.RFC3339).After(start)).Valid())
+	})
+
+	// The pointed string is read when the rules are evaluated
+
+	value := "2024-02-29T10:30:00Z"
+	ptr := &value
+	s := StringP(ptr).AsTime(time.RFC3339).After(start)
+	value = "2023-06-01T00:00:00Z"
+	assert.False(t, Check(s).Valid())
+}
+
+func TestValidatorStringAsTimeOrOperator(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// An empty string is accepted without parsing it
+	assert.True(t, Is(String("").Empty().OrElse().AsTime(time.RFC3339).After(start)).Valid())
+	assert.True(t, Is(String("2024-02-29T10:30:00Z").Empty().OrElse().AsTime(time.RFC3339).After(start)).Valid())
+	assert.False(t, Is(String("2023-02-28T10:30:00Z").Empty().OrElse().AsTime(time.RFC3339).After(start)).Valid())
+
+	assert.True(t, Is(String("2023-02-28T10:30:00Z").AsTime(time.RFC3339).Before(start).Or().After(start.AddDate(1, 0, 0))).Valid())
+	assert.False(t, Is(String("bad").AsTime(time.RFC3339).Before(start).Or().After(start)).Valid())
+}
+
+func TestValidatorStringPTimeRules(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp := "2024-02-29T10:30:00Z"
+	date := "2024-02-29"
+	duration := "PT30M"
+	unix := "1700000000"
+
+	assert.True(t, Is(StringP(&timestamp).Time(time.RFC3339).RFC3339()).Valid())
+	assert.True(t, Is(StringP(&date).ISO8601Date()).Valid())
+	assert.True(t, Is(StringP(&duration).ISO8601Duration()).Valid())
+	assert.True(t, Is(StringP(&unix).UnixTimestamp()).Valid())
+	assert.True(t, Is(StringP(&timestamp).AsTime(time.RFC3339).After(start)).Valid())
+	assert.False(t, Is(StringP(&date).RFC3339()).Valid())
+
+	var nilString *string
+	assert.False(t, Is(StringP(nilString).Time(time.RFC3339)).Valid())
+	assert.False(t, Is(StringP(nilString).RFC3339()).Valid())
+	assert.False(t, Is(StringP(nilString).ISO8601Date()).Valid())
+	assert.False(t, Is(StringP(nilString).ISO8601Duration()).Valid())
+	assert.False(t, Is(StringP(nilString).UnixTimestamp()).Valid())
+
+	v := Check(StringP(nilString, "since").AsTime(time.RFC3339).After(start))
+	assert.Equal(t,
+		[]string{"Since must be a time in the format \"" + time.RFC3339 + "\""},
+		v.Errors()["since"].Messages())
+	assert.True(t, Is(StringP(nilString).Nil().OrElse().AsTime(time.RFC3339).After(start)).Valid())
+}
@@ -0,0 +1,424 @@
+package valgo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Return the first character of the string that doesn't satisfy the function.
+// Invalid UTF-8 bytes are returned as utf8.RuneError and never satisfy it.
+func firstRuneNotSatisfying(v string, fn func(rune) bool) (rune, bool) {
+	for i := 0; i < len(v); {
+		r, size := utf8.DecodeRuneInString(v[i:])
+		if (r == utf8.RuneError && size == 1) || !fn(r) {
+			return r, true
+		}
+		i += size
+	}
+	return 0, false
+}
+
+// Check that all the characters of the string satisfy the function, and set
+// the first one that doesn't in the "character" template param, formatted as
+// a code point like "U+200B", since it's usually invisible.
+func isStringEveryRune(v string, fn func(rune) bool, params map[string]any) bool {
+	r, found := firstRuneNotSatisfying(v, fn)
+	if found {
+		params["character"] = fmt.Sprintf("%U", r)
+	}
+	return !found
+}
+
+// Control (Cc) and format (Cf) characters, such as the zero-width joiners and
+// the bidirectional overrides, except the tab and the line breaks.
+func isControlChar(r rune) bool {
+	if r == '\t' || r == '\n' || r == '\r' {
+		return false
+	}
+	return unicode.IsControl(r) || unicode.Is(unicode.Cf, r)
+}
+
+func isLineBreak(r rune) bool {
+	switch r {
+	case '\n', '\v', '\f', '\r', '\u0085', '\u2028', '\u2029':
+		return true
+	}
+	return false
+}
+
+func isStringNormalizedNFC(v string) bool {
+	if !utf8.ValidString(v) {
+		return false
+	}
+	return norm.NFC.IsNormalString(v)
+}
+
+// Return the name of the script of the character, as in [unicode.Scripts].
+func runeScript(r rune) string {
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+	return "Unknown"
+}
+
+// Scripts commonly used together in a single writing system, as in the
+// augmented script sets of Unicode Technical Standard #39. For example,
+// Japanese is written with the Han, Hiragana and Katakana scripts.
+var augmentedScripts = map[string][]string{
+	"Han":      {"Han", "Hanb", "Jpan", "Kore"},
+	"Hiragana": {"Hiragana", "Jpan"},
+	"Katakana": {"Katakana", "Jpan"},
+	"Hangul":   {"Hangul", "Kore"},
+	"Bopomofo": {"Bopomofo", "Hanb"},
+}
+
+// Check that the characters of the string belong to a single script. The
+// characters of the Common and Inherited scripts, like the digits, the
+// punctuation and the combining marks, can be used with any script.
+func isStringSingleScript(v string) bool {
+	var resolved []string
+	for _, r := range v {
+		script := runeScript(r)
+		if script == "Common" || script == "Inherited" {
+			continue
+		}
+		scripts, ok := augmentedScripts[script]
+		if !ok {
+			scripts = []string{script}
+		}
+		if resolved == nil {
+			resolved = scripts
+			continue
+		}
+		resolved = slices.DeleteFunc(slices.Clone(resolved), func(s string) bool {
+			return !slices.Contains(scripts, s)
+		})
+		if len(resolved) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isStringScriptIn(v string, scripts []*unicode.RangeTable) bool {
+	_, found := firstRuneNotSatisfying(v, func(r rune) bool {
+		return unicode.In(r, scripts...) || unicode.In(r, unicode.Common, unicode.Inherited)
+	})
+	return !found
+}
+
+// Return the names of the scripts, as in [unicode.Scripts], separated by
+// commas.
+func scriptNames(scripts []*unicode.RangeTable) string {
+	names := []string{}
+	for _, script := range scripts {
+		for name, table := range unicode.Scripts {
+			if table == script {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// Return the Latin letter or ASCII character that the character looks like, if
+// any.
+func confusableLetter(r rune) (rune, bool) {
+	// Fullwidth forms of the ASCII characters
+	if r >= 0xFF01 && r <= 0xFF5E {
+		return r - 0xFEE0, true
+	}
+	lookalike, ok := confusableLetters[r]
+	return lookalike, ok
+}
+
+// Check that the string doesn't contain characters that can be confused with
+// Latin letters to spoof a Latin text. The characters of other scripts that
+// look like Latin letters are rejected when they are mixed with Latin letters,
+// like in "pаypal" with a Cyrillic "а", or when all the letters of the string
+// look like Latin letters, like in "раураl" written in Cyrillic. The fullwidth
+// forms of the ASCII characters are always rejected. The first confusable
+// character and its lookalike are set in the "character" and "lookalike"
+// template params.
+func isStringWithoutConfusables(v string, params map[string]any) bool {
+	var confusable, lookalike rune
+	found, hasLatin, onlyConfusables := false, false, true
+	for _, r := range v {
+		l, ok := confusableLetter(r)
+		switch {
+		case ok && r >= 0xFF01 && r <= 0xFF5E:
+			params["character"], params["lookalike"] = string(r), string(l)
+			return false
+		case ok:
+			if !found {
+				confusable, lookalike, found = r, l, true
+			}
+		case unicode.Is(unicode.Latin, r):
+			hasLatin = true
+		case unicode.IsLetter(r):
+			onlyConfusables = false
+		}
+	}
+
+	if found && (hasLatin || onlyConfusables) {
+		params["character"], params["lookalike"] = string(confusable), string(lookalike)
+		return false
+	}
+	return true
+}
+
+// Validate if a string only contains printable characters, as defined by
+// [unicode.IsPrint]: letters, marks, numbers, punctuation, symbols and the
+// ASCII space. Tabs, line breaks, other spaces, control and format characters,
+// and invalid UTF-8 are not printable. The error message can use the param
+// "character" with the code point of the first character that is not
+// printable, like "U+200B".
+// For example:
+//
+//	Is(v.String("Jane Doe").Printable())
+func (validator *ValidatorString[T]) Printable(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringEveryRune(string(validator.context.Value().(T)), unicode.IsPrint, params)
+		},
+		ErrorKeyPrintable, params, template...)
+
+	return validator
+}
+
+// Validate if a string doesn't contain control (Cc) or format (Cf) characters,
+// such as the zero-width joiners or the bidirectional overrides used to hide
+// or reorder text. Tabs and line breaks are allowed, use `SingleLine` to
+// reject the line breaks. Invalid UTF-8 is rejected as well. The error message
+// can use the param "character" with the code point of the first control
+// character, like "U+202E".
+// For example:
+//
+//	Is(v.String(comment).NoControlChars())
+func (validator *ValidatorString[T]) NoControlChars(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringEveryRune(string(validator.context.Value().(T)), func(r rune) bool { return !isControlChar(r) }, params)
+		},
+		ErrorKeyWithoutControlChars, params, template...)
+
+	return validator
+}
+
+// Validate if a string is in the Unicode Normalization Form C (NFC), so that
+// visually identical texts, such as "é" written with one or with two
+// characters, have the same representation.
+// For example:
+//
+//	Is(v.String("Zoë").NormalizedNFC())
+func (validator *ValidatorString[T]) NormalizedNFC(template ...string) *ValidatorString[T] {
+	validator.context.Add(
+		func() bool {
+			return isStringNormalizedNFC(string(validator.context.Value().(T)))
+		},
+		ErrorKeyNormalizedNFC, template...)
+
+	return validator
+}
+
+// Validate if the characters of a string belong to a single script, such as
+// Latin, Cyrillic or Greek. The characters common to all the scripts, like
+// digits and punctuation, are allowed. Han can be mixed with Hiragana and
+// Katakana, as in Japanese, with Hangul, as in Korean, or with Bopomofo.
+// For example:
+//
+//	Is(v.String("Ирина").SingleScript())
+func (validator *ValidatorString[T]) SingleScript(template ...string) *ValidatorString[T] {
+	validator.context.Add(
+		func() bool {
+			return isStringSingleScript(string(validator.context.Value().(T)))
+		},
+		ErrorKeySingleScript, template...)
+
+	return validator
+}
+
+// Validate if the characters of a string belong to the scripts in the slice.
+// The scripts are the tables of [unicode.Scripts], such as [unicode.Latin].
+// The characters common to all the scripts, like digits and punctuation, are
+// allowed.
+// For example:
+//
+//	Is(v.String("Ελένη").ScriptIn([]*unicode.RangeTable{unicode.Latin, unicode.Greek}))
+func (validator *ValidatorString[T]) ScriptIn(scripts []*unicode.RangeTable, template ...string) *ValidatorString[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringScriptIn(string(validator.context.Value().(T)), scripts)
+		},
+		ErrorKeyScriptIn,
+		map[string]any{"title": validator.context.title, "scripts": scriptNames(scripts), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if a string doesn't contain characters of other scripts that can be
+// confused with Latin letters, like the Cyrillic "а" in "pаypal", or fullwidth
+// forms of ASCII characters. The confusable characters are only rejected when
+// they are mixed with Latin letters, or when all the letters of the string can
+// be confused with Latin letters. The error message can use the params
+// "character" and "lookalike" with the first confusable character and the
+// character it looks like.
+// For example:
+//
+//	Is(v.String(username).NoConfusables())
+func (validator *ValidatorString[T]) NoConfusables(template ...string) *ValidatorString[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			return isStringWithoutConfusables(string(validator.context.Value().(T)), params)
+		},
+		ErrorKeyWithoutConfusables, params, template...)
+
+	return validator
+}
+
+// Validate if a string doesn't contain line breaks, including the Unicode line
+// and paragraph separators.
+// For example:
+//
+//	Is(v.String(subject).SingleLine())
+func (validator *ValidatorString[T]) SingleLine(template ...string) *ValidatorString[T] {
+	validator.context.Add(
+		func() bool {
+			return strings.IndexFunc(string(validator.context.Value().(T)), isLineBreak) < 0
+		},
+		ErrorKeySingleLine, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer only contains printable
+// characters, like in [ValidatorString.Printable].
+// For example:
+//
+//	name := "Jane Doe"
+//	Is(v.StringP(&name).Printable())
+func (validator *ValidatorStringP[T]) Printable(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil &&
+				isStringEveryRune(string(*(validator.context.Value().(*T))), unicode.IsPrint, params)
+		},
+		ErrorKeyPrintable, params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer doesn't contain control or format
+// characters, like in [ValidatorString.NoControlChars].
+// For example:
+//
+//	Is(v.StringP(&comment).NoControlChars())
+func (validator *ValidatorStringP[T]) NoControlChars(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil &&
+				isStringEveryRune(string(*(validator.context.Value().(*T))), func(r rune) bool { return !isControlChar(r) }, params)
+		},
+		ErrorKeyWithoutControlChars, params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer is in the Unicode Normalization
+// Form C (NFC).
+// For example:
+//
+//	name := "Zoë"
+//	Is(v.StringP(&name).NormalizedNFC())
+func (validator *ValidatorStringP[T]) NormalizedNFC(template ...string) *ValidatorStringP[T] {
+	validator.context.Add(
+		func() bool {
+			return validator.context.Value().(*T) != nil &&
+				isStringNormalizedNFC(string(*(validator.context.Value().(*T))))
+		},
+		ErrorKeyNormalizedNFC, template...)
+
+	return validator
+}
+
+// Validate if the characters of the value of a string pointer belong to a
+// single script, like in [ValidatorString.SingleScript].
+// For example:
+//
+//	name := "Ирина"
+//	Is(v.StringP(&name).SingleScript())
+func (validator *ValidatorStringP[T]) SingleScript(template ...string) *ValidatorStringP[T] {
+	validator.context.Add(
+		func() bool {
+			return validator.context.Value().(*T) != nil &&
+				isStringSingleScript(string(*(validator.context.Value().(*T))))
+		},
+		ErrorKeySingleScript, template...)
+
+	return validator
+}
+
+// Validate if the characters of the value of a string pointer belong to the
+// scripts in the slice.
+// For example:
+//
+//	name := "Ελένη"
+//	Is(v.StringP(&name).ScriptIn([]*unicode.RangeTable{unicode.Latin, unicode.Greek}))
+func (validator *ValidatorStringP[T]) ScriptIn(scripts []*unicode.RangeTable, template ...string) *ValidatorStringP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil &&
+				isStringScriptIn(string(*(validator.context.Value().(*T))), scripts)
+		},
+		ErrorKeyScriptIn,
+		map[string]any{"title": validator.context.title, "scripts": scriptNames(scripts), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer doesn't contain characters that
+// can be confused with Latin letters, like in [ValidatorString.NoConfusables].
+// For example:
+//
+//	Is(v.StringP(&username).NoConfusables())
+func (validator *ValidatorStringP[T]) NoConfusables(template ...string) *ValidatorStringP[T] {
+	params := map[string]any{"title": validator.context.title, "value": validator.context.Value()}
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil &&
+				isStringWithoutConfusables(string(*(validator.context.Value().(*T))), params)
+		},
+		ErrorKeyWithoutConfusables, params, template...)
+
+	return validator
+}
+
+// Validate if the value of a string pointer doesn't contain line breaks.
+// For example:
+//
+//	Is(v.StringP(&subject).SingleLine())
+func (validator *ValidatorStringP[T]) SingleLine(template ...string) *ValidatorStringP[T] {
+	validator.context.Add(
+		func() bool {
+			return validator.context.Value().(*T) != nil &&
+				strings.IndexFunc(string(*(validator.context.Value().(*T))), isLineBreak) < 0
+		},
+		ErrorKeySingleLine, template...)
+
+	return validator
+}
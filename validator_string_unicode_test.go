@@ -0,0 +1,229 @@
+package valgo
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorStringPrintable(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("Jane Doe, Zoë 🙂").Printable())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, s := range []string{"Jane\tDoe", "Jane\nDoe", "Jane\u00a0Doe", "Jane\u200bDoe", "Jane\xffDoe"} {
+		assert.False(t, Is(String(s).Printable()).Valid(), s)
+	}
+
+	v = Is(String("Jane\u200bDoe", "name").Printable())
+	assert.Equal(t,
+		"Name must only contain printable characters",
+		v.Errors()["name"].Messages()[0])
+
+	v = Is(String("Jane\u200bDoe", "name").Printable("{{title}} contains {{character}}"))
+	assert.Equal(t,
+		"Name contains U+200B",
+		v.Errors()["name"].Messages()[0])
+
+	v = Is(String("Jane", "name").Not().Printable())
+	assert.Equal(t,
+		"Name can't only contain printable characters",
+		v.Errors()["name"].Messages()[0])
+}
+
+func TestValidatorStringNoControlChars(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("Line one\r\n\tLine two").NoControlChars())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, s := range []string{"a\x00b", "a\x1bb", "a\u0085b", "a\u200db", "admin\u202etxt.exe", "a\xffb"} {
+		assert.False(t, Is(String(s).NoControlChars()).Valid(), s)
+	}
+
+	v = Is(String("admin\u202etxt.exe", "file").NoControlChars("{{title}} contains {{character}}"))
+	assert.Equal(t,
+		"File contains U+202E",
+		v.Errors()["file"].Messages()[0])
+
+	v = Is(String("a\u200db", "name").NoControlChars())
+	assert.Equal(t,
+		"Name must not contain control characters",
+		v.Errors()["name"].Messages()[0])
+
+	v = Is(String("abc", "name").Not().NoControlChars())
+	assert.Equal(t,
+		"Name must contain control characters",
+		v.Errors()["name"].Messages()[0])
+}
+
+func TestValidatorStringNormalizedNFC(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"", "Zoe", "Zo\u00eb", "\u1e69", "\u1eb9\u0301", "\ud55c\uae00", "\u00e1\u0301", "\u0915\u093c"} {
+		assert.True(t, Is(String(s).NormalizedNFC()).Valid(), s)
+	}
+
+	// Decomposed, unordered marks, composition exclusions, singletons and
+	// conjoining Hangul jamos
+	for _, s := range []string{"Zoe\u0308", "s\u0323\u0307", "e\u0301\u0323", "\u0958", "\u212b", "\u1112\u1161\u11ab", "\xff"} {
+		assert.False(t, Is(String(s).NormalizedNFC()).Valid(), s)
+	}
+
+	v = Is(String("Zoe\u0308", "name").NormalizedNFC())
+	assert.Equal(t,
+		"Name must be in Unicode normalization form NFC",
+		v.Errors()["name"].Messages()[0])
+
+	v = Is(String("Zoë", "name").Not().NormalizedNFC())
+	assert.Equal(t,
+		"Name can't be in Unicode normalization form NFC",
+		v.Errors()["name"].Messages()[0])
+
+	assert.True(t, Is(String("ṩ").NormalizedNFC()).Valid())
+	assert.False(t, Is(String("s\u0307\u0323").NormalizedNFC()).Valid())
+}
+
+func TestValidatorStringSingleScript(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"", "Jane Doe 2", "Ирина-1", "Ελένη", "東京タワーとスカイツリー", "한국어 漢字", "123 !?"} {
+		assert.True(t, Is(String(s).SingleScript()).Valid(), s)
+	}
+
+	for _, s := range []string{"p\u0430ypal", "Ирина Doe", "한국어 ひらがな"} {
+		assert.False(t, Is(String(s).SingleScript()).Valid(), s)
+	}
+
+	v = Is(String("p\u0430ypal", "username").SingleScript())
+	assert.Equal(t,
+		"Username must not mix characters of different scripts",
+		v.Errors()["username"].Messages()[0])
+
+	v = Is(String("Jane", "username").Not().SingleScript())
+	assert.Equal(t,
+		"Username must mix characters of different scripts",
+		v.Errors()["username"].Messages()[0])
+}
+
+func TestValidatorStringScriptIn(t *testing.T) {
+
+	var v *Validation
+
+	scripts := []*unicode.RangeTable{unicode.Latin, unicode.Greek}
+
+	v = Is(String("Jane & Ελένη 2").ScriptIn(scripts))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(String("Ирина", "name").ScriptIn(scripts))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Name must only contain characters of the scripts Latin, Greek",
+		v.Errors()["name"].Messages()[0])
+
+	v = Is(String("Jane", "name").Not().ScriptIn(scripts))
+	assert.Equal(t,
+		"Name can't only contain characters of the scripts Latin, Greek",
+		v.Errors()["name"].Messages()[0])
+}
+
+func TestValidatorStringNoConfusables(t *testing.T) {
+
+	var v *Validation
+
+	for _, s := range []string{"paypal", "Ирина", "мама", "Ελένη", "東京", "jane.doe_2"} {
+		assert.True(t, Is(String(s).NoConfusables()).Valid(), s)
+	}
+
+	for _, s := range []string{"p\u0430ypal", "\u0440\u0430\u0443\u0440\u0430l", "Ορα", "\uff50\uff41\uff59\uff50\uff41\uff4c", "pay\uff11"} {
+		assert.False(t, Is(String(s).NoConfusables()).Valid(), s)
+	}
+
+	v = Is(String("p\u0430ypal", "username").NoConfusables())
+	assert.Equal(t,
+		"Username must not contain characters that look like Latin letters",
+		v.Errors()["username"].Messages()[0])
+
+	v = Is(String("p\u0430ypal", "username").NoConfusables(`{{title}} contains "{{character}}", which looks like "{{lookalike}}"`))
+	assert.Equal(t,
+		"Username contains \"\u0430\", which looks like \"a\"",
+		v.Errors()["username"].Messages()[0])
+
+	v = Is(String("paypal", "username").Not().NoConfusables())
+	assert.Equal(t,
+		"Username must contain characters that look like Latin letters",
+		v.Errors()["username"].Messages()[0])
+}
+
+func TestValidatorStringSingleLine(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(String("Weekly report\t(draft)").SingleLine())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	for _, s := range []string{"a\nb", "a\rb", "a\u2028b", "a\u0085b"} {
+		assert.False(t, Is(String(s).SingleLine()).Valid(), s)
+	}
+
+	v = Is(String("Weekly\nreport", "subject").SingleLine())
+	assert.Equal(t,
+		"Subject must be a single line",
+		v.Errors()["subject"].Messages()[0])
+
+	v = Is(String("Weekly report", "subject").Not().SingleLine())
+	assert.Equal(t,
+		"Subject can't be a single line",
+		v.Errors()["subject"].Messages()[0])
+}
+
+func TestValidatorStringPUnicode(t *testing.T) {
+
+	var v *Validation
+
+	name := "Zoë"
+	v = Is(StringP(&name).Printable().NoControlChars().NormalizedNFC().SingleScript().
+		ScriptIn([]*unicode.RangeTable{unicode.Latin}).NoConfusables().SingleLine())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	name = "Zoe\u0308\u200d\n"
+	v = Is(StringP(&name, "name").Printable()).
+		Is(StringP(&name, "name").NoControlChars()).
+		Is(StringP(&name, "name").NormalizedNFC()).
+		Is(StringP(&name, "name").SingleLine())
+	assert.Len(t, v.Errors()["name"].Messages(), 4)
+
+	name = "Zo\u0435"
+	v = Is(StringP(&name, "name").SingleScript()).
+		Is(StringP(&name, "name").ScriptIn([]*unicode.RangeTable{unicode.Latin})).
+		Is(StringP(&name, "name").NoConfusables())
+	assert.Len(t, v.Errors()["name"].Messages(), 3)
+
+	var nilName *string
+	assert.False(t, Is(StringP(nilName).Printable()).Valid())
+	assert.False(t, Is(StringP(nilName).NoControlChars()).Valid())
+	assert.False(t, Is(StringP(nilName).NormalizedNFC()).Valid())
+	assert.False(t, Is(StringP(nilName).SingleScript()).Valid())
+	assert.False(t, Is(StringP(nilName).ScriptIn([]*unicode.RangeTable{unicode.Latin})).Valid())
+	assert.False(t, Is(StringP(nilName).NoConfusables()).Valid())
+	assert.False(t, Is(StringP(nilName).SingleLine()).Valid())
+}
+
+func TestValidatorStringUnicodeLocales(t *testing.T) {
+
+	v := New(Options{LocaleCode: LocaleCodeEs}).Is(String("p\u0430ypal", "username", "Usuario").NoConfusables())
+	assert.Equal(t,
+		"Usuario no debe contener caracteres que parecen letras latinas",
+		v.Errors()["username"].Messages()[0])
+}
@@ -32,6 +32,33 @@ func isTimeBetween(v time.Time, min time.Time, max time.Time) bool {
 	return (v.After(min) || v.Equal(min)) && (v.Before(max) || v.Equal(max))
 }
 
+func isTimeInFuture(v time.Time, now time.Time) bool {
+	return v.After(now)
+}
+
+func isTimeInPast(v time.Time, now time.Time) bool {
+	return v.Before(now)
+}
+
+func isTimeWithinLast(v time.Time, now time.Time, d time.Duration) bool {
+	return isTimeBetween(v, now.Add(-d), now)
+}
+
+func isTimeWithinNext(v time.Time, now time.Time, d time.Duration) bool {
+	return isTimeBetween(v, now, now.Add(d))
+}
+
+func isTimeOlderThan(v time.Time, now time.Time, d time.Duration) bool {
+	return v.Before(now.Add(-d))
+}
+
+// Report if at least the given number of years have passed since v, counted
+// by calendar in the location of v. Someone born on February 29 turns a year
+// older on March 1 of non-leap years.
+func isTimeAgeAtLeast(v time.Time, now time.Time, years int) bool {
+	return !v.AddDate(years, 0, 0).After(now)
+}
+
 func isTimeInSlice(v time.Time, slice []time.Time) bool {
 	for _, _v := range slice {
 		if v.Equal(_v) {
@@ -300,3 +327,118 @@ func (validator *ValidatorTime) InSlice(slice []time.Time, template ...string) *
 
 	return validator
 }
+
+// The InFuture method verifies if the time value is after the current time,
+// according to the [Clock] of the [Validation] session.
+//
+// For example:
+//
+//	expiresAt := time.Now().Add(time.Hour)
+//	Is(v.Time(expiresAt).InFuture()).Valid()
+func (validator *ValidatorTime) InFuture(template ...string) *ValidatorTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return isTimeInFuture(validator.context.Value().(time.Time), validator.context.Now())
+		},
+		ErrorKeyInFuture, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The InPast method verifies if the time value is before the current time,
+// according to the [Clock] of the [Validation] session.
+//
+// For example:
+//
+//	createdAt := time.Now().Add(-time.Hour)
+//	Is(v.Time(createdAt).InPast()).Valid()
+func (validator *ValidatorTime) InPast(template ...string) *ValidatorTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return isTimeInPast(validator.context.Value().(time.Time), validator.context.Now())
+		},
+		ErrorKeyInPast, validator.context.Value(), template...)
+
+	return validator
+}
+
+// The WithinLast method verifies if the time value is not in the future and
+// not older than a duration, according to the [Clock] of the [Validation]
+// session. Both bounds are inclusive.
+//
+// For example:
+//
+//	lastSeen := time.Now().Add(-10 * time.Minute)
+//	Is(v.Time(lastSeen).WithinLast(time.Hour)).Valid()
+func (validator *ValidatorTime) WithinLast(d time.Duration, template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return isTimeWithinLast(validator.context.Value().(time.Time), validator.context.Now(), d)
+		},
+		ErrorKeyWithinLast,
+		map[string]any{"title": validator.context.title, "duration": d},
+		template...)
+
+	return validator
+}
+
+// The WithinNext method verifies if the time value is not in the past and not
+// further in the future than a duration, according to the [Clock] of the
+// [Validation] session. Both bounds are inclusive.
+//
+// For example:
+//
+//	startsAt := time.Now().Add(48 * time.Hour)
+//	Is(v.Time(startsAt).WithinNext(7 * 24 * time.Hour)).Valid()
+func (validator *ValidatorTime) WithinNext(d time.Duration, template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return isTimeWithinNext(validator.context.Value().(time.Time), validator.context.Now(), d)
+		},
+		ErrorKeyWithinNext,
+		map[string]any{"title": validator.context.title, "duration": d},
+		template...)
+
+	return validator
+}
+
+// The OlderThan method verifies if more than a duration has passed since the
+// time value, according to the [Clock] of the [Validation] session.
+//
+// For example:
+//
+//	passwordChangedAt := time.Now().Add(-100 * 24 * time.Hour)
+//	Is(v.Time(passwordChangedAt).OlderThan(90 * 24 * time.Hour)).Valid()
+func (validator *ValidatorTime) OlderThan(d time.Duration, template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return isTimeOlderThan(validator.context.Value().(time.Time), validator.context.Now(), d)
+		},
+		ErrorKeyOlderThan,
+		map[string]any{"title": validator.context.title, "duration": d},
+		template...)
+
+	return validator
+}
+
+// The AgeAtLeast method verifies if at least a number of years have passed
+// since the time value, such as a birth date, according to the [Clock] of the
+// [Validation] session. The years are counted by calendar, so the age
+// increases on each anniversary; someone born on February 29 turns a year
+// older on March 1 of non-leap years.
+//
+// For example:
+//
+//	birthDate := time.Date(2000, 5, 20, 0, 0, 0, 0, time.UTC)
+//	Is(v.Time(birthDate).AgeAtLeast(18)).Valid()
+func (validator *ValidatorTime) AgeAtLeast(years int, template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return isTimeAgeAtLeast(validator.context.Value().(time.Time), validator.context.Now(), years)
+		},
+		ErrorKeyAgeAtLeast,
+		map[string]any{"title": validator.context.title, "years": years},
+		template...)
+
+	return validator
+}
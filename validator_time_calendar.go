@@ -0,0 +1,412 @@
+package valgo
+
+import "time"
+
+// Default layouts used in the error messages when the locale doesn't define
+// [LayoutKeyDate] or [LayoutKeyTimeOfDay].
+const (
+	defaultLayoutDate      = "2006-01-02"
+	defaultLayoutTimeOfDay = "15:04"
+)
+
+var weekdayKeys = [...]string{
+	time.Sunday:    WeekdayKeySunday,
+	time.Monday:    WeekdayKeyMonday,
+	time.Tuesday:   WeekdayKeyTuesday,
+	time.Wednesday: WeekdayKeyWednesday,
+	time.Thursday:  WeekdayKeyThursday,
+	time.Friday:    WeekdayKeyFriday,
+	time.Saturday:  WeekdayKeySaturday,
+}
+
+// A BusinessCalendar defines the business days used by the BusinessDay rule of
+// the [ValidatorTime].
+type BusinessCalendar struct {
+	// The location where the calendar days are evaluated. When it's nil, the
+	// location of the validated time is used
+	Location *time.Location
+	// The working days of the week. When it's empty, Monday to Friday are used
+	Weekdays []time.Weekday
+	// The days that are not business days, even if they are working days of
+	// the week. Only the date of each time in the calendar location matters
+	Holidays []time.Time
+}
+
+// Return the time in the location, or the same time when the location is nil.
+func timeIn(v time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		return v
+	}
+	return v.In(loc)
+}
+
+func isSameDate(v0 time.Time, v1 time.Time) bool {
+	y0, m0, d0 := v0.Date()
+	y1, m1, d1 := v1.Date()
+	return y0 == y1 && m0 == m1 && d0 == d1
+}
+
+func isTimeWeekday(v time.Time, days []time.Weekday) bool {
+	for _, day := range days {
+		if v.Weekday() == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Return the time elapsed since the start of the day, as read on a wall clock.
+func timeOfDay(v time.Time) time.Duration {
+	hour, min, sec := v.Clock()
+	return time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(v.Nanosecond())
+}
+
+// Report if the time of day of v is within the inclusive range. When from is
+// later than to, the range wraps around midnight.
+func isTimeOfDayBetween(v time.Time, from time.Duration, to time.Duration) bool {
+	d := timeOfDay(v)
+	if from <= to {
+		return d >= from && d <= to
+	}
+	return d >= from || d <= to
+}
+
+func isSameMonth(v0 time.Time, v1 time.Time) bool {
+	return v0.Year() == v1.Year() && v0.Month() == v1.Month()
+}
+
+func isTimeInLocation(v time.Time, loc *time.Location) bool {
+	// A nil location is UTC, as in the time package
+	if loc == nil {
+		loc = time.UTC
+	}
+	// Locations loaded separately are different pointers, so they are compared
+	// by name
+	return v.Location().String() == loc.String()
+}
+
+// Return the first date matching the date of v in the location, if any.
+func findExcludedDate(v time.Time, dates []time.Time, loc *time.Location) (time.Time, bool) {
+	v = timeIn(v, loc)
+	for _, date := range dates {
+		date = timeIn(date, loc)
+		if isSameDate(v, date) {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func isTimeBusinessDay(v time.Time, calendar BusinessCalendar) bool {
+	v = timeIn(v, calendar.Location)
+
+	weekdays := calendar.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	}
+	if !isTimeWeekday(v, weekdays) {
+		return false
+	}
+
+	_, isHoliday := findExcludedDate(v, calendar.Holidays, calendar.Location)
+	return !isHoliday
+}
+
+func localizedWeekdays(days []time.Weekday) *localizedList {
+	list := &localizedList{items: make([]*errorTemplate, len(days))}
+	for i, day := range days {
+		list.items[i] = &errorTemplate{key: weekdayKeys[day%7], params: map[string]any{}}
+	}
+	return list
+}
+
+func localizedDate(v time.Time) *localizedTime {
+	return &localizedTime{time: v, layoutKey: LayoutKeyDate, defaultLayout: defaultLayoutDate}
+}
+
+func localizedTimeOfDay(d time.Duration) *localizedTime {
+	return &localizedTime{
+		time:          time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(d),
+		layoutKey:     LayoutKeyTimeOfDay,
+		defaultLayout: defaultLayoutTimeOfDay}
+}
+
+// The Weekday method verifies if the time value falls on one of the days of
+// the week, evaluated in the location. When the location is nil, the location
+// of the time value is used.
+//
+// For example:
+//
+//	days := []time.Weekday{time.Saturday, time.Sunday}
+//	Is(v.Time(deliveryAt).Weekday(days, berlin)).Valid()
+func (validator *ValidatorTime) Weekday(days []time.Weekday, loc *time.Location, template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return isTimeWeekday(timeIn(validator.context.Value().(time.Time), loc), days)
+		},
+		ErrorKeyWeekday,
+		map[string]any{"title": validator.context.title, "days": localizedWeekdays(days)},
+		template...)
+
+	return validator
+}
+
+// The TimeOfDayBetween method verifies if the wall clock time of the value,
+// evaluated in the location, is within a range of times of day, inclusive. The
+// times of day are durations since midnight, and when from is later than to,
+// the range wraps around midnight. When the location is nil, the location of
+// the time value is used.
+//
+// For example:
+//
+//	berlin, _ := time.LoadLocation("Europe/Berlin")
+//	Is(v.Time(meetingAt).TimeOfDayBetween(9*time.Hour, 17*time.Hour, berlin)).Valid()
+func (validator *ValidatorTime) TimeOfDayBetween(from time.Duration, to time.Duration, loc *time.Location, template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return isTimeOfDayBetween(timeIn(validator.context.Value().(time.Time), loc), from, to)
+		},
+		ErrorKeyTimeOfDayBetween,
+		map[string]any{"title": validator.context.title, "from": localizedTimeOfDay(from), "to": localizedTimeOfDay(to)},
+		template...)
+
+	return validator
+}
+
+// The SameDay method verifies if the time value falls on the same calendar day
+// as another time, with both evaluated in the location. When the location is
+// nil, each time is evaluated in its own location.
+//
+// For example:
+//
+//	Is(v.Time(checkOutAt).SameDay(checkInAt, berlin)).Valid()
+func (validator *ValidatorTime) SameDay(value time.Time, loc *time.Location, template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return isSameDate(timeIn(validator.context.Value().(time.Time), loc), timeIn(value, loc))
+		},
+		ErrorKeySameDay,
+		map[string]any{"title": validator.context.title, "date": localizedDate(timeIn(value, loc))},
+		template...)
+
+	return validator
+}
+
+// The SameMonth method verifies if the time value falls on the same calendar
+// month and year as another time, with both evaluated in the location. When
+// the location is nil, each time is evaluated in its own location.
+//
+// For example:
+//
+//	Is(v.Time(expenseAt).SameMonth(reportAt, berlin)).Valid()
+func (validator *ValidatorTime) SameMonth(value time.Time, loc *time.Location, template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return isSameMonth(timeIn(validator.context.Value().(time.Time), loc), timeIn(value, loc))
+		},
+		ErrorKeySameMonth,
+		map[string]any{"title": validator.context.title, "date": localizedDate(timeIn(value, loc))},
+		template...)
+
+	return validator
+}
+
+// The InLocation method verifies if the time value is set in the location.
+// Locations are compared by name, so a location loaded again with
+// time.LoadLocation is the same location. A nil location is UTC.
+//
+// For example:
+//
+//	berlin, _ := time.LoadLocation("Europe/Berlin")
+//	Is(v.Time(meetingAt).InLocation(berlin)).Valid()
+func (validator *ValidatorTime) InLocation(loc *time.Location, template ...string) *ValidatorTime {
+	validator.context.AddWithParams(
+		func() bool {
+			return isTimeInLocation(validator.context.Value().(time.Time), loc)
+		},
+		ErrorKeyInLocation,
+		map[string]any{"title": validator.context.title, "location": loc},
+		template...)
+
+	return validator
+}
+
+// The NotOnDates method verifies if the time value doesn't fall on the
+// calendar day of any of the dates, such as holidays, with all of them
+// evaluated in the location. When the location is nil, each time is evaluated
+// in its own location. The error message includes the matching date.
+//
+// For example:
+//
+//	holidays := []time.Time{time.Date(2024, 12, 25, 0, 0, 0, 0, berlin)}
+//	Is(v.Time(deliveryAt).NotOnDates(holidays, berlin)).Valid()
+func (validator *ValidatorTime) NotOnDates(dates []time.Time, loc *time.Location, template ...string) *ValidatorTime {
+	params := map[string]any{"title": validator.context.title}
+
+	validator.context.AddWithParams(
+		func() bool {
+			date, found := findExcludedDate(validator.context.Value().(time.Time), dates, loc)
+			if found {
+				params["date"] = localizedDate(date)
+			}
+			return !found
+		},
+		ErrorKeyExcludedDate, params, template...)
+
+	return validator
+}
+
+// The BusinessDay method verifies if the time value falls on a business day of
+// the calendar, that is, a working day of the week that is not a holiday.
+//
+// For example:
+//
+//	calendar := v.BusinessCalendar{Location: berlin, Holidays: holidays}
+//	Is(v.Time(deliveryAt).BusinessDay(calendar)).Valid()
+func (validator *ValidatorTime) BusinessDay(calendar BusinessCalendar, template ...string) *ValidatorTime {
+	validator.context.AddWithValue(
+		func() bool {
+			return isTimeBusinessDay(validator.context.Value().(time.Time), calendar)
+		},
+		ErrorKeyBusinessDay, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Weekday validates that the time pointer is pointing to a time that falls on
+// one of the days of the week, evaluated in the location. When the location is
+// nil, the location of the time is used.
+//
+// Usage example:
+//
+//	days := []time.Weekday{time.Saturday, time.Sunday}
+//	Is(v.TimeP(&deliveryAt).Weekday(days, berlin)).Valid()
+func (validator *ValidatorTimeP) Weekday(days []time.Weekday, loc *time.Location, template ...string) *ValidatorTimeP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isTimeWeekday(timeIn(*(validator.context.Value().(*time.Time)), loc), days)
+		},
+		ErrorKeyWeekday,
+		map[string]any{"title": validator.context.title, "days": localizedWeekdays(days)},
+		template...)
+
+	return validator
+}
+
+// TimeOfDayBetween validates that the time pointer is pointing to a time whose
+// wall clock time, evaluated in the location, is within a range of times of
+// day, inclusive. The times of day are durations since midnight, and when from
+// is later than to, the range wraps around midnight.
+//
+// Usage example:
+//
+//	Is(v.TimeP(&meetingAt).TimeOfDayBetween(9*time.Hour, 17*time.Hour, berlin)).Valid()
+func (validator *ValidatorTimeP) TimeOfDayBetween(from time.Duration, to time.Duration, loc *time.Location, template ...string) *ValidatorTimeP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isTimeOfDayBetween(timeIn(*(validator.context.Value().(*time.Time)), loc), from, to)
+		},
+		ErrorKeyTimeOfDayBetween,
+		map[string]any{"title": validator.context.title, "from": localizedTimeOfDay(from), "to": localizedTimeOfDay(to)},
+		template...)
+
+	return validator
+}
+
+// SameDay validates that the time pointer is pointing to a time on the same
+// calendar day as another time, with both evaluated in the location.
+//
+// Usage example:
+//
+//	Is(v.TimeP(&checkOutAt).SameDay(checkInAt, berlin)).Valid()
+func (validator *ValidatorTimeP) SameDay(value time.Time, loc *time.Location, template ...string) *ValidatorTimeP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isSameDate(timeIn(*(validator.context.Value().(*time.Time)), loc), timeIn(value, loc))
+		},
+		ErrorKeySameDay,
+		map[string]any{"title": validator.context.title, "date": localizedDate(timeIn(value, loc))},
+		template...)
+
+	return validator
+}
+
+// SameMonth validates that the time pointer is pointing to a time on the same
+// calendar month and year as another time, with both evaluated in the
+// location.
+//
+// Usage example:
+//
+//	Is(v.TimeP(&expenseAt).SameMonth(reportAt, berlin)).Valid()
+func (validator *ValidatorTimeP) SameMonth(value time.Time, loc *time.Location, template ...string) *ValidatorTimeP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isSameMonth(timeIn(*(validator.context.Value().(*time.Time)), loc), timeIn(value, loc))
+		},
+		ErrorKeySameMonth,
+		map[string]any{"title": validator.context.title, "date": localizedDate(timeIn(value, loc))},
+		template...)
+
+	return validator
+}
+
+// InLocation validates that the time pointer is pointing to a time set in the
+// location. Locations are compared by name, and a nil location is UTC.
+//
+// Usage example:
+//
+//	Is(v.TimeP(&meetingAt).InLocation(berlin)).Valid()
+func (validator *ValidatorTimeP) InLocation(loc *time.Location, template ...string) *ValidatorTimeP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isTimeInLocation(*(validator.context.Value().(*time.Time)), loc)
+		},
+		ErrorKeyInLocation,
+		map[string]any{"title": validator.context.title, "location": loc},
+		template...)
+
+	return validator
+}
+
+// NotOnDates validates that the time pointer is pointing to a time that
+// doesn't fall on the calendar day of any of the dates, with all of them
+// evaluated in the location. A nil pointer is not valid.
+//
+// Usage example:
+//
+//	Is(v.TimeP(&deliveryAt).NotOnDates(holidays, berlin)).Valid()
+func (validator *ValidatorTimeP) NotOnDates(dates []time.Time, loc *time.Location, template ...string) *ValidatorTimeP {
+	params := map[string]any{"title": validator.context.title}
+
+	validator.context.AddWithParams(
+		func() bool {
+			if validator.context.Value().(*time.Time) == nil {
+				return false
+			}
+			date, found := findExcludedDate(*(validator.context.Value().(*time.Time)), dates, loc)
+			if found {
+				params["date"] = localizedDate(date)
+			}
+			return !found
+		},
+		ErrorKeyExcludedDate, params, template...)
+
+	return validator
+}
+
+// BusinessDay validates that the time pointer is pointing to a business day of
+// the calendar, that is, a working day of the week that is not a holiday.
+//
+// Usage example:
+//
+//	Is(v.TimeP(&deliveryAt).BusinessDay(v.BusinessCalendar{Location: berlin})).Valid()
+func (validator *ValidatorTimeP) BusinessDay(calendar BusinessCalendar, template ...string) *ValidatorTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isTimeBusinessDay(*(validator.context.Value().(*time.Time)), calendar)
+		},
+		ErrorKeyBusinessDay, validator.context.Value(), template...)
+
+	return validator
+}
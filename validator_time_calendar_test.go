@@ -0,0 +1,207 @@
+package valgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func loadTestLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s not available: %v", name, err)
+	}
+	return loc
+}
+
+func TestValidatorTimeWeekday(t *testing.T) {
+	berlin := loadTestLocation(t, "Europe/Berlin")
+	weekend := []time.Weekday{time.Saturday, time.Sunday}
+
+	// Friday 23:30 UTC is already Saturday in Berlin
+	fridayNight := time.Date(2024, 3, 1, 23, 30, 0, 0, time.UTC)
+
+	assert.True(t, Is(Time(fridayNight).Weekday(weekend, berlin)).Valid())
+	assert.True(t, Is(Time(fridayNight).Not().Weekday(weekend, nil)).Valid())
+
+	v := Is(Time(fridayNight).Weekday(weekend, nil))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 must be on Saturday, Sunday",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(Time(fridayNight).Weekday(weekend, nil))
+	assert.Equal(t,
+		"Value 0 debe ser en sábado, domingo",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorTimeWeekdayRenderedInEachLocale(t *testing.T) {
+	weekend := []time.Weekday{time.Saturday, time.Sunday}
+	fridayNight := time.Date(2024, 3, 1, 23, 30, 0, 0, time.UTC)
+
+	// The same validator rendered in a second locale must not keep the
+	// weekday names of the first one.
+	validator := Time(fridayNight).Weekday(weekend, nil)
+
+	v := Is(validator)
+	assert.Equal(t,
+		"Value 0 must be on Saturday, Sunday",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(validator)
+	assert.Equal(t,
+		"Value 0 debe ser en sábado, domingo",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorTimeTimeOfDayBetween(t *testing.T) {
+	berlin := loadTestLocation(t, "Europe/Berlin")
+
+	// 08:30 UTC is 09:30 in Berlin during winter time
+	morning := time.Date(2024, 1, 15, 8, 30, 0, 0, time.UTC)
+
+	assert.True(t, Is(Time(morning).TimeOfDayBetween(9*time.Hour, 17*time.Hour, berlin)).Valid())
+	assert.False(t, Is(Time(morning).TimeOfDayBetween(9*time.Hour, 17*time.Hour, nil)).Valid())
+
+	// Bounds are inclusive
+	assert.True(t, Is(Time(morning).TimeOfDayBetween(8*time.Hour+30*time.Minute, 9*time.Hour, nil)).Valid())
+	assert.True(t, Is(Time(morning).TimeOfDayBetween(8*time.Hour, 8*time.Hour+30*time.Minute, nil)).Valid())
+
+	// A range wrapping around midnight
+	night := time.Date(2024, 1, 15, 23, 0, 0, 0, berlin)
+	assert.True(t, Is(Time(night).TimeOfDayBetween(22*time.Hour, 6*time.Hour, nil)).Valid())
+	assert.True(t, Is(Time(night.Add(4*time.Hour)).TimeOfDayBetween(22*time.Hour, 6*time.Hour, nil)).Valid())
+	assert.False(t, Is(Time(night.Add(-2*time.Hour)).TimeOfDayBetween(22*time.Hour, 6*time.Hour, nil)).Valid())
+
+	v := Is(Time(morning).TimeOfDayBetween(9*time.Hour, 17*time.Hour+30*time.Minute, nil))
+	assert.Equal(t,
+		"Value 0 must be between \"09:00\" and \"17:30\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorTimeSameDayAndSameMonth(t *testing.T) {
+	berlin := loadTestLocation(t, "Europe/Berlin")
+
+	// 2024-02-29 23:30 UTC is 2024-03-01 00:30 in Berlin
+	value := time.Date(2024, 2, 29, 23, 30, 0, 0, time.UTC)
+	other := time.Date(2024, 3, 1, 10, 0, 0, 0, berlin)
+
+	assert.True(t, Is(Time(value).SameDay(other, berlin)).Valid())
+	assert.True(t, Is(Time(value).SameMonth(other, berlin)).Valid())
+	assert.False(t, Is(Time(value).SameDay(other, time.UTC)).Valid())
+	assert.False(t, Is(Time(value).SameMonth(other, time.UTC)).Valid())
+	assert.True(t, Is(Time(value).Not().SameDay(other, time.UTC)).Valid())
+
+	v := Is(Time(value).SameDay(other, time.UTC))
+	assert.Equal(t,
+		"Value 0 must be on the same day as \"2024-03-01\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeEs}).Is(Time(value).SameMonth(other, time.UTC))
+	assert.Equal(t,
+		"Value 0 debe ser en el mismo mes que \"01/03/2024\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeDe}).Is(Time(value).SameDay(other, time.UTC))
+	assert.Contains(t, v.Errors()["value_0"].Messages()[0], "01.03.2024")
+}
+
+func TestValidatorTimeInLocation(t *testing.T) {
+	berlin := loadTestLocation(t, "Europe/Berlin")
+	value := time.Date(2024, 1, 15, 10, 0, 0, 0, berlin)
+
+	assert.True(t, Is(Time(value).InLocation(loadTestLocation(t, "Europe/Berlin"))).Valid())
+	assert.False(t, Is(Time(value.UTC()).InLocation(berlin)).Valid())
+
+	// A nil location is UTC
+	assert.True(t, Is(Time(value.UTC()).InLocation(nil)).Valid())
+
+	v := Is(Time(value).InLocation(nil))
+	assert.Equal(t,
+		"Value 0 must be in the time zone \"UTC\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Time(value).InLocation(time.UTC))
+	assert.Equal(t,
+		"Value 0 must be in the time zone \"UTC\"",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorTimeNotOnDates(t *testing.T) {
+	berlin := loadTestLocation(t, "Europe/Berlin")
+	holidays := []time.Time{
+		time.Date(2024, 12, 25, 0, 0, 0, 0, berlin),
+		time.Date(2024, 12, 26, 0, 0, 0, 0, berlin),
+	}
+
+	assert.True(t, Is(Time(time.Date(2024, 12, 27, 10, 0, 0, 0, berlin)).NotOnDates(holidays, berlin)).Valid())
+	assert.True(t, Is(Time(time.Date(2024, 12, 24, 10, 0, 0, 0, berlin)).NotOnDates(holidays, berlin)).Valid())
+
+	// 2024-12-25 23:30 UTC is already the 26th in Berlin
+	v := Is(Time(time.Date(2024, 12, 25, 23, 30, 0, 0, time.UTC)).NotOnDates(holidays, berlin))
+	assert.False(t, v.Valid())
+	assert.Equal(t,
+		"Value 0 can't be on \"2024-12-26\"",
+		v.Errors()["value_0"].Messages()[0])
+
+	v = Is(Time(time.Date(2024, 12, 24, 10, 0, 0, 0, berlin)).Not().NotOnDates(holidays, berlin))
+	assert.Equal(t,
+		"Value 0 must be on one of the excluded dates",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorTimeBusinessDay(t *testing.T) {
+	berlin := loadTestLocation(t, "Europe/Berlin")
+	calendar := BusinessCalendar{
+		Location: berlin,
+		Holidays: []time.Time{time.Date(2024, 12, 25, 0, 0, 0, 0, berlin)},
+	}
+
+	assert.True(t, Is(Time(time.Date(2024, 12, 24, 10, 0, 0, 0, berlin)).BusinessDay(calendar)).Valid())
+	assert.False(t, Is(Time(time.Date(2024, 12, 25, 10, 0, 0, 0, berlin)).BusinessDay(calendar)).Valid())
+	// Saturday
+	assert.False(t, Is(Time(time.Date(2024, 12, 28, 10, 0, 0, 0, berlin)).BusinessDay(calendar)).Valid())
+	// Friday 23:30 UTC is Saturday in Berlin
+	assert.False(t, Is(Time(time.Date(2024, 12, 27, 23, 30, 0, 0, time.UTC)).BusinessDay(calendar)).Valid())
+
+	calendar.Weekdays = []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday}
+	assert.True(t, Is(Time(time.Date(2024, 12, 29, 10, 0, 0, 0, berlin)).BusinessDay(calendar)).Valid())
+	assert.False(t, Is(Time(time.Date(2024, 12, 27, 10, 0, 0, 0, berlin)).BusinessDay(calendar)).Valid())
+
+	v := Is(Time(time.Date(2024, 12, 25, 10, 0, 0, 0, berlin)).BusinessDay(BusinessCalendar{
+		Holidays: []time.Time{time.Date(2024, 12, 25, 0, 0, 0, 0, berlin)},
+	}))
+	assert.Equal(t,
+		"Value 0 must be a business day",
+		v.Errors()["value_0"].Messages()[0])
+}
+
+func TestValidatorTimePCalendar(t *testing.T) {
+	berlin := loadTestLocation(t, "Europe/Berlin")
+	value := time.Date(2024, 12, 24, 10, 0, 0, 0, berlin)
+	other := time.Date(2024, 12, 24, 20, 0, 0, 0, berlin)
+	holidays := []time.Time{time.Date(2024, 12, 25, 0, 0, 0, 0, berlin)}
+
+	assert.True(t, Is(TimeP(&value).
+		Weekday([]time.Weekday{time.Tuesday}, berlin).
+		TimeOfDayBetween(9*time.Hour, 17*time.Hour, berlin).
+		SameDay(other, berlin).
+		SameMonth(other, berlin).
+		InLocation(berlin).
+		NotOnDates(holidays, berlin).
+		BusinessDay(BusinessCalendar{Location: berlin, Holidays: holidays})).Valid())
+
+	assert.False(t, Is(TimeP(&value).Weekday([]time.Weekday{time.Monday}, berlin)).Valid())
+	assert.False(t, Is(TimeP(&value).NotOnDates([]time.Time{other}, berlin)).Valid())
+
+	var nilTime *time.Time
+	assert.False(t, Is(TimeP(nilTime).Weekday([]time.Weekday{time.Tuesday}, berlin)).Valid())
+	assert.False(t, Is(TimeP(nilTime).TimeOfDayBetween(0, 24*time.Hour, berlin)).Valid())
+	assert.False(t, Is(TimeP(nilTime).SameDay(other, berlin)).Valid())
+	assert.False(t, Is(TimeP(nilTime).SameMonth(other, berlin)).Valid())
+	assert.False(t, Is(TimeP(nilTime).InLocation(berlin)).Valid())
+	assert.False(t, Is(TimeP(nilTime).NotOnDates(holidays, berlin)).Valid())
+	assert.False(t, Is(TimeP(nilTime).BusinessDay(BusinessCalendar{})).Valid())
+}
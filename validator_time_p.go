@@ -279,3 +279,116 @@ func (validator *ValidatorTimeP) NilOrZero(template ...string) *ValidatorTimeP {
 
 	return validator
 }
+
+// InFuture validates that the time pointer is pointing to a time after the
+// current time, according to the [Clock] of the [Validation] session.
+//
+// Usage example:
+//
+//	expiresAt := time.Now().Add(time.Hour)
+//	Is(v.TimeP(&expiresAt).InFuture()).Valid()  // Will return true.
+func (validator *ValidatorTimeP) InFuture(template ...string) *ValidatorTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isTimeInFuture(*(validator.context.Value().(*time.Time)), validator.context.Now())
+		},
+		ErrorKeyInFuture, validator.context.Value(), template...)
+
+	return validator
+}
+
+// InPast validates that the time pointer is pointing to a time before the
+// current time, according to the [Clock] of the [Validation] session.
+//
+// Usage example:
+//
+//	createdAt := time.Now().Add(-time.Hour)
+//	Is(v.TimeP(&createdAt).InPast()).Valid()  // Will return true.
+func (validator *ValidatorTimeP) InPast(template ...string) *ValidatorTimeP {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isTimeInPast(*(validator.context.Value().(*time.Time)), validator.context.Now())
+		},
+		ErrorKeyInPast, validator.context.Value(), template...)
+
+	return validator
+}
+
+// WithinLast validates that the time pointer is pointing to a time that is not
+// in the future and not older than a duration, according to the [Clock] of the
+// [Validation] session. Both bounds are inclusive.
+//
+// Usage example:
+//
+//	lastSeen := time.Now().Add(-10 * time.Minute)
+//	Is(v.TimeP(&lastSeen).WithinLast(time.Hour)).Valid()  // Will return true.
+func (validator *ValidatorTimeP) WithinLast(d time.Duration, template ...string) *ValidatorTimeP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isTimeWithinLast(*(validator.context.Value().(*time.Time)), validator.context.Now(), d)
+		},
+		ErrorKeyWithinLast,
+		map[string]any{"title": validator.context.title, "duration": d},
+		template...)
+
+	return validator
+}
+
+// WithinNext validates that the time pointer is pointing to a time that is not
+// in the past and not further in the future than a duration, according to the
+// [Clock] of the [Validation] session. Both bounds are inclusive.
+//
+// Usage example:
+//
+//	startsAt := time.Now().Add(48 * time.Hour)
+//	Is(v.TimeP(&startsAt).WithinNext(7 * 24 * time.Hour)).Valid()  // Will return true.
+func (validator *ValidatorTimeP) WithinNext(d time.Duration, template ...string) *ValidatorTimeP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isTimeWithinNext(*(validator.context.Value().(*time.Time)), validator.context.Now(), d)
+		},
+		ErrorKeyWithinNext,
+		map[string]any{"title": validator.context.title, "duration": d},
+		template...)
+
+	return validator
+}
+
+// OlderThan validates that more than a duration has passed since the time the
+// pointer is pointing to, according to the [Clock] of the [Validation] session.
+//
+// Usage example:
+//
+//	passwordChangedAt := time.Now().Add(-100 * 24 * time.Hour)
+//	Is(v.TimeP(&passwordChangedAt).OlderThan(90 * 24 * time.Hour)).Valid()  // Will return true.
+func (validator *ValidatorTimeP) OlderThan(d time.Duration, template ...string) *ValidatorTimeP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isTimeOlderThan(*(validator.context.Value().(*time.Time)), validator.context.Now(), d)
+		},
+		ErrorKeyOlderThan,
+		map[string]any{"title": validator.context.title, "duration": d},
+		template...)
+
+	return validator
+}
+
+// AgeAtLeast validates that at least a number of years have passed since the
+// time the pointer is pointing to, such as a birth date, according to the
+// [Clock] of the [Validation] session. The years are counted by calendar.
+//
+// Usage example:
+//
+//	birthDate := time.Date(2000, 5, 20, 0, 0, 0, 0, time.UTC)
+//	Is(v.TimeP(&birthDate).AgeAtLeast(18)).Valid()  // Will return true.
+func (validator *ValidatorTimeP) AgeAtLeast(years int, template ...string) *ValidatorTimeP {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*time.Time) != nil && isTimeAgeAtLeast(*(validator.context.Value().(*time.Time)), validator.context.Now(), years)
+		},
+		ErrorKeyAgeAtLeast,
+		map[string]any{"title": validator.context.title, "years": years},
+		template...)
+
+	return validator
+}
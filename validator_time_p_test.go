@@ -478,3 +478,33 @@ func TestValidatorTimePOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorTimePRelativeRules(t *testing.T) {
+
+	now := time.Date(2024, 5, 20, 10, 0, 0, 0, time.UTC)
+	val := func() *Validation { return New(Options{Clock: FixedClock(now)}) }
+
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+	birthDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	v := val().Is(TimeP(&future).InFuture().WithinNext(2 * time.Hour)).
+		Is(TimeP(&past).InPast().WithinLast(2 * time.Hour).OlderThan(time.Minute)).
+		Is(TimeP(&birthDate).AgeAtLeast(18))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = val().Is(TimeP(&past, "expires_at").InFuture())
+	assert.Equal(t,
+		"Expires at must be in the future",
+		v.Errors()["expires_at"].Messages()[0])
+
+	var nilTime *time.Time
+	for _, validator := range []*ValidatorTimeP{
+		TimeP(nilTime).InFuture(), TimeP(nilTime).InPast(),
+		TimeP(nilTime).WithinLast(time.Hour), TimeP(nilTime).WithinNext(time.Hour),
+		TimeP(nilTime).OlderThan(0), TimeP(nilTime).AgeAtLeast(0),
+	} {
+		assert.False(t, val().Is(validator).Valid())
+	}
+}
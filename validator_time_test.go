@@ -473,3 +473,117 @@ func TestValidatorTimeOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorTimeInFutureAndInPast(t *testing.T) {
+
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	val := func() *Validation { return New(Options{Clock: FixedClock(now)}) }
+
+	var v *Validation
+
+	v = val().Is(Time(now.Add(time.Second)).InFuture()).
+		Is(Time(now.Add(-time.Second)).InPast())
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	// The current time is neither in the future nor in the past
+	assert.False(t, val().Is(Time(now).InFuture()).Valid())
+	assert.False(t, val().Is(Time(now).InPast()).Valid())
+
+	v = val().Is(Time(now.Add(-time.Hour), "expires_at").InFuture())
+	assert.Equal(t,
+		"Expires at must be in the future",
+		v.Errors()["expires_at"].Messages()[0])
+
+	v = val().Is(Time(now.Add(time.Hour), "created_at").InPast())
+	assert.Equal(t,
+		"Created at must be in the past",
+		v.Errors()["created_at"].Messages()[0])
+
+	v = val().Is(Time(now.Add(time.Hour), "starts_at").Not().InFuture())
+	assert.Equal(t,
+		"Starts at can't be in the future",
+		v.Errors()["starts_at"].Messages()[0])
+
+	// Without a clock, the system time is used
+	assert.True(t, Is(Time(time.Now().Add(time.Hour)).InFuture()).Valid())
+	assert.True(t, Is(Time(time.Now().Add(-time.Hour)).InPast()).Valid())
+}
+
+func TestValidatorTimeWithinLastAndWithinNext(t *testing.T) {
+
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	val := func() *Validation { return New(Options{Clock: FixedClock(now)}) }
+
+	var v *Validation
+
+	for _, tm := range []time.Time{now, now.Add(-30 * time.Minute), now.Add(-time.Hour)} {
+		assert.True(t, val().Is(Time(tm).WithinLast(time.Hour)).Valid(), tm)
+	}
+	for _, tm := range []time.Time{now.Add(time.Second), now.Add(-time.Hour - time.Second)} {
+		assert.False(t, val().Is(Time(tm).WithinLast(time.Hour)).Valid(), tm)
+	}
+
+	for _, tm := range []time.Time{now, now.Add(30 * time.Minute), now.Add(time.Hour)} {
+		assert.True(t, val().Is(Time(tm).WithinNext(time.Hour)).Valid(), tm)
+	}
+	for _, tm := range []time.Time{now.Add(-time.Second), now.Add(time.Hour + time.Second)} {
+		assert.False(t, val().Is(Time(tm).WithinNext(time.Hour)).Valid(), tm)
+	}
+
+	v = val().Is(Time(now.Add(-2*time.Hour), "last_seen").WithinLast(time.Hour))
+	assert.Equal(t,
+		"Last seen must be within the last \"1h0m0s\"",
+		v.Errors()["last_seen"].Messages()[0])
+
+	v = val().Is(Time(now.Add(48*time.Hour), "starts_at").WithinNext(24 * time.Hour))
+	assert.Equal(t,
+		"Starts at must be within the next \"24h0m0s\"",
+		v.Errors()["starts_at"].Messages()[0])
+}
+
+func TestValidatorTimeOlderThan(t *testing.T) {
+
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	val := func() *Validation { return New(Options{Clock: FixedClock(now)}) }
+
+	assert.True(t, val().Is(Time(now.Add(-91*24*time.Hour)).OlderThan(90*24*time.Hour)).Valid())
+	assert.False(t, val().Is(Time(now.Add(-90*24*time.Hour)).OlderThan(90*24*time.Hour)).Valid())
+	assert.False(t, val().Is(Time(now.Add(time.Hour)).OlderThan(0)).Valid())
+
+	v := val().Is(Time(now.Add(-time.Minute), "password_changed_at").OlderThan(time.Hour))
+	assert.Equal(t,
+		"Password changed at must be older than \"1h0m0s\"",
+		v.Errors()["password_changed_at"].Messages()[0])
+}
+
+func TestValidatorTimeAgeAtLeast(t *testing.T) {
+
+	now := time.Date(2024, 5, 20, 10, 0, 0, 0, time.UTC)
+	val := func() *Validation { return New(Options{Clock: FixedClock(now)}) }
+
+	var v *Validation
+
+	// The anniversary counts as a full year
+	assert.True(t, val().Is(Time(time.Date(2006, 5, 20, 0, 0, 0, 0, time.UTC)).AgeAtLeast(18)).Valid())
+	assert.False(t, val().Is(Time(time.Date(2006, 5, 21, 0, 0, 0, 0, time.UTC)).AgeAtLeast(18)).Valid())
+	assert.True(t, val().Is(Time(time.Date(1990, 12, 31, 0, 0, 0, 0, time.UTC)).AgeAtLeast(18)).Valid())
+
+	// Someone born on February 29 turns a year older on March 1 of non-leap years
+	leapDay := time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC)
+	assert.False(t, New(Options{Clock: FixedClock(time.Date(2023, 2, 28, 12, 0, 0, 0, time.UTC))}).
+		Is(Time(leapDay).AgeAtLeast(19)).Valid())
+	assert.True(t, New(Options{Clock: FixedClock(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC))}).
+		Is(Time(leapDay).AgeAtLeast(19)).Valid())
+
+	v = val().Is(Time(time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), "birth_date").AgeAtLeast(18))
+	assert.Equal(t,
+		"Birth date must be at least 18 years ago",
+		v.Errors()["birth_date"].Messages()[0])
+
+	v = New(Options{LocaleCode: LocaleCodeEs, Clock: FixedClock(now)}).
+		Is(Time(time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), "birth_date", "Fecha de nacimiento").AgeAtLeast(18))
+	assert.Equal(t,
+		"Fecha de nacimiento debe ser de hace al menos 18 años",
+		v.Errors()["birth_date"].Messages()[0])
+}
@@ -344,3 +344,89 @@ func (validator *ValidatorUintP[T]) Nil(template ...string) *ValidatorUintP[T] {
 
 	return validator
 }
+
+// Validate if a numeric pointer value is a multiple of another. Zero is only a
+// multiple of itself.
+//
+// For example:
+//
+//	n := uint(12)
+//	Is(v.UintP(&n).MultipleOf(4))
+func (validator *ValidatorUintP[T]) MultipleOf(value T, template ...string) *ValidatorUintP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isIntegerMultipleOf(*(validator.context.Value().(*T)), value)
+		},
+		ErrorKeyMultipleOf, value, template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is an even number.
+//
+// For example:
+//
+//	n := uint(4)
+//	Is(v.UintP(&n).Even())
+func (validator *ValidatorUintP[T]) Even(template ...string) *ValidatorUintP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isIntegerEven(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyEven, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is an odd number.
+//
+// For example:
+//
+//	n := uint(5)
+//	Is(v.UintP(&n).Odd())
+func (validator *ValidatorUintP[T]) Odd(template ...string) *ValidatorUintP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isIntegerOdd(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyOdd, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value is a power of two, such as 1, 2, 4 or
+// 1024.
+//
+// For example:
+//
+//	n := uint(64)
+//	Is(v.UintP(&n).PowerOfTwo())
+func (validator *ValidatorUintP[T]) PowerOfTwo(template ...string) *ValidatorUintP[T] {
+	validator.context.AddWithValue(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isIntegerPowerOfTwo(*(validator.context.Value().(*T)))
+		},
+		ErrorKeyPowerOfTwo, validator.context.Value(), template...)
+
+	return validator
+}
+
+// Validate if a numeric pointer value can be converted to another numeric type
+// without overflowing or losing a fractional part. It is useful when narrowing
+// values, for example to store an int64 from an API in an int32 column.
+//
+// For example:
+//
+//	n := uint(42)
+//	Is(v.UintP(&n).FitsIn(v.NumberTypeOf[int32]()))
+func (validator *ValidatorUintP[T]) FitsIn(t NumberType, template ...string) *ValidatorUintP[T] {
+	validator.context.AddWithParams(
+		func() bool {
+			return validator.context.Value().(*T) != nil && isNumberFitsIn(*(validator.context.Value().(*T)), t)
+		},
+		ErrorKeyFitsIn,
+		map[string]any{"title": validator.context.title, "type": t.String(), "value": validator.context.Value()},
+		template...)
+
+	return validator
+}
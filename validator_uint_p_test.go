@@ -877,3 +877,26 @@ func TestValidatorUintPOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorUintPDivisibilityAndFitsIn(t *testing.T) {
+
+	var v *Validation
+
+	n := uint(256)
+	v = Is(UintP(&n).MultipleOf(16).Even().PowerOfTwo().FitsIn(NumberTypeOf[uint16]()))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	v = Is(UintP(&n, "level").FitsIn(NumberTypeOf[uint8]()))
+	assert.Equal(t,
+		"Level must fit in the \"uint8\" type",
+		v.Errors()["level"].Messages()[0])
+
+	var nilUint *uint
+	for _, validator := range []*ValidatorUintP[uint]{
+		UintP(nilUint).MultipleOf(1), UintP(nilUint).Even(), UintP(nilUint).Odd(),
+		UintP(nilUint).PowerOfTwo(), UintP(nilUint).FitsIn(NumberTypeOf[uint64]()),
+	} {
+		assert.False(t, Is(validator).Valid())
+	}
+}
@@ -648,3 +648,45 @@ func TestValidatorUintOrElseOperatorWithCheck(t *testing.T) {
 	assert.False(t, v.Valid())
 	assert.NotEmpty(t, v.Errors())
 }
+
+func TestValidatorUintMultipleOfEvenOddAndPowerOfTwo(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Uint(uint(12)).MultipleOf(4).Even()).
+		Is(Uint8(uint8(255)).Odd()).
+		Is(Uint64(uint64(1 << 63)).PowerOfTwo()).
+		Is(Uint(uint(0)).MultipleOf(0))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.False(t, Is(Uint(uint(0)).PowerOfTwo()).Valid())
+	assert.False(t, Is(Uint(uint(5)).MultipleOf(0)).Valid())
+
+	v = Is(Uint(uint(1000), "block_size").PowerOfTwo())
+	assert.Equal(t,
+		"Block size must be a power of two",
+		v.Errors()["block_size"].Messages()[0])
+
+	v = Is(Uint(uint(10), "quantity").MultipleOf(6))
+	assert.Equal(t,
+		"Quantity must be a multiple of \"6\"",
+		v.Errors()["quantity"].Messages()[0])
+}
+
+func TestValidatorUintFitsIn(t *testing.T) {
+
+	var v *Validation
+
+	v = Is(Uint64(uint64(65535)).FitsIn(NumberTypeOf[uint16]())).
+		Is(Uint64(uint64(127)).FitsIn(NumberTypeOf[int8]()))
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Errors())
+
+	assert.False(t, Is(Uint64(uint64(1<<63)).FitsIn(NumberTypeOf[int64]())).Valid())
+
+	v = Is(Uint64(uint64(128), "level").FitsIn(NumberTypeOf[int8]()))
+	assert.Equal(t,
+		"Level must fit in the \"int8\" type",
+		v.Errors()["level"].Messages()[0])
+}